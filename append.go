@@ -0,0 +1,167 @@
+// This file implements appending an export to an existing document.
+
+package exporter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-data-exporter/exporter/codec"
+)
+
+// AppendFile appends the export to the document in the named file, creating it
+// if it does not exist, e.g. to add the rows of a resumed run to a JSON array
+// written by an earlier run. The codec must implement codec.Appender and be
+// configured for appending, e.g. with jsoncodec.WithAppendMode: the closing
+// sequence of the document, such as the final "]" of a JSON array, is removed,
+// the rows are written after a separator and the codec closes the document again.
+// If the export fails or writes no rows, the removed sequence is restored.
+// Compression is not supported.
+func (cs *Exporter) AppendFile(filename string) (err error) {
+	appender, ok := cs.codec.(codec.Appender)
+	var open, separator, closing string
+	if ok {
+		open, separator, closing, ok = appender.AppendSyntax()
+	}
+	if !ok {
+		return fmt.Errorf("exporter: codec %T is not configured for appending", cs.codec)
+	}
+	if cs.compression != CompressionNone {
+		return errors.New("exporter: compressed files cannot be appended")
+	}
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if syncErr := f.Sync(); err == nil {
+			err = syncErr
+		}
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	tail, prefix, err := openAppend(f, open, separator, closing)
+	if err != nil {
+		return fmt.Errorf("exporter: cannot append to %s: %w", filename, err)
+	}
+	w := &prefixWriter{Writer: f, prefix: prefix}
+	_, err = cs.Export(w)
+	if err != nil || !w.written {
+		// Restore the document: drop partial output and write back the removed tail.
+		end, seekErr := f.Seek(-int64(w.n), io.SeekCurrent)
+		if seekErr == nil {
+			seekErr = f.Truncate(end)
+		}
+		if seekErr == nil {
+			_, seekErr = f.Write(tail)
+		}
+		err = errors.Join(err, seekErr)
+	}
+	return err
+}
+
+// openAppend prepares f for appending a document with the given syntax. It removes
+// the closing sequence and the whitespace around it, positions f at the end and
+// returns the removed bytes and the prefix of the appended output: the separator
+// if the document has rows, nothing if it is empty, and the opening sequence if the
+// file is empty. For an empty file, tail is the complete empty document.
+func openAppend(f *os.File, open, separator, closing string) (tail, prefix []byte, err error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	size := info.Size()
+	if closing == "" {
+		_, err = f.Seek(0, io.SeekEnd)
+		return nil, nil, err
+	}
+	last, err := lastNonSpace(f, size)
+	if err != nil {
+		return nil, nil, err
+	}
+	if last < 0 {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return nil, nil, err
+		}
+		return []byte(open + closing + "\n"), []byte(open), f.Truncate(0)
+	}
+	closeStart := last + 1 - int64(len(closing))
+	if closeStart < 0 || !hasBytesAt(f, closeStart, closing) {
+		return nil, nil, fmt.Errorf("the document does not end with %q", closing)
+	}
+	end, err := lastNonSpace(f, closeStart)
+	if err != nil {
+		return nil, nil, err
+	}
+	end++
+	prefix = []byte(separator)
+	if openStart := end - int64(len(open)); openStart >= 0 && hasBytesAt(f, openStart, open) {
+		prefix = nil
+	}
+	tail = make([]byte, size-end)
+	if _, err := f.ReadAt(tail, end); err != nil {
+		return nil, nil, err
+	}
+	if err := f.Truncate(end); err != nil {
+		return nil, nil, err
+	}
+	_, err = f.Seek(end, io.SeekStart)
+	return tail, prefix, err
+}
+
+// lastNonSpace returns the offset of the last byte of f before end that is not
+// JSON whitespace, or -1 if there is none.
+func lastNonSpace(f *os.File, end int64) (int64, error) {
+	buf := make([]byte, 4096)
+	for end > 0 {
+		start := max(end-int64(len(buf)), 0)
+		chunk := buf[:end-start]
+		if _, err := f.ReadAt(chunk, start); err != nil {
+			return 0, err
+		}
+		if i := bytes.LastIndexFunc(chunk, func(r rune) bool {
+			return r != ' ' && r != '\t' && r != '\n' && r != '\r'
+		}); i >= 0 {
+			return start + int64(i), nil
+		}
+		end = start
+	}
+	return -1, nil
+}
+
+// hasBytesAt reports whether f contains s at offset.
+func hasBytesAt(f *os.File, offset int64, s string) bool {
+	b := make([]byte, len(s))
+	_, err := f.ReadAt(b, offset)
+	return err == nil && string(b) == s
+}
+
+// prefixWriter writes a prefix before the first bytes written to it.
+type prefixWriter struct {
+	io.Writer
+	prefix  []byte
+	written bool
+	n       int // Number of bytes written, including the prefix.
+}
+
+// Write writes the prefix on the first call, followed by p.
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if !w.written {
+		w.written = true
+		n, err := w.Writer.Write(w.prefix)
+		w.n += n
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.Writer.Write(p)
+	w.n += n
+	return n, err
+}
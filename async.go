@@ -0,0 +1,175 @@
+// This file implements writing to slow destinations through a bounded buffer.
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ErrWriterStalled is returned when the destination of an asynchronous export does
+// not accept data for longer than the stall timeout set with WithAsyncWrite.
+var ErrWriterStalled = errors.New("exporter: destination stalled")
+
+// WithAsyncWrite decouples the export from its destination: rows are read and
+// encoded while a goroutine writes the output to the destination, buffering up to
+// memoryLimit bytes in between. Once the buffer is full, reading rows pauses until
+// the destination catches up, so a slow destination such as an SFTP upload paces
+// the scan instead of blocking the source for every write, e.g. a database cursor
+// that would otherwise time out. If stallTimeout is positive and the buffer stays
+// full because the destination accepts no data for that long, the export fails
+// with ErrWriterStalled; the pending write to the destination is then abandoned.
+// The export returns once all output has reached the destination. A non-positive
+// memoryLimit disables the asynchronous mode (default).
+func WithAsyncWrite(memoryLimit int, stallTimeout time.Duration) Option {
+	return func(e *Exporter) {
+		e.asyncLimit = memoryLimit
+		e.asyncStall = stallTimeout
+	}
+}
+
+// asyncWriter buffers the output in a ring buffer drained by a goroutine.
+type asyncWriter struct {
+	w     io.Writer
+	stall time.Duration
+
+	mu     sync.Mutex
+	buf    []byte // The ring buffer.
+	start  int    // The offset of the first buffered byte.
+	n      int    // The number of buffered bytes.
+	closed bool
+	err    error // The error of the destination.
+
+	readable chan struct{} // Signaled when data was buffered or the writer was closed.
+	writable chan struct{} // Signaled when buffered data was written.
+	done     chan struct{} // Closed when the goroutine has finished.
+}
+
+// newAsyncWriter returns an asyncWriter buffering up to limit bytes for w and
+// starts its goroutine.
+func newAsyncWriter(w io.Writer, limit int, stall time.Duration) *asyncWriter {
+	a := &asyncWriter{
+		w:        w,
+		stall:    stall,
+		buf:      make([]byte, limit),
+		readable: make(chan struct{}, 1),
+		writable: make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	go a.drain()
+	return a
+}
+
+// Write copies p into the buffer, waiting for free space while it is full.
+func (a *asyncWriter) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		a.mu.Lock()
+		if a.err != nil {
+			err := a.err
+			a.mu.Unlock()
+			return written, err
+		}
+		if a.n == len(a.buf) {
+			a.mu.Unlock()
+			if err := a.waitWritable(); err != nil {
+				return written, err
+			}
+			continue
+		}
+		end := (a.start + a.n) % len(a.buf)
+		k := copy(a.buf[end:min(end+len(a.buf)-a.n, len(a.buf))], p[written:])
+		a.n += k
+		a.mu.Unlock()
+		written += k
+		notify(a.readable)
+	}
+	return written, nil
+}
+
+// waitWritable waits until the goroutine has written buffered data or finished,
+// or returns ErrWriterStalled after the stall timeout.
+func (a *asyncWriter) waitWritable() error {
+	var timeout <-chan time.Time
+	if a.stall > 0 {
+		timer := time.NewTimer(a.stall)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-a.writable:
+	case <-a.done:
+	case <-timeout:
+		a.mu.Lock()
+		if a.err == nil {
+			a.err = fmt.Errorf("%w: no data accepted for %v", ErrWriterStalled, a.stall)
+		}
+		a.mu.Unlock()
+	}
+	return nil
+}
+
+// drain writes the buffered data to the destination until the writer is closed
+// and the buffer is empty, or the destination fails.
+func (a *asyncWriter) drain() {
+	defer close(a.done)
+	for {
+		a.mu.Lock()
+		if a.err != nil {
+			a.mu.Unlock()
+			return
+		}
+		if a.n == 0 {
+			closed := a.closed
+			a.mu.Unlock()
+			if closed {
+				return
+			}
+			<-a.readable
+			continue
+		}
+		// The writer never overwrites buffered data, so the chunk can be written
+		// without holding the lock.
+		chunk := a.buf[a.start:min(a.start+a.n, len(a.buf))]
+		a.mu.Unlock()
+		k, err := a.w.Write(chunk)
+		if err == nil && k < len(chunk) {
+			err = io.ErrShortWrite
+		}
+		a.mu.Lock()
+		a.start = (a.start + k) % len(a.buf)
+		a.n -= k
+		if err != nil && a.err == nil {
+			a.err = err
+		}
+		a.mu.Unlock()
+		notify(a.writable)
+	}
+}
+
+// close waits until all buffered data has been written and returns the error of
+// the destination, if any. After a stall it returns without waiting.
+func (a *asyncWriter) close() error {
+	a.mu.Lock()
+	a.closed = true
+	stalled := errors.Is(a.err, ErrWriterStalled)
+	a.mu.Unlock()
+	notify(a.readable)
+	if !stalled {
+		<-a.done
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// notify signals ch without blocking.
+func notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,111 @@
+// This file implements audit records of exports.
+
+package exporter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"sync"
+	"time"
+)
+
+// AuditRecord describes a finished export.
+type AuditRecord struct {
+	User        string    `json:"user,omitempty"`   // Who ran the export, as set with WithAuditor.
+	Source      string    `json:"source,omitempty"` // What was exported, e.g. a query, as set with WithAuditor.
+	Started     time.Time `json:"started"`
+	Finished    time.Time `json:"finished"`
+	Rows        int64     `json:"rows"`
+	Bytes       int64     `json:"bytes"`
+	Destination string    `json:"destination"` // Name of the file, or type of the writer.
+	Checksum    string    `json:"checksum"`    // SHA-256 of the bytes written, hex-encoded.
+	Error       string    `json:"error,omitempty"`
+}
+
+// Auditor is invoked with a record of every export, successful or not.
+type Auditor interface {
+	Audit(record AuditRecord) error
+}
+
+// AuditorFunc is a function that implements Auditor.
+type AuditorFunc func(record AuditRecord) error
+
+// Audit calls fn(record).
+func (fn AuditorFunc) Audit(record AuditRecord) error {
+	return fn(record)
+}
+
+// WithAuditor invokes auditor at the end of every Export, and every method built on
+// it such as Write and WriteFile, with the given user and source description and the
+// statistics and checksum of the output. An error of the auditor fails an otherwise
+// successful export.
+func WithAuditor(auditor Auditor, user, source string) Option {
+	return func(e *Exporter) {
+		e.auditor = auditor
+		e.auditUser = user
+		e.auditSource = source
+	}
+}
+
+// jsonAuditor writes audit records as JSON lines.
+type jsonAuditor struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// NewJSONAuditor returns an Auditor that writes every record as a line of JSON to
+// writer, e.g. a file opened for appending. It is safe for concurrent use.
+func NewJSONAuditor(writer io.Writer) Auditor {
+	return &jsonAuditor{writer: writer}
+}
+
+// Audit writes the record as a line of JSON.
+func (a *jsonAuditor) Audit(record AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, err = a.writer.Write(append(data, '\n'))
+	return err
+}
+
+// audit tracks an export for its audit record.
+type audit struct {
+	record AuditRecord
+	hash   hash.Hash
+}
+
+// startAudit starts the audit of an export to writer, or returns nil if no auditor is set.
+func (cs *Exporter) startAudit(writer io.Writer) *audit {
+	if cs.auditor == nil {
+		return nil
+	}
+	record := AuditRecord{
+		User:        cs.auditUser,
+		Source:      cs.auditSource,
+		Started:     time.Now(),
+		Destination: fmt.Sprintf("%T", writer),
+	}
+	if named, ok := writer.(interface{ Name() string }); ok {
+		record.Destination = named.Name()
+	}
+	return &audit{record: record, hash: sha256.New()}
+}
+
+// finish passes the record of the finished export to the auditor.
+func (a *audit) finish(auditor Auditor, stats Stats, err error) error {
+	a.record.Finished = time.Now()
+	a.record.Rows = stats.Rows
+	a.record.Bytes = stats.Bytes
+	a.record.Checksum = hex.EncodeToString(a.hash.Sum(nil))
+	if err != nil {
+		a.record.Error = err.Error()
+	}
+	return auditor.Audit(a.record)
+}
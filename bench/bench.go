@@ -0,0 +1,111 @@
+// Package bench provides reproducible data generators used to benchmark codecs
+// and scanner wrappers. All generators are deterministic for a given seed, so
+// results of different runs and revisions are comparable.
+//
+// Run the benchmarks with allocation reporting using:
+//
+//	go test ./bench -run '^$' -bench . -benchmem
+package bench
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// baseTime is the fixed point in time generated timestamps are derived from.
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newRand returns a deterministic random source for the given seed.
+func newRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+}
+
+// WideRows generates rows with cols columns cycling through int64, float64,
+// string, bool and time.Time values.
+func WideRows(rows, cols int, seed uint64) [][]any {
+	r := newRand(seed)
+	data := make([][]any, rows)
+	for i := range data {
+		row := make([]any, cols)
+		for j := range row {
+			row[j] = value(r, j)
+		}
+		data[i] = row
+	}
+	return data
+}
+
+// LongStrings generates rows with an id column and a text column of the given
+// length containing characters that need quoting or escaping in most formats.
+func LongStrings(rows, length int, seed uint64) [][]any {
+	r := newRand(seed)
+	const alphabet = "abcdefghijklmnopqrstuvwxyz ,\"<>&\n"
+	data := make([][]any, rows)
+	var b strings.Builder
+	for i := range data {
+		b.Reset()
+		for range length {
+			b.WriteByte(alphabet[r.IntN(len(alphabet))])
+		}
+		data[i] = []any{int64(i), b.String()}
+	}
+	return data
+}
+
+// ManyNULLs generates rows like WideRows where each value is NULL with the given probability.
+// The first row never contains NULLs, so that column types can be inferred from it.
+func ManyNULLs(rows, cols int, nullRatio float64, seed uint64) [][]any {
+	r := newRand(seed)
+	data := make([][]any, rows)
+	for i := range data {
+		row := make([]any, cols)
+		for j := range row {
+			if i != 0 && r.Float64() < nullRatio {
+				continue
+			}
+			row[j] = value(r, j)
+		}
+		data[i] = row
+	}
+	return data
+}
+
+// StringRows generates rows like WideRows with every value rendered as a string,
+// as delivered by text sources such as CSV files or Hive.
+func StringRows(rows, cols int, seed uint64) [][]any {
+	r := newRand(seed)
+	data := make([][]any, rows)
+	for i := range data {
+		row := make([]any, cols)
+		for j := range row {
+			switch j % 3 {
+			case 0:
+				row[j] = strconv.FormatInt(r.Int64N(1_000_000), 10)
+			case 1:
+				row[j] = baseTime.Add(time.Duration(r.Int64N(1e6)) * time.Second).Format(time.DateTime)
+			default:
+				row[j] = "true"
+			}
+		}
+		data[i] = row
+	}
+	return data
+}
+
+// value returns a random value whose type depends on the column index.
+func value(r *rand.Rand, col int) any {
+	switch col % 5 {
+	case 0:
+		return r.Int64N(1_000_000)
+	case 1:
+		return r.Float64() * 1000
+	case 2:
+		return "value-" + strconv.FormatInt(r.Int64N(1000), 10)
+	case 3:
+		return r.IntN(2) == 0
+	default:
+		return baseTime.Add(time.Duration(r.Int64N(1e9)) * time.Millisecond)
+	}
+}
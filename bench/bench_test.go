@@ -0,0 +1,104 @@
+package bench
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/go-data-exporter/exporter"
+	"github.com/go-data-exporter/exporter/codec"
+	jsoncodec "github.com/go-data-exporter/exporter/codec/json"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// datasets are the generated inputs shared by all benchmarks.
+var datasets = []struct {
+	name string
+	data [][]any
+}{
+	{"wide", WideRows(2_000, 50, 1)},
+	{"long-strings", LongStrings(2_000, 1_000, 2)},
+	{"many-nulls", ManyNULLs(2_000, 50, 0.7, 3)},
+}
+
+// codecs are the codecs under benchmark.
+var codecs = []struct {
+	name string
+	new  func() codec.Codec
+}{
+	{"csv", func() codec.Codec { return codec.CSV() }},
+	{"json", func() codec.Codec { return codec.JSON() }},
+	{"ndjson", func() codec.Codec { return codec.JSON(jsoncodec.WithNewlineDelimited(true)) }},
+	{"html", func() codec.Codec { return codec.HTML() }},
+	{"xml", func() codec.Codec { return codec.XML() }},
+}
+
+func BenchmarkCodecs(b *testing.B) {
+	for _, ds := range datasets {
+		for _, c := range codecs {
+			b.Run(ds.name+"/"+c.name, func(b *testing.B) {
+				b.ReportAllocs()
+				cc := c.new()
+				for range b.N {
+					if err := cc.Write(scanner.FromData(ds.data), io.Discard); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(float64(len(ds.data)*b.N)/b.Elapsed().Seconds(), "rows/s")
+			})
+		}
+	}
+}
+
+func BenchmarkScannerWrappers(b *testing.B) {
+	data := StringRows(2_000, 30, 4)
+	schema := map[string]scanner.TargetType{}
+	for i := range 30 {
+		schema[fmt.Sprintf("column_%d", i)] = []scanner.TargetType{scanner.TypeInt64, scanner.TypeTime, scanner.TypeBool}[i%3]
+	}
+	wrappers := []struct {
+		name string
+		wrap func(scanner.Rows) scanner.Rows
+	}{
+		{"none", func(r scanner.Rows) scanner.Rows { return r }},
+		{"cast", func(r scanner.Rows) scanner.Rows { return scanner.Cast(r, schema) }},
+	}
+	for _, w := range wrappers {
+		b.Run(w.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				rows := w.wrap(scanner.FromData(data))
+				if _, err := rows.Columns(); err != nil {
+					b.Fatal(err)
+				}
+				for rows.Next() {
+					if _, err := rows.ScanRow(); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkExporterTransforms(b *testing.B) {
+	data := WideRows(2_000, 50, 5)
+	options := []struct {
+		name string
+		opts []exporter.Option
+	}{
+		{"none", nil},
+		{"sanitize", []exporter.Option{exporter.WithSanitize(true, true), exporter.WithMaxCellLength(32, "…")}},
+		{"value-map", []exporter.Option{exporter.WithValueMap("column_3", map[any]string{true: "yes", false: "no"}, "")}},
+	}
+	for _, o := range options {
+		b.Run(o.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				if err := exporter.New(scanner.FromData(data), codec.CSV(), o.opts...).Write(io.Discard); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// This file implements writing large objects to files of their own.
+
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// WithBlobFiles writes every scanner.Blob value to a file of its own in dir and
+// replaces the value with the path of the file, so that the export references
+// large objects instead of embedding them. The name function returns the file name
+// of a cell relative to dir; if nil, files are named <column>_<row ID>.bin.
+// Existing files are overwritten.
+func WithBlobFiles(dir string, name func(metadata scanner.Metadata) string) Option {
+	if name == nil {
+		name = defaultBlobFileName
+	}
+	return func(e *Exporter) {
+		e.transforms = append(e.transforms, transform{fn: func(v any, metadata scanner.Metadata) (any, error) {
+			b, ok := v.(scanner.Blob)
+			if !ok {
+				return v, nil
+			}
+			path := filepath.Join(dir, name(metadata))
+			if err := writeBlobFile(path, b); err != nil {
+				return nil, err
+			}
+			return path, nil
+		}})
+	}
+}
+
+// defaultBlobFileName names blob files after the column and the row.
+func defaultBlobFileName(metadata scanner.Metadata) string {
+	column := ""
+	if metadata.Column != nil {
+		column = strings.NewReplacer("/", "_", `\`, "_").Replace(metadata.Column.Name())
+	}
+	return fmt.Sprintf("%s_%d.bin", column, metadata.RowID)
+}
+
+// writeBlobFile streams the contents of b to the file at path.
+func writeBlobFile(path string, b scanner.Blob) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, b); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write blob file %s: %w", path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,64 @@
+// This file implements in-memory export helpers.
+
+package exporter
+
+import (
+	"bytes"
+	"errors"
+)
+
+// ErrTooLarge is returned by Bytes and String when the export exceeds
+// the size configured with WithMaxBufferSize.
+var ErrTooLarge = errors.New("exporter: export exceeds maximum buffer size")
+
+// WithMaxBufferSize limits the number of bytes Bytes and String may buffer in memory.
+// A non-positive value means no limit (default).
+func WithMaxBufferSize(n int) Option {
+	return func(e *Exporter) {
+		e.maxBufferSize = n
+	}
+}
+
+// Bytes runs the export and returns the encoded data buffered in memory.
+// It returns ErrTooLarge as soon as the output exceeds the limit set with WithMaxBufferSize.
+func (cs *Exporter) Bytes() ([]byte, error) {
+	buf := &limitedBuffer{limit: cs.maxBufferSize}
+	err := cs.Write(buf)
+	if buf.err != nil {
+		// Codecs may ignore write errors, so the limit is checked explicitly.
+		return nil, buf.err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// String runs the export and returns the encoded data as a string. See Bytes.
+func (cs *Exporter) String() (string, error) {
+	data, err := cs.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// limitedBuffer is a bytes.Buffer that refuses to grow beyond limit bytes.
+// Once the limit is exceeded, all further writes fail.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+	err   error
+}
+
+// Write appends p to the buffer, or returns ErrTooLarge if the limit would be exceeded.
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	if b.limit > 0 && b.Len()+len(p) > b.limit {
+		b.err = ErrTooLarge
+		return 0, b.err
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,131 @@
+// This file implements a fluent builder composing rows, codec and destinations.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-data-exporter/exporter/codec"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// Builder assembles an export in a single chain, e.g.
+//
+//	err := exporter.From(rows).
+//		Select("id", "email").
+//		Mask("email").
+//		Limit(1000).
+//		As(codec.CSV()).
+//		To(dest).
+//		Run(ctx)
+//
+// Select and Limit wrap the rows with scanner.Select and scanner.Limit, in the order
+// they are called; the options of Mask and With apply as if passed to New.
+type Builder struct {
+	rows  scanner.Rows
+	codec codec.Codec
+	dests []Destination
+	opts  []Option
+}
+
+// From starts a Builder exporting rows.
+func From(rows scanner.Rows) *Builder {
+	return &Builder{rows: rows}
+}
+
+// Select limits the export to the named columns, in the given order.
+func (b *Builder) Select(columns ...string) *Builder {
+	b.rows = scanner.Select(b.rows, columns...)
+	return b
+}
+
+// Mask replaces the non-NULL values of the named columns with DefaultMask.
+// Use With(WithMaskedColumns(...)) for a different mask.
+func (b *Builder) Mask(columns ...string) *Builder {
+	return b.With(WithMaskedColumns(DefaultMask, columns...))
+}
+
+// Limit ends the export after n rows.
+func (b *Builder) Limit(n int64) *Builder {
+	b.rows = scanner.Limit(b.rows, n)
+	return b
+}
+
+// As sets the codec of the export.
+func (b *Builder) As(c codec.Codec) *Builder {
+	b.codec = c
+	return b
+}
+
+// To adds destinations the export is written to, as with Exporter.WriteDestinations.
+func (b *Builder) To(dests ...Destination) *Builder {
+	b.dests = append(b.dests, dests...)
+	return b
+}
+
+// With adds export options.
+func (b *Builder) With(opts ...Option) *Builder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// Exporter returns the Exporter of the chain, for writing the export with
+// Write or Export instead of Run.
+func (b *Builder) Exporter() *Exporter {
+	return New(b.rows, b.codec, b.opts...)
+}
+
+// Run writes the export to the destinations. Reading the rows stops with the
+// error of ctx once it is done.
+func (b *Builder) Run(ctx context.Context) error {
+	_, err := b.run(ctx, b.rows)
+	return err
+}
+
+// run writes rows, the possibly wrapped rows of the chain, to the destinations
+// and returns the export statistics.
+func (b *Builder) run(ctx context.Context, rows scanner.Rows) (Stats, error) {
+	if b.codec == nil {
+		return Stats{}, errors.New("exporter: no codec set, call As")
+	}
+	if len(b.dests) == 0 {
+		return Stats{}, errors.New("exporter: no destination set, call To")
+	}
+	rows = &contextRows{Rows: rows, ctx: ctx}
+	return New(rows, b.codec, b.opts...).exportDestinations(b.dests)
+}
+
+// contextRows wraps a Rows and stops reading once a context is done.
+type contextRows struct {
+	scanner.Rows
+	ctx context.Context
+}
+
+// Next advances to the next row unless the context is done.
+func (c *contextRows) Next() bool {
+	return c.ctx.Err() == nil && c.Rows.Next()
+}
+
+// Err returns the error of the context if it is done, or the error of the underlying rows.
+func (c *contextRows) Err() error {
+	if err := c.Rows.Err(); err != nil {
+		return err
+	}
+	return c.ctx.Err()
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (c *contextRows) RawValue(column int) (any, bool) {
+	return scanner.RawValue(c.Rows, column)
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (c *contextRows) Close() error {
+	return scanner.Close(c.Rows)
+}
+
+// EstimateRows returns the row estimate of the underlying rows if they implement Counter.
+func (c *contextRows) EstimateRows() (int64, bool) {
+	return scanner.EstimateRows(c.Rows)
+}
@@ -0,0 +1,171 @@
+// Package charset provides legacy character encodings for text output, such as
+// Windows-1252 and Shift_JIS, required by systems that cannot read UTF-8.
+// Codecs apply a Charset by wrapping their writer with NewWriter and declaring its
+// name, e.g. in the HTML meta tag or the XML declaration.
+package charset
+
+import (
+	"io"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Charset is a character encoding of Unicode text.
+type Charset struct {
+	name   string
+	encode func(dst []byte, r rune) ([]byte, bool)
+}
+
+// Name returns the IANA name of the charset, as used in charset declarations.
+func (c *Charset) Name() string {
+	if c == nil {
+		return UTF8.name
+	}
+	return c.name
+}
+
+// IsUTF8 reports whether the charset is UTF-8, which needs no conversion.
+// A nil Charset is UTF-8.
+func (c *Charset) IsUTF8() bool {
+	return c == nil || c == UTF8
+}
+
+var (
+	// UTF8 is the UTF-8 encoding, written unchanged.
+	UTF8 = &Charset{name: "UTF-8", encode: func(dst []byte, r rune) ([]byte, bool) {
+		return utf8.AppendRune(dst, r), true
+	}}
+
+	// ISO88591 is ISO-8859-1 (Latin-1), covering U+0000 to U+00FF.
+	ISO88591 = &Charset{name: "ISO-8859-1", encode: encodeLatin1}
+
+	// Windows1252 is the Windows code page 1252, a superset of ISO-8859-1 for
+	// Western European languages with typographic quotes, dashes and the euro sign.
+	Windows1252 = &Charset{name: "windows-1252", encode: encodeWindows1252}
+
+	// ShiftJIS is Shift_JIS with the Windows extensions (Windows-31J, code page 932),
+	// the encoding Japanese systems usually expect under this name.
+	ShiftJIS = &Charset{name: "Shift_JIS", encode: encodeShiftJIS}
+)
+
+// Fallback appends the replacement of a rune the charset cannot encode to dst.
+// The replacement must consist of ASCII characters.
+type Fallback func(dst []byte, r rune) []byte
+
+// Replace is a Fallback that writes a question mark.
+func Replace(dst []byte, _ rune) []byte {
+	return append(dst, '?')
+}
+
+// CharacterReference is a Fallback that writes a numeric character reference,
+// e.g. &#8364; for the euro sign, preserving the rune in HTML and XML documents.
+func CharacterReference(dst []byte, r rune) []byte {
+	dst = append(dst, "&#"...)
+	dst = strconv.AppendInt(dst, int64(r), 10)
+	return append(dst, ';')
+}
+
+// writer converts UTF-8 text to a charset.
+type writer struct {
+	w        io.Writer
+	charset  *Charset
+	fallback Fallback
+	partial  []byte // Incomplete UTF-8 sequence at the end of the last write.
+	buf      []byte
+}
+
+// NewWriter returns a writer that converts the UTF-8 text written to it to cs and
+// writes it to w. Runes cs cannot encode, and invalid UTF-8, are replaced using
+// fallback. A UTF-8 sequence split across writes is completed by the next write.
+// If cs is UTF-8, w is returned unchanged.
+func NewWriter(w io.Writer, cs *Charset, fallback Fallback) io.Writer {
+	if cs.IsUTF8() {
+		return w
+	}
+	return &writer{w: w, charset: cs, fallback: fallback}
+}
+
+// Write converts p and writes the result to the underlying writer.
+func (w *writer) Write(p []byte) (int, error) {
+	n := len(p)
+	if len(w.partial) != 0 {
+		p = append(w.partial[:len(w.partial):len(w.partial)], p...)
+		w.partial = w.partial[:0]
+	}
+	buf := w.buf[:0]
+	for len(p) != 0 {
+		if p[0] < utf8.RuneSelf {
+			buf = append(buf, p[0])
+			p = p[1:]
+			continue
+		}
+		if !utf8.FullRune(p) {
+			w.partial = append(w.partial, p...)
+			break
+		}
+		r, size := utf8.DecodeRune(p)
+		p = p[size:]
+		var ok bool
+		if r != utf8.RuneError || size != 1 {
+			buf, ok = w.charset.encode(buf, r)
+		}
+		if !ok {
+			buf = w.fallback(buf, r)
+		}
+	}
+	w.buf = buf
+	if _, err := w.w.Write(buf); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// encodeLatin1 encodes runes up to U+00FF as a single byte.
+func encodeLatin1(dst []byte, r rune) ([]byte, bool) {
+	if r > 0xFF {
+		return dst, false
+	}
+	return append(dst, byte(r)), true
+}
+
+// windows1252 maps the runes of the code points 0x80 to 0x9F of Windows-1252.
+var windows1252 = map[rune]byte{
+	0x20AC: 0x80, 0x201A: 0x82, 0x0192: 0x83, 0x201E: 0x84, 0x2026: 0x85, 0x2020: 0x86,
+	0x2021: 0x87, 0x02C6: 0x88, 0x2030: 0x89, 0x0160: 0x8A, 0x2039: 0x8B, 0x0152: 0x8C,
+	0x017D: 0x8E, 0x2018: 0x91, 0x2019: 0x92, 0x201C: 0x93, 0x201D: 0x94, 0x2022: 0x95,
+	0x2013: 0x96, 0x2014: 0x97, 0x02DC: 0x98, 0x2122: 0x99, 0x0161: 0x9A, 0x203A: 0x9B,
+	0x0153: 0x9C, 0x017E: 0x9E, 0x0178: 0x9F,
+}
+
+// encodeWindows1252 encodes a rune in Windows-1252.
+func encodeWindows1252(dst []byte, r rune) ([]byte, bool) {
+	if r < 0x80 || (r >= 0xA0 && r <= 0xFF) {
+		return append(dst, byte(r)), true
+	}
+	if b, ok := windows1252[r]; ok {
+		return append(dst, b), true
+	}
+	return dst, false
+}
+
+// encodeShiftJIS encodes a rune in Windows-31J.
+func encodeShiftJIS(dst []byte, r rune) ([]byte, bool) {
+	if r < 0x80 {
+		return append(dst, byte(r)), true
+	}
+	if r > 0xFFFF {
+		return dst, false
+	}
+	i, found := slices.BinarySearchFunc(shiftJISTable[:], r, func(entry uint32, r rune) int {
+		return int(entry>>16) - int(r)
+	})
+	if !found {
+		return dst, false
+	}
+	code := uint16(shiftJISTable[i])
+	if code <= 0xFF {
+		return append(dst, byte(code)), true
+	}
+	return append(dst, byte(code>>8), byte(code)), true
+}
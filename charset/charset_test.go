@@ -0,0 +1,34 @@
+package charset
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewWriter(t *testing.T) {
+	for _, tc := range []struct {
+		charset  *Charset
+		fallback Fallback
+		input    string
+		want     string
+	}{
+		{Windows1252, Replace, "Café – 5 €", "Caf\xe9 \x96 5 \x80"},
+		{ISO88591, Replace, "Café – 5 €", "Caf\xe9 ? 5 ?"},
+		{ShiftJIS, Replace, "日本 ｱ abc", "\x93\xfa\x96\x7b \xb1 abc"},
+		{ShiftJIS, CharacterReference, "a😀b", "a&#128512;b"},
+		{Windows1252, Replace, "a\xffb", "a?b"},
+		{UTF8, Replace, "日本", "日本"},
+	} {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, tc.charset, tc.fallback)
+		// Write one byte at a time to split every multi-byte sequence.
+		for i := range len(tc.input) {
+			if _, err := w.Write([]byte{tc.input[i]}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if buf.String() != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.charset.Name(), buf.String(), tc.want)
+		}
+	}
+}
@@ -0,0 +1,1170 @@
+// Package charset provides legacy character encodings for text output.
+// This file holds the Shift_JIS table, derived from the Windows code page 932
+// mapping of the BMP; do not edit it by hand.
+package charset
+
+// shiftJISTable maps Unicode code points to Windows-31J codes, one entry per rune
+// above U+007F as rune<<16 | code, sorted by rune. Single-byte codes (half-width
+// katakana) have a zero high byte.
+var shiftJISTable = [...]uint32{
+	0x00800080, 0x00A28191, 0x00A38192, 0x00A78198, 0x00A8814E, 0x00AC81CA, 0x00B0818B, 0x00B1817D,
+	0x00B4814C, 0x00B681F7, 0x00D7817E, 0x00F78180, 0x0391839F, 0x039283A0, 0x039383A1, 0x039483A2,
+	0x039583A3, 0x039683A4, 0x039783A5, 0x039883A6, 0x039983A7, 0x039A83A8, 0x039B83A9, 0x039C83AA,
+	0x039D83AB, 0x039E83AC, 0x039F83AD, 0x03A083AE, 0x03A183AF, 0x03A383B0, 0x03A483B1, 0x03A583B2,
+	0x03A683B3, 0x03A783B4, 0x03A883B5, 0x03A983B6, 0x03B183BF, 0x03B283C0, 0x03B383C1, 0x03B483C2,
+	0x03B583C3, 0x03B683C4, 0x03B783C5, 0x03B883C6, 0x03B983C7, 0x03BA83C8, 0x03BB83C9, 0x03BC83CA,
+	0x03BD83CB, 0x03BE83CC, 0x03BF83CD, 0x03C083CE, 0x03C183CF, 0x03C383D0, 0x03C483D1, 0x03C583D2,
+	0x03C683D3, 0x03C783D4, 0x03C883D5, 0x03C983D6, 0x04018446, 0x04108440, 0x04118441, 0x04128442,
+	0x04138443, 0x04148444, 0x04158445, 0x04168447, 0x04178448, 0x04188449, 0x0419844A, 0x041A844B,
+	0x041B844C, 0x041C844D, 0x041D844E, 0x041E844F, 0x041F8450, 0x04208451, 0x04218452, 0x04228453,
+	0x04238454, 0x04248455, 0x04258456, 0x04268457, 0x04278458, 0x04288459, 0x0429845A, 0x042A845B,
+	0x042B845C, 0x042C845D, 0x042D845E, 0x042E845F, 0x042F8460, 0x04308470, 0x04318471, 0x04328472,
+	0x04338473, 0x04348474, 0x04358475, 0x04368477, 0x04378478, 0x04388479, 0x0439847A, 0x043A847B,
+	0x043B847C, 0x043C847D, 0x043D847E, 0x043E8480, 0x043F8481, 0x04408482, 0x04418483, 0x04428484,
+	0x04438485, 0x04448486, 0x04458487, 0x04468488, 0x04478489, 0x0448848A, 0x0449848B, 0x044A848C,
+	0x044B848D, 0x044C848E, 0x044D848F, 0x044E8490, 0x044F8491, 0x04518476, 0x2010815D, 0x2015815C,
+	0x20168161, 0x20188165, 0x20198166, 0x201C8167, 0x201D8168, 0x202081F5, 0x202181F6, 0x20258164,
+	0x20268163, 0x203081F1, 0x2032818C, 0x2033818D, 0x203B81A6, 0x2103818E, 0x21168782, 0x21218784,
+	0x212B81F0, 0x21608754, 0x21618755, 0x21628756, 0x21638757, 0x21648758, 0x21658759, 0x2166875A,
+	0x2167875B, 0x2168875C, 0x2169875D, 0x2170EEEF, 0x2171EEF0, 0x2172EEF1, 0x2173EEF2, 0x2174EEF3,
+	0x2175EEF4, 0x2176EEF5, 0x2177EEF6, 0x2178EEF7, 0x2179EEF8, 0x219081A9, 0x219181AA, 0x219281A8,
+	0x219381AB, 0x21D281CB, 0x21D481CC, 0x220081CD, 0x220281DD, 0x220381CE, 0x220781DE, 0x220881B8,
+	0x220B81B9, 0x22118794, 0x2212817C, 0x221A81E3, 0x221D81E5, 0x221E8187, 0x221F8798, 0x222081DA,
+	0x22258161, 0x222781C8, 0x222881C9, 0x222981BF, 0x222A81BE, 0x222B81E7, 0x222C81E8, 0x222E8793,
+	0x22348188, 0x223581E6, 0x223D81E4, 0x225281E0, 0x22608182, 0x226181DF, 0x22668185, 0x22678186,
+	0x226A81E1, 0x226B81E2, 0x228281BC, 0x228381BD, 0x228681BA, 0x228781BB, 0x22A581DB, 0x22BF8799,
+	0x231281DC, 0x24608740, 0x24618741, 0x24628742, 0x24638743, 0x24648744, 0x24658745, 0x24668746,
+	0x24678747, 0x24688748, 0x24698749, 0x246A874A, 0x246B874B, 0x246C874C, 0x246D874D, 0x246E874E,
+	0x246F874F, 0x24708750, 0x24718751, 0x24728752, 0x24738753, 0x2500849F, 0x250184AA, 0x250284A0,
+	0x250384AB, 0x250C84A1, 0x250F84AC, 0x251084A2, 0x251384AD, 0x251484A4, 0x251784AF, 0x251884A3,
+	0x251B84AE, 0x251C84A5, 0x251D84BA, 0x252084B5, 0x252384B0, 0x252484A7, 0x252584BC, 0x252884B7,
+	0x252B84B2, 0x252C84A6, 0x252F84B6, 0x253084BB, 0x253384B1, 0x253484A8, 0x253784B8, 0x253884BD,
+	0x253B84B3, 0x253C84A9, 0x253F84B9, 0x254284BE, 0x254B84B4, 0x25A081A1, 0x25A181A0, 0x25B281A3,
+	0x25B381A2, 0x25BC81A5, 0x25BD81A4, 0x25C6819F, 0x25C7819E, 0x25CB819B, 0x25CE819D, 0x25CF819C,
+	0x25EF81FC, 0x2605819A, 0x26068199, 0x2640818A, 0x26428189, 0x266A81F4, 0x266D81F3, 0x266F81F2,
+	0x30008140, 0x30018141, 0x30028142, 0x30038156, 0x30058158, 0x30068159, 0x3007815A, 0x30088171,
+	0x30098172, 0x300A8173, 0x300B8174, 0x300C8175, 0x300D8176, 0x300E8177, 0x300F8178, 0x30108179,
+	0x3011817A, 0x301281A7, 0x301381AC, 0x3014816B, 0x3015816C, 0x301C8160, 0x301D8780, 0x301F8781,
+	0x3041829F, 0x304282A0, 0x304382A1, 0x304482A2, 0x304582A3, 0x304682A4, 0x304782A5, 0x304882A6,
+	0x304982A7, 0x304A82A8, 0x304B82A9, 0x304C82AA, 0x304D82AB, 0x304E82AC, 0x304F82AD, 0x305082AE,
+	0x305182AF, 0x305282B0, 0x305382B1, 0x305482B2, 0x305582B3, 0x305682B4, 0x305782B5, 0x305882B6,
+	0x305982B7, 0x305A82B8, 0x305B82B9, 0x305C82BA, 0x305D82BB, 0x305E82BC, 0x305F82BD, 0x306082BE,
+	0x306182BF, 0x306282C0, 0x306382C1, 0x306482C2, 0x306582C3, 0x306682C4, 0x306782C5, 0x306882C6,
+	0x306982C7, 0x306A82C8, 0x306B82C9, 0x306C82CA, 0x306D82CB, 0x306E82CC, 0x306F82CD, 0x307082CE,
+	0x307182CF, 0x307282D0, 0x307382D1, 0x307482D2, 0x307582D3, 0x307682D4, 0x307782D5, 0x307882D6,
+	0x307982D7, 0x307A82D8, 0x307B82D9, 0x307C82DA, 0x307D82DB, 0x307E82DC, 0x307F82DD, 0x308082DE,
+	0x308182DF, 0x308282E0, 0x308382E1, 0x308482E2, 0x308582E3, 0x308682E4, 0x308782E5, 0x308882E6,
+	0x308982E7, 0x308A82E8, 0x308B82E9, 0x308C82EA, 0x308D82EB, 0x308E82EC, 0x308F82ED, 0x309082EE,
+	0x309182EF, 0x309282F0, 0x309382F1, 0x309B814A, 0x309C814B, 0x309D8154, 0x309E8155, 0x30A18340,
+	0x30A28341, 0x30A38342, 0x30A48343, 0x30A58344, 0x30A68345, 0x30A78346, 0x30A88347, 0x30A98348,
+	0x30AA8349, 0x30AB834A, 0x30AC834B, 0x30AD834C, 0x30AE834D, 0x30AF834E, 0x30B0834F, 0x30B18350,
+	0x30B28351, 0x30B38352, 0x30B48353, 0x30B58354, 0x30B68355, 0x30B78356, 0x30B88357, 0x30B98358,
+	0x30BA8359, 0x30BB835A, 0x30BC835B, 0x30BD835C, 0x30BE835D, 0x30BF835E, 0x30C0835F, 0x30C18360,
+	0x30C28361, 0x30C38362, 0x30C48363, 0x30C58364, 0x30C68365, 0x30C78366, 0x30C88367, 0x30C98368,
+	0x30CA8369, 0x30CB836A, 0x30CC836B, 0x30CD836C, 0x30CE836D, 0x30CF836E, 0x30D0836F, 0x30D18370,
+	0x30D28371, 0x30D38372, 0x30D48373, 0x30D58374, 0x30D68375, 0x30D78376, 0x30D88377, 0x30D98378,
+	0x30DA8379, 0x30DB837A, 0x30DC837B, 0x30DD837C, 0x30DE837D, 0x30DF837E, 0x30E08380, 0x30E18381,
+	0x30E28382, 0x30E38383, 0x30E48384, 0x30E58385, 0x30E68386, 0x30E78387, 0x30E88388, 0x30E98389,
+	0x30EA838A, 0x30EB838B, 0x30EC838C, 0x30ED838D, 0x30EE838E, 0x30EF838F, 0x30F08390, 0x30F18391,
+	0x30F28392, 0x30F38393, 0x30F48394, 0x30F58395, 0x30F68396, 0x30FB8145, 0x30FC815B, 0x30FD8152,
+	0x30FE8153, 0x3231878A, 0x3232878B, 0x3239878C, 0x32A48785, 0x32A58786, 0x32A68787, 0x32A78788,
+	0x32A88789, 0x33038765, 0x330D8769, 0x33148760, 0x33188763, 0x33228761, 0x3323876B, 0x3326876A,
+	0x33278764, 0x332B876C, 0x33368766, 0x333B876E, 0x3349875F, 0x334A876D, 0x334D8762, 0x33518767,
+	0x33578768, 0x337B877E, 0x337C878F, 0x337D878E, 0x337E878D, 0x338E8772, 0x338F8773, 0x339C876F,
+	0x339D8770, 0x339E8771, 0x33A18775, 0x33C48774, 0x33CD8783, 0x4E0088EA, 0x4E01929A, 0x4E038EB5,
+	0x4E07969C, 0x4E088FE4, 0x4E098E4F, 0x4E0A8FE3, 0x4E0B89BA, 0x4E0D9573, 0x4E0E975E, 0x4E1098A0,
+	0x4E11894E, 0x4E148A8E, 0x4E1598A1, 0x4E1690A2, 0x4E1799C0, 0x4E188B75, 0x4E1995B8, 0x4E1E8FE5,
+	0x4E2197BC, 0x4E2695C0, 0x4E28ED4C, 0x4E2A98A2, 0x4E2D9286, 0x4E3198A3, 0x4E328BF8, 0x4E3698A4,
+	0x4E388ADB, 0x4E39924F, 0x4E3B8EE5, 0x4E3C98A5, 0x4E3F98A6, 0x4E4298A7, 0x4E439454, 0x4E458B76,
+	0x4E4B9456, 0x4E4D93E1, 0x4E4E8CC1, 0x4E4F9652, 0x4E55E568, 0x4E5698A8, 0x4E578FE6, 0x4E5898A9,
+	0x4E5989B3, 0x4E5D8BE3, 0x4E5E8CEE, 0x4E5F96E7, 0x4E629BA4, 0x4E719790, 0x4E7393FB, 0x4E7E8AA3,
+	0x4E808B54, 0x4E8298AA, 0x4E8598AB, 0x4E8697B9, 0x4E88975C, 0x4E899188, 0x4E8A98AD, 0x4E8B8E96,
+	0x4E8C93F1, 0x4E8E98B0, 0x4E91895D, 0x4E928CDD, 0x4E948CDC, 0x4E9588E4, 0x4E98986A, 0x4E999869,
+	0x4E9B8DB1, 0x4E9C889F, 0x4E9E98B1, 0x4E9F98B2, 0x4EA098B3, 0x4EA19653, 0x4EA298B4, 0x4EA48CF0,
+	0x4EA588E5, 0x4EA69692, 0x4EA88B9C, 0x4EAB8B9D, 0x4EAC8B9E, 0x4EAD92E0, 0x4EAE97BA, 0x4EB098B5,
+	0x4EB398B6, 0x4EB698B7, 0x4EBA906C, 0x4EC08F59, 0x4EC1906D, 0x4EC298BC, 0x4EC498BA, 0x4EC698BB,
+	0x4EC78B77, 0x4ECA8DA1, 0x4ECB89EE, 0x4ECD98B9, 0x4ECE98B8, 0x4ECF95A7, 0x4ED48E65, 0x4ED58E64,
+	0x4ED691BC, 0x4ED798BD, 0x4ED89574, 0x4ED990E5, 0x4EDD8157, 0x4EDE98BE, 0x4EDF98C0, 0x4EE1ED4D,
+	0x4EE391E3, 0x4EE497DF, 0x4EE588C8, 0x4EED98BF, 0x4EEE89BC, 0x4EF08BC2, 0x4EF29287, 0x4EF68C8F,
+	0x4EF798C1, 0x4EFB9443, 0x4EFCED4E, 0x4F00ED4F, 0x4F018AE9, 0x4F03ED50, 0x4F0998C2, 0x4F0A88C9,
+	0x4F0D8CDE, 0x4F0E8AEA, 0x4F0F959A, 0x4F1094B0, 0x4F118B78, 0x4F1A89EF, 0x4F1C98E5, 0x4F1D9360,
+	0x4F2F948C, 0x4F3098C4, 0x4F3494BA, 0x4F3697E0, 0x4F38904C, 0x4F39ED51, 0x4F3A8E66, 0x4F3C8E97,
+	0x4F3D89BE, 0x4F4392CF, 0x4F469241, 0x4F4798C8, 0x4F4D88CA, 0x4F4E92E1, 0x4F4F8F5A, 0x4F508DB2,
+	0x4F519743, 0x4F5391CC, 0x4F5589BD, 0x4F56ED52, 0x4F5798C7, 0x4F59975D, 0x4F5A98C3, 0x4F5B98C5,
+	0x4F5C8DEC, 0x4F5D98C6, 0x4F5E9B43, 0x4F6998CE, 0x4F6F98D1, 0x4F7098CF, 0x4F7389C0, 0x4F7595B9,
+	0x4F7698C9, 0x4F7B98CD, 0x4F7C8CF1, 0x4F7F8E67, 0x4F838AA4, 0x4F8698D2, 0x4F8898CA, 0x4F8AED54,
+	0x4F8B97E1, 0x4F8D8E98, 0x4F8F98CB, 0x4F9198D0, 0x4F92ED53, 0x4F94ED56, 0x4F9698D3, 0x4F9898CC,
+	0x4F9AED55, 0x4F9B8B9F, 0x4F9D88CB, 0x4FA08BA0, 0x4FA189BF, 0x4FAB9B44, 0x4FAD9699, 0x4FAE958E,
+	0x4FAF8CF2, 0x4FB5904E, 0x4FB697B5, 0x4FBF95D6, 0x4FC28C57, 0x4FC391A3, 0x4FC489E2, 0x4FC9ED45,
+	0x4FCA8F72, 0x4FCDED57, 0x4FCE98D7, 0x4FD098DC, 0x4FD198DA, 0x4FD498D5, 0x4FD791AD, 0x4FD898D8,
+	0x4FDA98DB, 0x4FDB98D9, 0x4FDD95DB, 0x4FDF98D6, 0x4FE1904D, 0x4FE39693, 0x4FE498DD, 0x4FE598DE,
+	0x4FEE8F43, 0x4FEF98EB, 0x4FF3946F, 0x4FF59555, 0x4FF698E6, 0x4FF895EE, 0x4FFA89B4, 0x4FFE98EA,
+	0x4FFFED5A, 0x500598E4, 0x500698ED, 0x50099171, 0x500B8CC2, 0x500D947B, 0x500FE0C5, 0x501198EC,
+	0x5012937C, 0x501498E1, 0x50168CF4, 0x50198CF3, 0x501A98DF, 0x501EED5B, 0x501F8ED8, 0x502198E7,
+	0x5022ED59, 0x502395ED, 0x5024926C, 0x502598E3, 0x50268C91, 0x502898E0, 0x502998E8, 0x502A98E2,
+	0x502B97CF, 0x502C98E9, 0x502D9860, 0x50368BE4, 0x50398C90, 0x5040ED58, 0x5042ED5E, 0x504398EE,
+	0x5046ED5C, 0x504798EF, 0x504898F3, 0x504988CC, 0x504F95CE, 0x505098F2, 0x505598F1, 0x505698F5,
+	0x505A98F4, 0x505C92E2, 0x50658C92, 0x506C98F6, 0x5070ED5D, 0x50728EC3, 0x507491A4, 0x507592E3,
+	0x50768BF4, 0x507898F7, 0x507D8B55, 0x508098F8, 0x508598FA, 0x508D9654, 0x50918C86, 0x5094ED5F,
+	0x50988E50, 0x509994F5, 0x509A98F9, 0x50AC8DC3, 0x50AD9762, 0x50B298FC, 0x50B39942, 0x50B498FB,
+	0x50B58DC2, 0x50B78F9D, 0x50BE8C58, 0x50C29943, 0x50C58BCD, 0x50C99940, 0x50CA9941, 0x50CD93AD,
+	0x50CF919C, 0x50D18BA1, 0x50D5966C, 0x50D69944, 0x50D8ED61, 0x50DA97BB, 0x50DE9945, 0x50E39948,
+	0x50E59946, 0x50E7916D, 0x50ED9947, 0x50EE9949, 0x50F4ED60, 0x50F5994B, 0x50F9994A, 0x50FB95C6,
+	0x51008B56, 0x5101994D, 0x5102994E, 0x510489AD, 0x5109994C, 0x51128EF2, 0x51149951, 0x51159950,
+	0x5116994F, 0x511898D4, 0x511A9952, 0x511F8F9E, 0x51219953, 0x512A9744, 0x513296D7, 0x51379955,
+	0x513A9954, 0x513B9957, 0x513C9956, 0x513F9958, 0x51409959, 0x514188F2, 0x51438CB3, 0x51448C5A,
+	0x51458F5B, 0x5146929B, 0x51478BA2, 0x514890E6, 0x51498CF5, 0x514AED62, 0x514B8D8E, 0x514C995B,
+	0x514D96C6, 0x514E9365, 0x51508E99, 0x5152995A, 0x5154995C, 0x515A937D, 0x515C8A95, 0x5162995D,
+	0x5164ED63, 0x516593FC, 0x51689153, 0x5169995F, 0x516A9960, 0x516B94AA, 0x516C8CF6, 0x516D985A,
+	0x516E9961, 0x51718BA4, 0x517595BA, 0x517691B4, 0x51778BEF, 0x51789354, 0x517C8C93, 0x51809962,
+	0x51829963, 0x518593E0, 0x5186897E, 0x51899966, 0x518A8DFB, 0x518C9965, 0x518D8DC4, 0x518F9967,
+	0x5190E3EC, 0x51919968, 0x51929660, 0x51939969, 0x5195996A, 0x5196996B, 0x51978FE7, 0x51998ECA,
+	0x519DED64, 0x51A08AA5, 0x51A2996E, 0x51A4996C, 0x51A596BB, 0x51A6996D, 0x51A89579, 0x51A9996F,
+	0x51AA9970, 0x51AB9971, 0x51AC937E, 0x51B09975, 0x51B19973, 0x51B29974, 0x51B39972, 0x51B48DE1,
+	0x51B59976, 0x51B696E8, 0x51B797E2, 0x51BD9977, 0x51BEED65, 0x51C490A6, 0x51C59978, 0x51C68F79,
+	0x51C99979, 0x51CB929C, 0x51CC97BD, 0x51CD9380, 0x51D699C3, 0x51DB997A, 0x51DCEAA3, 0x51DD8BC3,
+	0x51E0997B, 0x51E1967D, 0x51E68F88, 0x51E791FA, 0x51E9997D, 0x51EA93E2, 0x51ECED66, 0x51ED997E,
+	0x51F09980, 0x51F18A4D, 0x51F59981, 0x51F68BA5, 0x51F893CA, 0x51F9899A, 0x51FA8F6F, 0x51FD949F,
+	0x51FE9982, 0x52009381, 0x5203906E, 0x52049983, 0x520695AA, 0x520790D8, 0x52088AA0, 0x520A8AA7,
+	0x520B9984, 0x520E9986, 0x52118C59, 0x52149985, 0x5215ED67, 0x521797F1, 0x521D8F89, 0x522494BB,
+	0x522595CA, 0x52279987, 0x52299798, 0x522A9988, 0x522E9989, 0x5230939E, 0x5233998A, 0x523690A7,
+	0x52378DFC, 0x52388C94, 0x5239998B, 0x523A8E68, 0x523B8D8F, 0x524392E4, 0x5244998D, 0x524791A5,
+	0x524A8DED, 0x524B998E, 0x524C998F, 0x524D914F, 0x524F998C, 0x52549991, 0x52569655, 0x525B8D84,
+	0x525E9990, 0x52638C95, 0x52648DDC, 0x5265948D, 0x52699994, 0x526A9992, 0x526F959B, 0x52708FE8,
+	0x5271999B, 0x52728A84, 0x52739995, 0x52749993, 0x5275916E, 0x527D9997, 0x527F9996, 0x52838A63,
+	0x52878C80, 0x5288999C, 0x528997AB, 0x528D9998, 0x5291999D, 0x5292999A, 0x52949999, 0x529B97CD,
+	0x529CED68, 0x529F8CF7, 0x52A089C1, 0x52A397F2, 0x52A6ED69, 0x52A98F95, 0x52AA9377, 0x52AB8D85,
+	0x52AC99A0, 0x52AD99A1, 0x52AFEE5B, 0x52B197E3, 0x52B4984A, 0x52B599A3, 0x52B98CF8, 0x52BC99A2,
+	0x52BE8A4E, 0x52C0ED6A, 0x52C199A4, 0x52C39675, 0x52C592BA, 0x52C79745, 0x52C995D7, 0x52CD99A5,
+	0x52D2E8D3, 0x52D593AE, 0x52D799A6, 0x52D88AA8, 0x52D996B1, 0x52DBED6B, 0x52DD8F9F, 0x52DE99A7,
+	0x52DF95E5, 0x52E099AB, 0x52E290A8, 0x52E399A8, 0x52E48BCE, 0x52E699A9, 0x52E78AA9, 0x52F28C4D,
+	0x52F399AC, 0x52F599AD, 0x52F899AE, 0x52F999AF, 0x52FA8ED9, 0x52FE8CF9, 0x52FF96DC, 0x5300ED6C,
+	0x530196E6, 0x530293F5, 0x530595EF, 0x530699B0, 0x5307ED6D, 0x530899B1, 0x530D99B3, 0x530F99B5,
+	0x531099B4, 0x531599B6, 0x531689BB, 0x5317966B, 0x53198DFA, 0x531A99B7, 0x531D9178, 0x53208FA0,
+	0x53218BA7, 0x532399B8, 0x5324ED6E, 0x532A94D9, 0x532F99B9, 0x533199BA, 0x533399BB, 0x533899BC,
+	0x53399543, 0x533A8BE6, 0x533B88E3, 0x533F93BD, 0x534099BD, 0x53418F5C, 0x534390E7, 0x534599BF,
+	0x534699BE, 0x53478FA1, 0x53488CDF, 0x534999C1, 0x534A94BC, 0x534D99C2, 0x535194DA, 0x535291B2,
+	0x535391EC, 0x53548BA6, 0x535793EC, 0x53589250, 0x535A948E, 0x535C966D, 0x535E99C4, 0x536090E8,
+	0x53668C54, 0x536999C5, 0x536E99C6, 0x536F894B, 0x537088F3, 0x53718AEB, 0x5372ED6F, 0x537391A6,
+	0x53748B70, 0x53759791, 0x537799C9, 0x537889B5, 0x537B99C8, 0x537F8BA8, 0x538299CA, 0x538496EF,
+	0x5393ED70, 0x539699CB, 0x539897D0, 0x539A8CFA, 0x539F8CB4, 0x53A099CC, 0x53A599CE, 0x53A699CD,
+	0x53A8907E, 0x53A98958, 0x53AD897D, 0x53AE99CF, 0x53B099D0, 0x53B2ED71, 0x53B38CB5, 0x53B699D1,
+	0x53BB8B8E, 0x53C28E51, 0x53C399D2, 0x53C89694, 0x53C98DB3, 0x53CA8B79, 0x53CB9746, 0x53CC916F,
+	0x53CD94BD, 0x53CE8EFB, 0x53D48F66, 0x53D68EE6, 0x53D78EF3, 0x53D98F96, 0x53DB94BE, 0x53DDED72,
+	0x53DF99D5, 0x53E18962, 0x53E29170, 0x53E38CFB, 0x53E48CC3, 0x53E58BE5, 0x53E899D9, 0x53E99240,
+	0x53EA91FC, 0x53EB8BA9, 0x53EC8FA2, 0x53ED99DA, 0x53EE99D8, 0x53EF89C2, 0x53F091E4, 0x53F18EB6,
+	0x53F28E6A, 0x53F38945, 0x53F68A90, 0x53F78D86, 0x53F88E69, 0x53FA99DB, 0x540199DC, 0x54038B68,
+	0x54048A65, 0x54088D87, 0x54098B67, 0x540A92DD, 0x540B8944, 0x540C93AF, 0x540D96BC, 0x540E8D40,
+	0x540F9799, 0x54109366, 0x54118CFC, 0x541B8C4E, 0x541D99E5, 0x541F8BE1, 0x54209669, 0x542694DB,
+	0x542999E4, 0x542B8ADC, 0x542C99DF, 0x542D99E0, 0x542E99E2, 0x543699E3, 0x54388B7A, 0x54399081,
+	0x543B95AB, 0x543C99E1, 0x543D99DD, 0x543E8CE1, 0x544099DE, 0x54429843, 0x544695F0, 0x544892E6,
+	0x54498CE0, 0x544A8D90, 0x544E99E6, 0x545193DB, 0x545F99EA, 0x54688EFC, 0x546A8EF4, 0x547099ED,
+	0x547199EB, 0x547396A1, 0x547599E8, 0x547699F1, 0x547799EC, 0x547B99EF, 0x547C8CC4, 0x547D96BD,
+	0x548099F0, 0x548499F2, 0x548699F4, 0x548AED75, 0x548B8DEE, 0x548C9861, 0x548E99E9, 0x548F99E7,
+	0x549099F3, 0x549299EE, 0x549CED74, 0x54A299F6, 0x54A49A42, 0x54A599F8, 0x54A899FC, 0x54A9ED76,
+	0x54AB9A40, 0x54AC99F9, 0x54AF9A5D, 0x54B28DE7, 0x54B38A50, 0x54B899F7, 0x54BC9A44, 0x54BD88F4,
+	0x54BE9A43, 0x54C088A3, 0x54C19569, 0x54C29A41, 0x54C499FA, 0x54C799F5, 0x54C899FB, 0x54C98DC6,
+	0x54D89A45, 0x54E188F5, 0x54E29A4E, 0x54E59A46, 0x54E69A47, 0x54E88FA3, 0x54E99689, 0x54ED9A4C,
+	0x54EE9A4B, 0x54F2934E, 0x54FA9A4D, 0x54FD9A4A, 0x54FFED77, 0x55048953, 0x55068DB4, 0x5507904F,
+	0x550F9A48, 0x55109382, 0x55149A49, 0x551688A0, 0x552E9A53, 0x552F9742, 0x55318FA5, 0x55339A59,
+	0x55389A58, 0x55399A4F, 0x553E91C1, 0x55409A50, 0x554491ED, 0x55459A55, 0x55468FA4, 0x554C9A52,
+	0x554F96E2, 0x55538C5B, 0x55569A56, 0x55579A57, 0x555C9A54, 0x555D9A5A, 0x55639A51, 0x557B9A60,
+	0x557C9A65, 0x557E9A61, 0x55809A5C, 0x55839A66, 0x55849150, 0x5586ED78, 0x55879A68, 0x55898D41,
+	0x558A9A5E, 0x558B929D, 0x55989A62, 0x55999A5B, 0x559A8AAB, 0x559C8AEC, 0x559D8A85, 0x559E9A63,
+	0x559F9A5F, 0x55A78C96, 0x55A89A69, 0x55A99A67, 0x55AA9172, 0x55AB8B69, 0x55AC8BAA, 0x55AE9A64,
+	0x55B08BF2, 0x55B68963, 0x55C49A6D, 0x55C59A6B, 0x55C79AA5, 0x55D49A70, 0x55DA9A6A, 0x55DC9A6E,
+	0x55DF9A6C, 0x55E38E6B, 0x55E49A6F, 0x55F79A72, 0x55F99A77, 0x55FD9A75, 0x55FE9A74, 0x56069251,
+	0x560989C3, 0x56149A71, 0x56169A73, 0x56178FA6, 0x56188952, 0x561B9A76, 0x562989DC, 0x562F9A82,
+	0x56318FFA, 0x56329A7D, 0x56349A7B, 0x56369A7C, 0x56389A7E, 0x5642895C, 0x564C9158, 0x564E9A78,
+	0x56509A79, 0x565B8A9A, 0x56649A81, 0x56688AED, 0x566A9A84, 0x566B9A80, 0x566C9A83, 0x567495AC,
+	0x567893D3, 0x567A94B6, 0x56809A86, 0x56869A85, 0x56878A64, 0x568A9A87, 0x568F9A8A, 0x56949A89,
+	0x56A09A88, 0x56A29458, 0x56A59A8B, 0x56AE9A8C, 0x56B49A8E, 0x56B69A8D, 0x56BC9A90, 0x56C09A93,
+	0x56C19A91, 0x56C29A8F, 0x56C39A92, 0x56C89A94, 0x56CE9A95, 0x56D19A96, 0x56D39A97, 0x56D79A98,
+	0x56D89964, 0x56DA8EFA, 0x56DB8E6C, 0x56DE89F1, 0x56E088F6, 0x56E39263, 0x56EE9A99, 0x56F08DA2,
+	0x56F288CD, 0x56F3907D, 0x56F99A9A, 0x56FA8CC5, 0x56FD8D91, 0x56FF9A9C, 0x57009A9B, 0x570395DE,
+	0x57049A9D, 0x57089A9F, 0x57099A9E, 0x570B9AA0, 0x570D9AA1, 0x570F8C97, 0x57128980, 0x57139AA2,
+	0x57169AA4, 0x57189AA3, 0x571C9AA6, 0x571F9379, 0x57269AA7, 0x572788B3, 0x57288DDD, 0x572D8C5C,
+	0x5730926E, 0x57379AA8, 0x57389AA9, 0x573B9AAB, 0x57409AAC, 0x57428DE2, 0x57478BCF, 0x574A9656,
+	0x574E9AAA, 0x574F9AAD, 0x57508DBF, 0x57518D42, 0x5759ED79, 0x57619AB1, 0x57648DA3, 0x5765ED7A,
+	0x57669252, 0x57699AAE, 0x576A92D8, 0x577F9AB2, 0x57829082, 0x57889AB0, 0x57899AB3, 0x578B8C5E,
+	0x57939AB4, 0x57A09AB5, 0x57A28D43, 0x57A38A5F, 0x57A49AB7, 0x57AA9AB8, 0x57ACED7B, 0x57B09AB9,
+	0x57B39AB6, 0x57C09AAF, 0x57C39ABA, 0x57C69ABB, 0x57C7ED7D, 0x57C8ED7C, 0x57CB9684, 0x57CE8FE9,
+	0x57D29ABD, 0x57D39ABE, 0x57D49ABC, 0x57D69AC0, 0x57DC9457, 0x57DF88E6, 0x57E09575, 0x57E39AC1,
+	0x57F48FFB, 0x57F78EB7, 0x57F9947C, 0x57FA8AEE, 0x57FC8DE9, 0x58009678, 0x580293B0, 0x58058C98,
+	0x580691CD, 0x580A9ABF, 0x580B9AC2, 0x581591C2, 0x58199AC3, 0x581D9AC4, 0x58219AC6, 0x582492E7,
+	0x582A8AAC, 0x582FEA9F, 0x58308981, 0x583195F1, 0x58348FEA, 0x58359367, 0x583A8DE4, 0x583D9ACC,
+	0x584095BB, 0x584197DB, 0x584A89F2, 0x584B9AC8, 0x58519159, 0x58529ACB, 0x58549383, 0x58579368,
+	0x58589384, 0x585994B7, 0x585A92CB, 0x585E8DC7, 0x58629AC7, 0x58698996, 0x586B9355, 0x58709AC9,
+	0x58729AC5, 0x5875906F, 0x58799ACD, 0x587E8F6D, 0x58838BAB, 0x58859ACE, 0x589395E6, 0x5897919D,
+	0x589C92C4, 0x589EED81, 0x589F9AD0, 0x58A8966E, 0x58AB9AD1, 0x58AE9AD6, 0x58B2ED82, 0x58B395AD,
+	0x58B89AD5, 0x58B99ACF, 0x58BA9AD2, 0x58BB9AD4, 0x58BE8DA4, 0x58C195C7, 0x58C59AD7, 0x58C79264,
+	0x58CA89F3, 0x58CC8FEB, 0x58D19AD9, 0x58D39AD8, 0x58D58D88, 0x58D79ADA, 0x58D89ADC, 0x58D99ADB,
+	0x58DC9ADE, 0x58DE9AD3, 0x58DF9AE0, 0x58E49ADF, 0x58E59ADD, 0x58EB8E6D, 0x58EC9070, 0x58EE9173,
+	0x58EF9AE1, 0x58F090BA, 0x58F188EB, 0x58F29484, 0x58F792D9, 0x58F99AE3, 0x58FA9AE2, 0x58FB9AE4,
+	0x58FC9AE5, 0x58FD9AE6, 0x59029AE7, 0x590995CF, 0x590A9AE8, 0x590BED83, 0x590F89C4, 0x59109AE9,
+	0x5915975B, 0x59168A4F, 0x591899C7, 0x59198F67, 0x591A91BD, 0x591B9AEA, 0x591C96E9, 0x592296B2,
+	0x59259AEC, 0x592791E5, 0x59299356, 0x592A91BE, 0x592B9576, 0x592C9AED, 0x592D9AEE, 0x592E899B,
+	0x59318EB8, 0x59329AEF, 0x593788CE, 0x59389AF0, 0x593E9AF1, 0x59448982, 0x59478AEF, 0x594893DE,
+	0x594995F2, 0x594E9AF5, 0x594F9174, 0x59509AF4, 0x59518C5F, 0x5953ED84, 0x5954967A, 0x59559AF3,
+	0x59579385, 0x59589AF7, 0x595A9AF6, 0x595BED85, 0x595DED86, 0x59609AF9, 0x59629AF8, 0x5963ED87,
+	0x5965899C, 0x59679AFA, 0x59688FA7, 0x59699AFC, 0x596A9244, 0x596C9AFB, 0x596E95B1, 0x59738F97,
+	0x5974937A, 0x59789B40, 0x597D8D44, 0x59819B41, 0x59829440, 0x598394DC, 0x598496CF, 0x598A9444,
+	0x598D9B4A, 0x59938B57, 0x59969764, 0x599996AD, 0x599B9BAA, 0x599D9B42, 0x59A39B45, 0x59A4ED88,
+	0x59A591C3, 0x59A89657, 0x59AC9369, 0x59B29B46, 0x59B99685, 0x59BAED89, 0x59BB8DC8, 0x59BE8FA8,
+	0x59C69B47, 0x59C98E6F, 0x59CB8E6E, 0x59D088B7, 0x59D18CC6, 0x59D390A9, 0x59D488CF, 0x59D99B4B,
+	0x59DA9B4C, 0x59DC9B49, 0x59E58957, 0x59E68AAD, 0x59E89B48, 0x59EA96C3, 0x59EB9550, 0x59F688A6,
+	0x59FB88F7, 0x59FF8E70, 0x5A0188D0, 0x5A0388A1, 0x5A099B51, 0x5A119B4F, 0x5A1896BA, 0x5A1A9B52,
+	0x5A1C9B50, 0x5A1F9B4E, 0x5A209050, 0x5A259B4D, 0x5A2995D8, 0x5A2F8CE2, 0x5A359B56, 0x5A369B57,
+	0x5A3C8FA9, 0x5A409B53, 0x5A41984B, 0x5A46946B, 0x5A499B55, 0x5A5A8DA5, 0x5A629B58, 0x5A669577,
+	0x5A6A9B59, 0x5A6C9B54, 0x5A7F96B9, 0x5A92947D, 0x5A9A9B5A, 0x5A9B9551, 0x5ABC9B5B, 0x5ABD9B5F,
+	0x5ABE9B5C, 0x5AC189C5, 0x5AC29B5E, 0x5AC98EB9, 0x5ACB9B5D, 0x5ACC8C99, 0x5AD09B6B, 0x5AD69B64,
+	0x5AD79B61, 0x5AE19284, 0x5AE39B60, 0x5AE69B62, 0x5AE99B63, 0x5AFA9B65, 0x5AFB9B66, 0x5B098AF0,
+	0x5B0B9B68, 0x5B0C9B67, 0x5B169B69, 0x5B228FEC, 0x5B2A9B6C, 0x5B2C92DA, 0x5B308964, 0x5B329B6A,
+	0x5B369B6D, 0x5B3E9B6E, 0x5B409B71, 0x5B439B6F, 0x5B459B70, 0x5B508E71, 0x5B519B72, 0x5B548D45,
+	0x5B559B73, 0x5B56ED8A, 0x5B578E9A, 0x5B5891B6, 0x5B5A9B74, 0x5B5B9B75, 0x5B5C8E79, 0x5B5D8D46,
+	0x5B5F96D0, 0x5B638B47, 0x5B648CC7, 0x5B659B76, 0x5B668A77, 0x5B699B77, 0x5B6B91B7, 0x5B709B78,
+	0x5B719BA1, 0x5B739B79, 0x5B759B7A, 0x5B789B7B, 0x5B7A9B7D, 0x5B809B7E, 0x5B839B80, 0x5B8591EE,
+	0x5B878946, 0x5B888EE7, 0x5B8988C0, 0x5B8B9176, 0x5B8C8AAE, 0x5B8D8EB3, 0x5B8F8D47, 0x5B959386,
+	0x5B978F40, 0x5B988AAF, 0x5B999288, 0x5B9A92E8, 0x5B9B88B6, 0x5B9C8B58, 0x5B9D95F3, 0x5B9F8EC0,
+	0x5BA28B71, 0x5BA390E9, 0x5BA48EBA, 0x5BA59747, 0x5BA69B81, 0x5BAE8B7B, 0x5BB08DC9, 0x5BB38A51,
+	0x5BB48983, 0x5BB58FAA, 0x5BB689C6, 0x5BB89B82, 0x5BB99765, 0x5BBF8F68, 0x5BC0ED8B, 0x5BC28EE2,
+	0x5BC39B83, 0x5BC48AF1, 0x5BC593D0, 0x5BC696A7, 0x5BC79B84, 0x5BC99B85, 0x5BCC9578, 0x5BD09B87,
+	0x5BD28AA6, 0x5BD38BF5, 0x5BD49B86, 0x5BD8ED8D, 0x5BDB8AB0, 0x5BDD9051, 0x5BDE9B8B, 0x5BDF8E40,
+	0x5BE189C7, 0x5BE29B8A, 0x5BE49B88, 0x5BE59B8C, 0x5BE69B89, 0x5BE7944A, 0x5BE89ECB, 0x5BE99052,
+	0x5BEB9B8D, 0x5BECED8E, 0x5BEE97BE, 0x5BF09B8E, 0x5BF39B90, 0x5BF5929E, 0x5BF69B8F, 0x5BF890A1,
+	0x5BFA8E9B, 0x5BFE91CE, 0x5BFF8EF5, 0x5C019595, 0x5C0290EA, 0x5C048ECB, 0x5C059B91, 0x5C068FAB,
+	0x5C079B92, 0x5C089B93, 0x5C0988D1, 0x5C0A91B8, 0x5C0B9071, 0x5C0D9B94, 0x5C0E93B1, 0x5C0F8FAC,
+	0x5C118FAD, 0x5C139B95, 0x5C1690EB, 0x5C1A8FAE, 0x5C1EED8F, 0x5C209B96, 0x5C229B97, 0x5C2496DE,
+	0x5C289B98, 0x5C2D8BC4, 0x5C318F41, 0x5C389B99, 0x5C399B9A, 0x5C3A8EDA, 0x5C3B904B, 0x5C3C93F2,
+	0x5C3D9073, 0x5C3E94F6, 0x5C3F9441, 0x5C408BC7, 0x5C419B9B, 0x5C458B8F, 0x5C469B9C, 0x5C488BFC,
+	0x5C4A93CD, 0x5C4B89AE, 0x5C4D8E72, 0x5C4E9B9D, 0x5C4F9BA0, 0x5C509B9F, 0x5C518BFB, 0x5C539B9E,
+	0x5C559357, 0x5C5E91AE, 0x5C60936A, 0x5C618EC6, 0x5C649177, 0x5C65979A, 0x5C6C9BA2, 0x5C6E9BA3,
+	0x5C6F93D4, 0x5C718E52, 0x5C769BA5, 0x5C799BA6, 0x5C8C9BA7, 0x5C908AF2, 0x5C919BA8, 0x5C949BA9,
+	0x5CA189AA, 0x5CA6ED90, 0x5CA8915A, 0x5CA98AE2, 0x5CAB9BAB, 0x5CAC96A6, 0x5CB191D0, 0x5CB38A78,
+	0x5CB69BAD, 0x5CB79BAF, 0x5CB88ADD, 0x5CBAED91, 0x5CBB9BAC, 0x5CBC9BAE, 0x5CBE9BB1, 0x5CC59BB0,
+	0x5CC79BB2, 0x5CD99BB3, 0x5CE093BB, 0x5CE18BAC, 0x5CE889E3, 0x5CE99BB4, 0x5CEA9BB9, 0x5CED9BB7,
+	0x5CEF95F5, 0x5CF095F4, 0x5CF5ED92, 0x5CF69387, 0x5CFA9BB6, 0x5CFB8F73, 0x5CFD9BB5, 0x5D079092,
+	0x5D0B9BBA, 0x5D0E8DE8, 0x5D119BC0, 0x5D149BC1, 0x5D159BBB, 0x5D168A52, 0x5D179BBC, 0x5D189BC5,
+	0x5D199BC4, 0x5D1A9BC3, 0x5D1B9BBF, 0x5D1F9BBE, 0x5D229BC2, 0x5D27ED93, 0x5D2995F6, 0x5D42ED96,
+	0x5D4B9BC9, 0x5D4C9BC6, 0x5D4E9BC8, 0x5D509792, 0x5D529BC7, 0x5D53ED94, 0x5D5C9BBD, 0x5D699093,
+	0x5D6C9BCA, 0x5D6DED97, 0x5D6F8DB5, 0x5D739BCB, 0x5D769BCC, 0x5D829BCF, 0x5D849BCE, 0x5D879BCD,
+	0x5D8B9388, 0x5D8C9BB8, 0x5D909BD5, 0x5D9D9BD1, 0x5DA29BD0, 0x5DAC9BD2, 0x5DAE9BD3, 0x5DB79BD6,
+	0x5DB8ED98, 0x5DB9ED99, 0x5DBA97E4, 0x5DBC9BD7, 0x5DBD9BD4, 0x5DC99BD8, 0x5DCC8ADE, 0x5DCD9BD9,
+	0x5DD0ED9A, 0x5DD29BDB, 0x5DD39BDA, 0x5DD69BDC, 0x5DDB9BDD, 0x5DDD90EC, 0x5DDE8F42, 0x5DE18F84,
+	0x5DE39183, 0x5DE58D48, 0x5DE68DB6, 0x5DE78D49, 0x5DE88B90, 0x5DEB9BDE, 0x5DEE8DB7, 0x5DF18CC8,
+	0x5DF29BDF, 0x5DF396A4, 0x5DF49462, 0x5DF59BE0, 0x5DF78D4A, 0x5DFB8AAA, 0x5DFD9246, 0x5DFE8BD0,
+	0x5E028E73, 0x5E03957A, 0x5E0694BF, 0x5E0B9BE1, 0x5E0C8AF3, 0x5E119BE4, 0x5E16929F, 0x5E199BE3,
+	0x5E1A9BE2, 0x5E1B9BE5, 0x5E1D92E9, 0x5E259083, 0x5E2B8E74, 0x5E2D90C8, 0x5E2F91D1, 0x5E308B41,
+	0x5E3392A0, 0x5E369BE6, 0x5E379BE7, 0x5E388FED, 0x5E3D9658, 0x5E409BEA, 0x5E439BE9, 0x5E449BE8,
+	0x5E45959D, 0x5E479BF1, 0x5E4C9679, 0x5E4E9BEB, 0x5E549BED, 0x5E55968B, 0x5E579BEC, 0x5E5F9BEE,
+	0x5E6194A6, 0x5E629BEF, 0x5E6395BC, 0x5E649BF0, 0x5E728AB1, 0x5E7395BD, 0x5E74944E, 0x5E759BF2,
+	0x5E769BF3, 0x5E788D4B, 0x5E798AB2, 0x5E7A9BF4, 0x5E7B8CB6, 0x5E7C9763, 0x5E7D9748, 0x5E7E8AF4,
+	0x5E7F9BF6, 0x5E8192A1, 0x5E838D4C, 0x5E848FAF, 0x5E8794DD, 0x5E8A8FB0, 0x5E8F8F98, 0x5E9592EA,
+	0x5E9695F7, 0x5E979358, 0x5E9A8D4D, 0x5E9C957B, 0x5EA09BF7, 0x5EA69378, 0x5EA78DC0, 0x5EAB8CC9,
+	0x5EAD92EB, 0x5EB588C1, 0x5EB68F8E, 0x5EB78D4E, 0x5EB89766, 0x5EC19BF8, 0x5EC29BF9, 0x5EC39470,
+	0x5EC89BFA, 0x5EC997F5, 0x5ECA984C, 0x5ECF9BFC, 0x5ED09BFB, 0x5ED38A66, 0x5ED69C40, 0x5EDA9C43,
+	0x5EDB9C44, 0x5EDD9C42, 0x5EDF955F, 0x5EE08FB1, 0x5EE19C46, 0x5EE29C45, 0x5EE39C41, 0x5EE89C47,
+	0x5EE99C48, 0x5EEC9C49, 0x5EF09C4C, 0x5EF19C4A, 0x5EF39C4B, 0x5EF49C4D, 0x5EF68984, 0x5EF792EC,
+	0x5EF89C4E, 0x5EFA8C9A, 0x5EFB89F4, 0x5EFC9455, 0x5EFE9C4F, 0x5EFF93F9, 0x5F0195D9, 0x5F039C50,
+	0x5F04984D, 0x5F099C51, 0x5F0A95BE, 0x5F0B9C54, 0x5F0C989F, 0x5F0D98AF, 0x5F0F8EAE, 0x5F1093F3,
+	0x5F119C55, 0x5F138B7C, 0x5F1492A2, 0x5F1588F8, 0x5F169C56, 0x5F1795A4, 0x5F188D4F, 0x5F1B926F,
+	0x5F1F92ED, 0x5F21ED9B, 0x5F2596ED, 0x5F268CB7, 0x5F278CCA, 0x5F299C57, 0x5F2D9C58, 0x5F2F9C5E,
+	0x5F318EE3, 0x5F34ED9C, 0x5F3592A3, 0x5F378BAD, 0x5F389C59, 0x5F3C954A, 0x5F3E9265, 0x5F419C5A,
+	0x5F45ED4B, 0x5F489C5B, 0x5F4A8BAE, 0x5F4C9C5C, 0x5F4E9C5D, 0x5F519C5F, 0x5F539396, 0x5F569C60,
+	0x5F579C61, 0x5F599C62, 0x5F5C9C53, 0x5F5D9C52, 0x5F619C63, 0x5F628C60, 0x5F669546, 0x5F67ED9D,
+	0x5F698DCA, 0x5F6A9556, 0x5F6B92A4, 0x5F6C956A, 0x5F6D9C64, 0x5F708FB2, 0x5F718965, 0x5F739C65,
+	0x5F779C66, 0x5F7996F0, 0x5F7C94DE, 0x5F7F9C69, 0x5F80899D, 0x5F8190AA, 0x5F829C68, 0x5F839C67,
+	0x5F848C61, 0x5F8591D2, 0x5F879C6D, 0x5F889C6B, 0x5F8A9C6A, 0x5F8B97A5, 0x5F8C8CE3, 0x5F908F99,
+	0x5F919C6C, 0x5F92936B, 0x5F938F5D, 0x5F9793BE, 0x5F989C70, 0x5F999C6F, 0x5F9E9C6E, 0x5FA09C71,
+	0x5FA18CE4, 0x5FA89C72, 0x5FA9959C, 0x5FAA8F7A, 0x5FAD9C73, 0x5FAE94F7, 0x5FB393BF, 0x5FB492A5,
+	0x5FB7ED9E, 0x5FB9934F, 0x5FBC9C74, 0x5FBD8B4A, 0x5FC39053, 0x5FC5954B, 0x5FCC8AF5, 0x5FCD9445,
+	0x5FD69C75, 0x5FD78E75, 0x5FD89659, 0x5FD9965A, 0x5FDC899E, 0x5FDD9C7A, 0x5FDEED9F, 0x5FE09289,
+	0x5FE49C77, 0x5FEB89F5, 0x5FF09CAB, 0x5FF19C79, 0x5FF5944F, 0x5FF89C78, 0x5FFB9C76, 0x5FFD8D9A,
+	0x5FFF9C7C, 0x600E9C83, 0x600F9C89, 0x60109C81, 0x6012937B, 0x60159C86, 0x6016957C, 0x60199C80,
+	0x601B9C85, 0x601C97E5, 0x601D8E76, 0x602091D3, 0x60219C7D, 0x60258B7D, 0x60269C88, 0x602790AB,
+	0x60288985, 0x60299C82, 0x602A89F6, 0x602B9C87, 0x602F8BAF, 0x60319C84, 0x603A9C8A, 0x60419C8C,
+	0x60429C96, 0x60439C94, 0x60469C91, 0x604A9C90, 0x604B97F6, 0x604D9C92, 0x60508BB0, 0x60528D50,
+	0x60558F9A, 0x60599C99, 0x605A9C8B, 0x605DEDA0, 0x605F9C8F, 0x60609C7E, 0x606289F8, 0x60639C93,
+	0x60649C95, 0x60659270, 0x60688DA6, 0x606989B6, 0x606A9C8D, 0x606B9C98, 0x606C9C97, 0x606D8BB1,
+	0x606F91A7, 0x60708A86, 0x60758C62, 0x60779C8E, 0x60819C9A, 0x60839C9D, 0x60849C9F, 0x6085EDA1,
+	0x60898EBB, 0x608AEDA2, 0x608B9CA5, 0x608C92EE, 0x608D9C9B, 0x60929CA3, 0x609489F7, 0x60969CA1,
+	0x60979CA2, 0x609A9C9E, 0x609B9CA0, 0x609F8CE5, 0x60A09749, 0x60A38AB3, 0x60A68978, 0x60A79CA4,
+	0x60A99459, 0x60AA88AB, 0x60B294DF, 0x60B39C7B, 0x60B49CAA, 0x60B59CAE, 0x60B696E3, 0x60B89CA7,
+	0x60BC9389, 0x60BD9CAC, 0x60C58FEE, 0x60C69CAD, 0x60C793D5, 0x60D19866, 0x60D39CA9, 0x60D5EDA4,
+	0x60D89CAF, 0x60DA8D9B, 0x60DC90C9, 0x60DEEDA3, 0x60DF88D2, 0x60E09CA8, 0x60E19CA6, 0x60E39179,
+	0x60E79C9C, 0x60E88E53, 0x60F091C4, 0x60F19CBB, 0x60F2EDA6, 0x60F3917A, 0x60F49CB6, 0x60F69CB3,
+	0x60F79CB4, 0x60F98EE4, 0x60FA9CB7, 0x60FB9CBA, 0x61009CB5, 0x61018F44, 0x61039CB8, 0x61069CB2,
+	0x610896FA, 0x610996F9, 0x610D9CBC, 0x610E9CBD, 0x610F88D3, 0x6111EDA7, 0x61159CB1, 0x611A8BF0,
+	0x611B88A4, 0x611F8AB4, 0x6120EDA5, 0x61219CB9, 0x61279CC1, 0x61289CC0, 0x612C9CC5, 0x6130EDA9,
+	0x61349CC6, 0x6137EDA8, 0x613C9CC4, 0x613D9CC7, 0x613E9CBF, 0x613F9CC3, 0x61429CC8, 0x61449CC9,
+	0x61479CBE, 0x61488E9C, 0x614A9CC2, 0x614B91D4, 0x614C8D51, 0x614D9CB0, 0x614E9054, 0x61539CD6,
+	0x615595E7, 0x61589CCC, 0x61599CCD, 0x615A9CCE, 0x615D9CD5, 0x615F9CD4, 0x6162969D, 0x61638AB5,
+	0x61659CD2, 0x61678C64, 0x61688A53, 0x616B9CCF, 0x616E97B6, 0x616F9CD1, 0x617088D4, 0x61719CD3,
+	0x61739CCA, 0x61749CD0, 0x61759CD7, 0x61768C63, 0x61779CCB, 0x617E977C, 0x6182974A, 0x61879CDA,
+	0x618A9CDE, 0x618E919E, 0x619097F7, 0x61919CDF, 0x61949CDC, 0x61969CD9, 0x6198EDAA, 0x61999CD8,
+	0x619A9CDD, 0x61A495AE, 0x61A793B2, 0x61A98C65, 0x61AB9CE0, 0x61AC9CDB, 0x61AE9CE1, 0x61B28C9B,
+	0x61B689AF, 0x61BA9CE9, 0x61BE8AB6, 0x61C39CE7, 0x61C69CE8, 0x61C78DA7, 0x61C89CE6, 0x61C99CE4,
+	0x61CA9CE3, 0x61CB9CEA, 0x61CC9CE2, 0x61CD9CEC, 0x61D089F9, 0x61E39CEE, 0x61E69CED, 0x61F292A6,
+	0x61F49CF1, 0x61F69CEF, 0x61F79CE5, 0x61F88C9C, 0x61FA9CF0, 0x61FC9CF4, 0x61FD9CF3, 0x61FE9CF5,
+	0x61FF9CF2, 0x62009CF6, 0x62089CF7, 0x62099CF8, 0x620A95E8, 0x620C9CFA, 0x620D9CF9, 0x620E8F5E,
+	0x621090AC, 0x621189E4, 0x621289FA, 0x6213EDAB, 0x62149CFB, 0x621688BD, 0x621A90CA, 0x621B9CFC,
+	0x621DE6C1, 0x621E9D40, 0x621F8C81, 0x62219D41, 0x622690ED, 0x622A9D42, 0x622E9D43, 0x622F8B59,
+	0x62309D44, 0x62329D45, 0x62339D46, 0x623491D5, 0x62388CCB, 0x623B96DF, 0x623F965B, 0x62408F8A,
+	0x62419D47, 0x624790EE, 0x6248E7BB, 0x624994E0, 0x624B8EE8, 0x624D8DCB, 0x624E9D48, 0x625391C5,
+	0x625595A5, 0x625891EF, 0x625B9D4B, 0x625E9D49, 0x62609D4C, 0x62639D4A, 0x62689D4D, 0x626E95AF,
+	0x627188B5, 0x6276957D, 0x627994E1, 0x627C9D4E, 0x627E9D51, 0x627F8FB3, 0x62808B5A, 0x62829D4F,
+	0x62839D56, 0x62848FB4, 0x62899D50, 0x628A9463, 0x6291977D, 0x62929D52, 0x62939D53, 0x62949D57,
+	0x6295938A, 0x62969D54, 0x62978D52, 0x629890DC, 0x629B9D65, 0x629C94B2, 0x629E91F0, 0x62A6EDAC,
+	0x62AB94E2, 0x62AC9DAB, 0x62B195F8, 0x62B592EF, 0x62B99695, 0x62BB9D5A, 0x62BC899F, 0x62BD928A,
+	0x62C29D63, 0x62C59253, 0x62C69D5D, 0x62C79D64, 0x62C89D5F, 0x62C99D66, 0x62CA9D62, 0x62CC9D61,
+	0x62CD948F, 0x62CF9D5B, 0x62D089FB, 0x62D19D59, 0x62D28B91, 0x62D391F1, 0x62D49D55, 0x62D79D58,
+	0x62D88D53, 0x62D990D9, 0x62DB8FB5, 0x62DC9D60, 0x62DD9471, 0x62E08B92, 0x62E18A67, 0x62EC8A87,
+	0x62ED9040, 0x62EE9D68, 0x62EF9D6D, 0x62F19D69, 0x62F38C9D, 0x62F59D6E, 0x62F68E41, 0x62F78D89,
+	0x62FE8F45, 0x62FF9D5C, 0x63018E9D, 0x63029D6B, 0x63078E77, 0x63089D6C, 0x630988C2, 0x630C9D67,
+	0x631192A7, 0x63198B93, 0x631F8BB2, 0x63279D6A, 0x632888A5, 0x632B8DC1, 0x632F9055, 0x633A92F0,
+	0x633D94D2, 0x633E9D70, 0x633F917D, 0x634991A8, 0x634C8E4A, 0x634D9D71, 0x634F9D73, 0x63509D6F,
+	0x635595DF, 0x635792BB, 0x635C917B, 0x636795F9, 0x63688ECC, 0x63699D80, 0x636B9D7E, 0x636E9098,
+	0x63728C9E, 0x63769D78, 0x63778FB7, 0x637A93E6, 0x637B9450, 0x63809D76, 0x6383917C, 0x63888EF6,
+	0x63899D7B, 0x638C8FB6, 0x638E9D75, 0x638F9D7A, 0x63929472, 0x63969D74, 0x63988C40, 0x639B8A7C,
+	0x639F9D7C, 0x63A097A9, 0x63A18DCC, 0x63A29254, 0x63A39D79, 0x63A590DA, 0x63A78D54, 0x63A89084,
+	0x63A98986, 0x63AA915B, 0x63AB9D77, 0x63AC8B64, 0x63B28C66, 0x63B492CD, 0x63B59D7D, 0x63BB917E,
+	0x63BE9D81, 0x63C09D83, 0x63C391B5, 0x63C49D89, 0x63C69D84, 0x63C99D86, 0x63CF9560, 0x63D092F1,
+	0x63D29D87, 0x63D6974B, 0x63DA9767, 0x63DB8AB7, 0x63E188AC, 0x63E39D85, 0x63E99D82, 0x63EE8AF6,
+	0x63F48987, 0x63F5EDAD, 0x63F69D88, 0x63FA9768, 0x64069D8C, 0x640D91B9, 0x640F9D93, 0x64139D8D,
+	0x64169D8A, 0x64179D91, 0x641C9D72, 0x64269D8E, 0x64289D92, 0x642C94C0, 0x642D938B, 0x64349D8B,
+	0x64369D8F, 0x643A8C67, 0x643E8DEF, 0x644290DB, 0x644E9D97, 0x64589345, 0x6460EDAE, 0x64679D94,
+	0x64699680, 0x646F9D95, 0x64769D96, 0x647896CC, 0x647A90A0, 0x64838C82, 0x64889D9D, 0x64928E54,
+	0x64939D9A, 0x64959D99, 0x649A9451, 0x649DEDAF, 0x649E93B3, 0x64A49350, 0x64A59D9B, 0x64A99D9C,
+	0x64AB958F, 0x64AD9464, 0x64AE8E42, 0x64B090EF, 0x64B2966F, 0x64B98A68, 0x64BB9DA3, 0x64BC9D9E,
+	0x64C19769, 0x64C29DA5, 0x64C59DA1, 0x64C79DA2, 0x64CD9180, 0x64CEEDB0, 0x64D29DA0, 0x64D49D5E,
+	0x64D89DA4, 0x64DA9D9F, 0x64E09DA9, 0x64E19DAA, 0x64E29346, 0x64E39DAC, 0x64E68E43, 0x64E79DA7,
+	0x64EC8B5B, 0x64EF9DAD, 0x64F19DA6, 0x64F29DB1, 0x64F49DB0, 0x64F69DAF, 0x64FA9DB2, 0x64FD9DB4,
+	0x64FE8FEF, 0x65009DB3, 0x65059DB7, 0x65189DB5, 0x651C9DB6, 0x651D9D90, 0x65239DB9, 0x65249DB8,
+	0x652A9D98, 0x652B9DBA, 0x652C9DAE, 0x652F8E78, 0x65349DBB, 0x65359DBC, 0x65369DBE, 0x65379DBD,
+	0x65389DBF, 0x653989FC, 0x653B8D55, 0x653E95FA, 0x653F90AD, 0x65458CCC, 0x65489DC1, 0x654D9DC4,
+	0x654EEDB1, 0x654F9571, 0x65518B7E, 0x65559DC3, 0x65569DC2, 0x65579473, 0x65589DC5, 0x65598BB3,
+	0x655D9DC7, 0x655E9DC6, 0x65628AB8, 0x65638E55, 0x656693D6, 0x656C8C68, 0x65709094, 0x65729DC8,
+	0x657490AE, 0x65759347, 0x6577957E, 0x65789DC9, 0x65829DCA, 0x65839DCB, 0x658795B6, 0x65889B7C,
+	0x658990C4, 0x658C956B, 0x658E8DD6, 0x659094E3, 0x659194C1, 0x6597936C, 0x659997BF, 0x659B9DCD,
+	0x659C8ECE, 0x659F9DCE, 0x65A188B4, 0x65A48BD2, 0x65A590CB, 0x65A79580, 0x65AB9DCF, 0x65AC8E61,
+	0x65AD9266, 0x65AF8E7A, 0x65B09056, 0x65B79DD0, 0x65B995FB, 0x65BC8997, 0x65BD8E7B, 0x65C19DD3,
+	0x65C39DD1, 0x65C49DD4, 0x65C597B7, 0x65C69DD2, 0x65CB90F9, 0x65CC9DD5, 0x65CF91B0, 0x65D29DD6,
+	0x65D78AF8, 0x65D99DD8, 0x65DB9DD7, 0x65E09DD9, 0x65E19DDA, 0x65E28AF9, 0x65E593FA, 0x65E69255,
+	0x65E78B8C, 0x65E88E7C, 0x65E99181, 0x65EC8F7B, 0x65ED88AE, 0x65F19DDB, 0x65FA89A0, 0x65FB9DDF,
+	0x6600EDB2, 0x66028D56, 0x66039DDE, 0x66068DA9, 0x66078FB8, 0x6609EDB5, 0x660A9DDD, 0x660C8FB9,
+	0x660E96BE, 0x660F8DA8, 0x661388D5, 0x661490CC, 0x6615EDB3, 0x661C9DE4, 0x661EEDB7, 0x661F90AF,
+	0x66208966, 0x6624EDB8, 0x66258F74, 0x66279686, 0x66288DF0, 0x662D8FBA, 0x662EEDB6, 0x662F90A5,
+	0x6631ED47, 0x66349DE3, 0x66359DE1, 0x66369DE2, 0x663BEDB4, 0x663C928B, 0x663F9E45, 0x66419DE8,
+	0x66428E9E, 0x66438D57, 0x66449DE6, 0x66499DE7, 0x664B9057, 0x664F9DE5, 0x66528E4E, 0x6657EDBA,
+	0x6659EDBB, 0x665D9DEA, 0x665E9DE9, 0x665F9DEE, 0x66629DEF, 0x66649DEB, 0x6665EDB9, 0x66668A41,
+	0x66679DEC, 0x66689DED, 0x666994D3, 0x666E9581, 0x666F8C69, 0x66709DF0, 0x6673EDBD, 0x667490B0,
+	0x66768FBB, 0x667A9271, 0x66818BC5, 0x66839DF1, 0x66849DF5, 0x668789C9, 0x66889DF2, 0x66899DF4,
+	0x668E9DF3, 0x66918F8B, 0x66969267, 0x669788C3, 0x66989DF6, 0x6699EDBE, 0x669D9DF7, 0x66A0EDBF,
+	0x66A292A8, 0x66A697EF, 0x66AB8E62, 0x66AE95E9, 0x66B2EDC0, 0x66B4965C, 0x66B89E41, 0x66B99DF9,
+	0x66BC9DFC, 0x66BE9DFB, 0x66BFEDC1, 0x66C19DF8, 0x66C49E40, 0x66C793DC, 0x66C99DFA, 0x66D69E42,
+	0x66D98F8C, 0x66DA9E43, 0x66DC976A, 0x66DD9498, 0x66E09E44, 0x66E69E46, 0x66E99E47, 0x66F09E48,
+	0x66F28BC8, 0x66F38967, 0x66F48D58, 0x66F59E49, 0x66F79E4A, 0x66F88F91, 0x66F99182, 0x66FAEDC2,
+	0x66FBED4A, 0x66FC99D6, 0x66FD915D, 0x66FE915C, 0x66FF91D6, 0x67008DC5, 0x670398F0, 0x67088C8E,
+	0x6709974C, 0x670B95FC, 0x670D959E, 0x670EEDC3, 0x670F9E4B, 0x67148DF1, 0x671592BD, 0x67169E4C,
+	0x6717984E, 0x671B965D, 0x671D92A9, 0x671E9E4D, 0x671F8AFA, 0x67269E4E, 0x67279E4F, 0x672896D8,
+	0x672A96A2, 0x672B9696, 0x672C967B, 0x672D8E44, 0x672E9E51, 0x67318EE9, 0x67349670, 0x67369E53,
+	0x67379E56, 0x67389E55, 0x673A8AF7, 0x673D8B80, 0x673F9E52, 0x67419E54, 0x67469E57, 0x67499099,
+	0x674E979B, 0x674F88C7, 0x67508DDE, 0x675191BA, 0x67538EDB, 0x67568FF1, 0x67599E5A, 0x675C936D,
+	0x675E9E58, 0x675F91A9, 0x67609E59, 0x67618FF0, 0x676296DB, 0x67639E5B, 0x67649E5C, 0x67659788,
+	0x6766EDC5, 0x676A9E61, 0x676D8D59, 0x676F9474, 0x67709E5E, 0x6771938C, 0x67729DDC, 0x67739DE0,
+	0x67758B6E, 0x67779466, 0x677C9E60, 0x677E8FBC, 0x677F94C2, 0x67859E66, 0x678794F8, 0x67899E5D,
+	0x678B9E63, 0x678C9E62, 0x679090CD, 0x6795968D, 0x679797D1, 0x679A9687, 0x679C89CA, 0x679D8E7D,
+	0x67A09867, 0x67A19E65, 0x67A29095, 0x67A69E64, 0x67A99E5F, 0x67AF8CCD, 0x67B39E6B, 0x67B49E69,
+	0x67B689CB, 0x67B79E67, 0x67B89E6D, 0x67B99E73, 0x67BBEDC6, 0x67C0EDC8, 0x67C191C6, 0x67C495BF,
+	0x67C69E75, 0x67CA9541, 0x67CE9E74, 0x67CF9490, 0x67D0965E, 0x67D18AB9, 0x67D390F5, 0x67D48F5F,
+	0x67D892D1, 0x67DA974D, 0x67DD9E70, 0x67DE9E6F, 0x67E29E71, 0x67E49E6E, 0x67E79E76, 0x67E99E6C,
+	0x67EC9E6A, 0x67EE9E72, 0x67EF9E68, 0x67F1928C, 0x67F396F6, 0x67F48EC4, 0x67F58DF2, 0x67FB8DB8,
+	0x67FE968F, 0x67FF8A60, 0x6801EDC9, 0x680292CC, 0x680393C8, 0x68048968, 0x681390F0, 0x681690B2,
+	0x68178C49, 0x681E9E78, 0x68218D5A, 0x68228A9C, 0x68299E7A, 0x682A8A94, 0x682B9E81, 0x68329E7D,
+	0x683490F1, 0x68388A6A, 0x68398DAA, 0x683C8A69, 0x683D8DCD, 0x68409E7B, 0x68418C85, 0x68428C6A,
+	0x6843938D, 0x6844EDCA, 0x68469E79, 0x684888C4, 0x684D9E7C, 0x684E9E7E, 0x68508BCB, 0x68518C4B,
+	0x6852EDC7, 0x68538ABA, 0x68548B6A, 0x68599E82, 0x685C8DF7, 0x685D9691, 0x685F8E56, 0x68639E83,
+	0x6867954F, 0x68749E8F, 0x687689B1, 0x68779E84, 0x687E9E95, 0x687F9E85, 0x688197C0, 0x68839E8C,
+	0x6885947E, 0x688D9E94, 0x688F9E87, 0x689388B2, 0x68949E89, 0x68978D5B, 0x689B9E8B, 0x689D9E8A,
+	0x689F9E86, 0x68A09E91, 0x68A28FBD, 0x68A69AEB, 0x68A78CE6, 0x68A8979C, 0x68AD9E88, 0x68AF92F2,
+	0x68B08A42, 0x68B18DAB, 0x68B39E80, 0x68B59E90, 0x68B68A81, 0x68B99E8E, 0x68BA9E92, 0x68BC938E,
+	0x68C48AFC, 0x68C69EB0, 0x68C8ED48, 0x68C996C7, 0x68CA9E97, 0x68CB8AFB, 0x68CD9E9E, 0x68CFEDCB,
+	0x68D2965F, 0x68D49E9F, 0x68D59EA1, 0x68D79EA5, 0x68D89E99, 0x68DA9249, 0x68DF938F, 0x68E09EA9,
+	0x68E19E9C, 0x68E39EA6, 0x68E79EA0, 0x68EE9058, 0x68EF9EAA, 0x68F290B1, 0x68F99EA8, 0x68FA8ABB,
+	0x6900986F, 0x69019E96, 0x69049EA4, 0x690588D6, 0x69089E98, 0x690B96B8, 0x690C9E9D, 0x690D9041,
+	0x690E92C5, 0x690F9E93, 0x69129EA3, 0x6919909A, 0x691A9EAD, 0x691B8A91, 0x691C8C9F, 0x69219EAF,
+	0x69229E9A, 0x69239EAE, 0x69259EA7, 0x69269E9B, 0x69289EAB, 0x692A9EAC, 0x69309EBD, 0x693493CC,
+	0x69369EA2, 0x69399EB9, 0x693D9EBB, 0x693F92D6, 0x694A976B, 0x69539596, 0x69549EB6, 0x695591C8,
+	0x69599EBC, 0x695A915E, 0x695C9EB3, 0x695D9EC0, 0x695E9EBF, 0x696093ED, 0x69619EBE, 0x696293E8,
+	0x6968EDCD, 0x696A9EC2, 0x696B9EB5, 0x696D8BC6, 0x696E9EB8, 0x696F8F7C, 0x69739480, 0x69749EBA,
+	0x69758BC9, 0x69779EB2, 0x69789EB4, 0x69799EB1, 0x697C984F, 0x697D8A79, 0x697E9EB7, 0x69819EC1,
+	0x69828A54, 0x698A8DE5, 0x698E897C, 0x69919ED2, 0x69949850, 0x69959ED5, 0x6998EDCF, 0x699B9059,
+	0x699C9ED4, 0x69A09ED3, 0x69A79ED0, 0x69AE9EC4, 0x69B19EE1, 0x69B29EC3, 0x69B49ED6, 0x69BB9ECE,
+	0x69BE9EC9, 0x69BF9EC6, 0x69C19EC7, 0x69C39ECF, 0x69C7EAA0, 0x69CA9ECC, 0x69CB8D5C, 0x69CC92C6,
+	0x69CD9184, 0x69CE9ECA, 0x69D09EC5, 0x69D39EC8, 0x69D8976C, 0x69D9968A, 0x69DD9ECD, 0x69DE9ED7,
+	0x69E2EDD0, 0x69E79EDF, 0x69E89ED8, 0x69EB9EE5, 0x69ED9EE3, 0x69F29EDE, 0x69F99EDD, 0x69FB92CE,
+	0x69FD9185, 0x69FF9EDB, 0x6A029ED9, 0x6A059EE0, 0x6A0A9EE6, 0x6A0B94F3, 0x6A0C9EEC, 0x6A129EE7,
+	0x6A139EEA, 0x6A149EE4, 0x6A179294, 0x6A199557, 0x6A1B9EDA, 0x6A1E9EE2, 0x6A1F8FBE, 0x6A2196CD,
+	0x6A229EF6, 0x6A239EE9, 0x6A298CA0, 0x6A2A89A1, 0x6A2B8A7E, 0x6A2E9ED1, 0x6A30EDD1, 0x6A358FBF,
+	0x6A369EEE, 0x6A389EF5, 0x6A398EF7, 0x6A3A8A92, 0x6A3D924D, 0x6A449EEB, 0x6A46EDD3, 0x6A479EF0,
+	0x6A489EF4, 0x6A4B8BB4, 0x6A588B6B, 0x6A599EF2, 0x6A5F8B40, 0x6A6193C9, 0x6A629EF1, 0x6A669EF3,
+	0x6A6BEDD2, 0x6A729EED, 0x6A73EDD4, 0x6A789EEF, 0x6A7EEDD5, 0x6A7F8A80, 0x6A809268, 0x6A849EFA,
+	0x6A8D9EF8, 0x6A8E8CE7, 0x6A909EF7, 0x6A979F40, 0x6A9C9E77, 0x6AA09EF9, 0x6AA29EFB, 0x6AA39EFC,
+	0x6AAA9F4B, 0x6AAC9F47, 0x6AAE9E8D, 0x6AB39F46, 0x6AB89F45, 0x6ABB9F42, 0x6AC19EE8, 0x6AC29F44,
+	0x6AC39F43, 0x6AD19F49, 0x6AD39845, 0x6ADA9F4C, 0x6ADB8BF9, 0x6ADE9F48, 0x6ADF9F4A, 0x6AE2EDD6,
+	0x6AE4EDD7, 0x6AE894A5, 0x6AEA9F4D, 0x6AFA9F51, 0x6AFB9F4E, 0x6B049793, 0x6B059F4F, 0x6B0A9EDC,
+	0x6B129F52, 0x6B169F53, 0x6B1D8954, 0x6B1F9F55, 0x6B208C87, 0x6B218E9F, 0x6B238BD3, 0x6B2789A2,
+	0x6B32977E, 0x6B379F57, 0x6B389F56, 0x6B399F59, 0x6B3A8B5C, 0x6B3D8BD4, 0x6B3E8ABC, 0x6B439F5C,
+	0x6B479F5B, 0x6B499F5D, 0x6B4C89CC, 0x6B4E9256, 0x6B509F5E, 0x6B538ABD, 0x6B549F60, 0x6B599F5F,
+	0x6B5B9F61, 0x6B5F9F62, 0x6B619F63, 0x6B628E7E, 0x6B6390B3, 0x6B648D9F, 0x6B669590, 0x6B6995E0,
+	0x6B6A9863, 0x6B6F8E95, 0x6B738DCE, 0x6B7497F0, 0x6B789F64, 0x6B799F65, 0x6B7B8E80, 0x6B7F9F66,
+	0x6B809F67, 0x6B839F69, 0x6B849F68, 0x6B869677, 0x6B898F7D, 0x6B8A8EEA, 0x6B8B8E63, 0x6B8D9F6A,
+	0x6B959F6C, 0x6B969042, 0x6B989F6B, 0x6B9E9F6D, 0x6BA49F6E, 0x6BAA9F6F, 0x6BAB9F70, 0x6BAF9F71,
+	0x6BB19F73, 0x6BB29F72, 0x6BB39F74, 0x6BB489A3, 0x6BB59269, 0x6BB79F75, 0x6BBA8E45, 0x6BBB8A6B,
+	0x6BBC9F76, 0x6BBF9361, 0x6BC09ACA, 0x6BC58B42, 0x6BC69F77, 0x6BCB9F78, 0x6BCD95EA, 0x6BCE9688,
+	0x6BD293C5, 0x6BD39F79, 0x6BD494E4, 0x6BD6EDD8, 0x6BD894F9, 0x6BDB96D1, 0x6BDF9F7A, 0x6BEB9F7C,
+	0x6BEC9F7B, 0x6BEF9F7E, 0x6BF39F7D, 0x6C089F81, 0x6C0F8E81, 0x6C1196AF, 0x6C139F82, 0x6C149F83,
+	0x6C178B43, 0x6C1B9F84, 0x6C239F86, 0x6C249F85, 0x6C349085, 0x6C379558, 0x6C388969, 0x6C3E94C3,
+	0x6C3FEDD9, 0x6C4092F3, 0x6C418F60, 0x6C428B81, 0x6C4E94C4, 0x6C508EAC, 0x6C559F88, 0x6C578ABE,
+	0x6C5A8998, 0x6C5CEDDA, 0x6C5D93F0, 0x6C5E9F87, 0x6C5F8D5D, 0x6C609272, 0x6C629F89, 0x6C689F91,
+	0x6C6A9F8A, 0x6C6FEDDC, 0x6C7091BF, 0x6C728B82, 0x6C739F92, 0x6C7A8C88, 0x6C7D8B44, 0x6C7E9F90,
+	0x6C819F8E, 0x6C829F8B, 0x6C839780, 0x6C86EDDB, 0x6C8892BE, 0x6C8C93D7, 0x6C8D9F8C, 0x6C909F94,
+	0x6C929F93, 0x6C938C42, 0x6C9689AB, 0x6C998DB9, 0x6C9A9F8D, 0x6C9B9F8F, 0x6CA19676, 0x6CA291F2,
+	0x6CAB9697, 0x6CAE9F9C, 0x6CB19F9D, 0x6CB389CD, 0x6CB895A6, 0x6CB996FB, 0x6CBA9F9F, 0x6CBB8EA1,
+	0x6CBC8FC0, 0x6CBD9F98, 0x6CBE9F9E, 0x6CBF8988, 0x6CC18BB5, 0x6CC49F95, 0x6CC59F9A, 0x6CC990F2,
+	0x6CCA9491, 0x6CCC94E5, 0x6CD39F97, 0x6CD59640, 0x6CD79F99, 0x6CD99FA2, 0x6CDAEDDD, 0x6CDB9FA0,
+	0x6CDD9F9B, 0x6CE19641, 0x6CE29467, 0x6CE38B83, 0x6CE59344, 0x6CE8928D, 0x6CEA9FA3, 0x6CEF9FA1,
+	0x6CF091D7, 0x6CF19F96, 0x6CF3896A, 0x6D04EDDE, 0x6D0B976D, 0x6D0C9FAE, 0x6D129FAD, 0x6D1790F4,
+	0x6D199FAA, 0x6D1B978C, 0x6D1E93B4, 0x6D1F9FA4, 0x6D2592C3, 0x6D29896B, 0x6D2A8D5E, 0x6D2B9FA7,
+	0x6D328F46, 0x6D339FAC, 0x6D359FAB, 0x6D369FA6, 0x6D389FA9, 0x6D3B8A88, 0x6D3D9FA8, 0x6D3E9468,
+	0x6D4197AC, 0x6D448FF2, 0x6D4590F3, 0x6D599FB4, 0x6D5A9FB2, 0x6D5C956C, 0x6D639FAF, 0x6D649FB1,
+	0x6D668959, 0x6D698D5F, 0x6D6A9851, 0x6D6C8A5C, 0x6D6E9582, 0x6D6FEDE0, 0x6D749781, 0x6D778A43,
+	0x6D78905A, 0x6D799FB3, 0x6D859FB8, 0x6D87EDDF, 0x6D888FC1, 0x6D8C974F, 0x6D8E9FB5, 0x6D939FB0,
+	0x6D959FB6, 0x6D96EDE1, 0x6D9997DC, 0x6D9B9393, 0x6D9C93C0, 0x6DACEDE2, 0x6DAF8A55, 0x6DB28974,
+	0x6DB59FBC, 0x6DB89FBF, 0x6DBC97C1, 0x6DC09784, 0x6DC59FC6, 0x6DC69FC0, 0x6DC79FBD, 0x6DCB97D2,
+	0x6DCC9FC3, 0x6DCFEDE3, 0x6DD18F69, 0x6DD29FC5, 0x6DD59FCA, 0x6DD89391, 0x6DD99FC8, 0x6DDE9FC2,
+	0x6DE19257, 0x6DE49FC9, 0x6DE69FBE, 0x6DE89FC4, 0x6DEA9FCB, 0x6DEB88FA, 0x6DEC9FC1, 0x6DEE9FCC,
+	0x6DF1905B, 0x6DF2EDE5, 0x6DF38F7E, 0x6DF595A3, 0x6DF78DAC, 0x6DF8EDE4, 0x6DF99FB9, 0x6DFA9FC7,
+	0x6DFB9359, 0x6DFCEDE6, 0x6E0590B4, 0x6E078A89, 0x6E088DCF, 0x6E098FC2, 0x6E0A9FBB, 0x6E0B8F61,
+	0x6E138C6B, 0x6E159FBA, 0x6E199FD0, 0x6E1A8F8D, 0x6E1B8CB8, 0x6E1D9FDF, 0x6E1F9FD9, 0x6E208B94,
+	0x6E21936E, 0x6E239FD4, 0x6E249FDD, 0x6E2588AD, 0x6E268951, 0x6E27EDE9, 0x6E2989B7, 0x6E2B9FD6,
+	0x6E2C91AA, 0x6E2D9FCD, 0x6E2E9FCF, 0x6E2F8D60, 0x6E389FE0, 0x6E39EDE7, 0x6E3A9FDB, 0x6E3CEDEA,
+	0x6E3E9FD3, 0x6E439FDA, 0x6E4A96A9, 0x6E4D9FD8, 0x6E4E9FDC, 0x6E568CCE, 0x6E588FC3, 0x6E5B9258,
+	0x6E5CEDE8, 0x6E5F9FD2, 0x6E67974E, 0x6E6B9FD5, 0x6E6E9FCE, 0x6E6F9392, 0x6E729FD1, 0x6E769FD7,
+	0x6E7E9870, 0x6E7F8EBC, 0x6E80969E, 0x6E829FE1, 0x6E8C94AC, 0x6E8F9FED, 0x6E908CB9, 0x6E968F80,
+	0x6E989FE3, 0x6E9C97AD, 0x6E9D8D61, 0x6E9F9FF0, 0x6EA288EC, 0x6EA59FEE, 0x6EAA9FE2, 0x6EAF9FE8,
+	0x6EB29FEA, 0x6EB6976E, 0x6EB79FE5, 0x6EBA934D, 0x6EBD9FE7, 0x6EBFEDEB, 0x6EC29FEF, 0x6EC49FE9,
+	0x6EC596C5, 0x6EC99FE4, 0x6ECB8EA0, 0x6ECC9FFC, 0x6ED18A8A, 0x6ED39FE6, 0x6ED49FEB, 0x6ED59FEC,
+	0x6EDD91EA, 0x6EDE91D8, 0x6EEC9FF4, 0x6EEF9FFA, 0x6EF29FF8, 0x6EF49348, 0x6EF7E042, 0x6EF89FF5,
+	0x6EFE9FF6, 0x6EFF9FDE, 0x6F018B99, 0x6F029559, 0x6F068EBD, 0x6F098D97, 0x6F0F9852, 0x6F119FF2,
+	0x6F13E041, 0x6F148989, 0x6F159186, 0x6F209499, 0x6F228ABF, 0x6F2397F8, 0x6F2B969F, 0x6F2C92D0,
+	0x6F319FF9, 0x6F329FFB, 0x6F389151, 0x6F3EE040, 0x6F3F9FF7, 0x6F419FF1, 0x6F458AC1, 0x6F548C89,
+	0x6F58E04E, 0x6F5BE049, 0x6F5C90F6, 0x6F5F8A83, 0x6F648F81, 0x6F66E052, 0x6F6DE04B, 0x6F6E92AA,
+	0x6F6FE048, 0x6F7092D7, 0x6F74E06B, 0x6F78E045, 0x6F7AE044, 0x6F7CE04D, 0x6F80E047, 0x6F81E046,
+	0x6F82E04C, 0x6F84909F, 0x6F86E043, 0x6F88EDEC, 0x6F8EE04F, 0x6F91E050, 0x6F978AC0, 0x6FA1E055,
+	0x6FA3E054, 0x6FA4E056, 0x6FAAE059, 0x6FB19362, 0x6FB3E053, 0x6FB5EDED, 0x6FB9E057, 0x6FC08C83,
+	0x6FC191F7, 0x6FC2E051, 0x6FC3945A, 0x6FC6E058, 0x6FD4E05D, 0x6FD5E05B, 0x6FD8E05E, 0x6FDBE061,
+	0x6FDFE05A, 0x6FE08D8A, 0x6FE19447, 0x6FE49FB7, 0x6FEB9794, 0x6FECE05C, 0x6FEEE060, 0x6FEF91F3,
+	0x6FF1E05F, 0x6FF3E04A, 0x6FF5EDEE, 0x6FF6E889, 0x6FFAE064, 0x6FFEE068, 0x7001E066, 0x7005EDEF,
+	0x7007EDF0, 0x7009E062, 0x700BE063, 0x700FE067, 0x7011E065, 0x7015956D, 0x7018E06D, 0x701AE06A,
+	0x701BE069, 0x701DE06C, 0x701E93D2, 0x701FE06E, 0x70269295, 0x702791EB, 0x7028EDF1, 0x702C90A3,
+	0x7030E06F, 0x7032E071, 0x703EE070, 0x704C9FF3, 0x7051E072, 0x705893E5, 0x7063E073, 0x706B89CE,
+	0x706F9394, 0x70708A44, 0x70788B84, 0x707C8EDC, 0x707D8DD0, 0x7085EDF2, 0x70899846, 0x708A9086,
+	0x708E898A, 0x7092E075, 0x7099E074, 0x70ABEDF3, 0x70ACE078, 0x70AD9259, 0x70AEE07B, 0x70AFE076,
+	0x70B3E07A, 0x70B8E079, 0x70B9935F, 0x70BA88D7, 0x70BBED46, 0x70C897F3, 0x70CBE07D, 0x70CF8947,
+	0x70D9E080, 0x70DDE07E, 0x70DFE07C, 0x70F1E077, 0x70F99642, 0x70FDE082, 0x7104EDF5, 0x7109E081,
+	0x710FEDF4, 0x7114898B, 0x7119E084, 0x711A95B0, 0x711CE083, 0x712196B3, 0x71268FC5, 0x71369152,
+	0x713C8FC4, 0x7146EDF7, 0x7147EDF8, 0x714997F9, 0x714CE08A, 0x714E90F7, 0x7155E086, 0x7156E08B,
+	0x7159898C, 0x715CEDF6, 0x7162E089, 0x71649481, 0x7165E085, 0x7166E088, 0x71678FC6, 0x716994CF,
+	0x716CE08C, 0x716E8ECF, 0x717D90F8, 0x7184E08F, 0x7188E087, 0x718A8C46, 0x718FE08D, 0x7194976F,
+	0x7195E090, 0x7199EAA4, 0x719F8F6E, 0x71A8E091, 0x71ACE092, 0x71B1944D, 0x71B9E094, 0x71BEE095,
+	0x71C1EDFA, 0x71C39452, 0x71C89395, 0x71C9E097, 0x71CEE099, 0x71D097D3, 0x71D2E096, 0x71D4E098,
+	0x71D5898D, 0x71D7E093, 0x71DF9A7A, 0x71E0E09A, 0x71E59187, 0x71E68E57, 0x71E7E09C, 0x71ECE09B,
+	0x71ED9043, 0x71EE99D7, 0x71F5E09D, 0x71F9E09F, 0x71FBE08E, 0x71FCE09E, 0x71FEEDFB, 0x71FFE0A0,
+	0x7206949A, 0x720DE0A1, 0x7210E0A2, 0x721BE0A3, 0x7228E0A4, 0x722A92DC, 0x722CE0A6, 0x722DE0A5,
+	0x7230E0A7, 0x7232E0A8, 0x72358EDD, 0x72369583, 0x723A96EA, 0x723BE0A9, 0x723CE0AA, 0x723D9175,
+	0x723E8EA2, 0x723FE0AB, 0x7240E0AC, 0x7246E0AD, 0x724795D0, 0x724894C5, 0x724BE0AE, 0x724C9476,
+	0x725292AB, 0x7258E0AF, 0x725989E5, 0x725B8B8D, 0x725D96C4, 0x725F96B4, 0x726189B2, 0x72629853,
+	0x72679671, 0x726995A8, 0x727290B5, 0x7274E0B0, 0x727993C1, 0x727D8CA1, 0x727EE0B1, 0x72808DD2,
+	0x7281E0B3, 0x7282E0B2, 0x7287E0B4, 0x7292E0B5, 0x7296E0B6, 0x72A08B5D, 0x72A2E0B7, 0x72A7E0B8,
+	0x72AC8CA2, 0x72AF94C6, 0x72B1EDFC, 0x72B2E0BA, 0x72B68FF3, 0x72B9E0B9, 0x72BEEE40, 0x72C28BB6,
+	0x72C3E0BB, 0x72C4E0BD, 0x72C6E0BC, 0x72CEE0BE, 0x72D08CCF, 0x72D2E0BF, 0x72D78BE7, 0x72D9915F,
+	0x72DB8D9D, 0x72E0E0C1, 0x72E1E0C2, 0x72E2E0C0, 0x72E98EEB, 0x72EC93C6, 0x72ED8BB7, 0x72F7E0C4,
+	0x72F8924B, 0x72F9E0C3, 0x72FC9854, 0x72FD9482, 0x730AE0C7, 0x7316E0C9, 0x7317E0C6, 0x731B96D2,
+	0x731CE0C8, 0x731DE0CA, 0x731F97C2, 0x7324EE41, 0x7325E0CE, 0x7329E0CD, 0x732A9296, 0x732B944C,
+	0x732E8CA3, 0x732FE0CC, 0x7334E0CB, 0x73369750, 0x73379751, 0x733EE0CF, 0x733F898E, 0x73448D96,
+	0x73458E82, 0x734EE0D0, 0x734FE0D1, 0x7357E0D3, 0x73638F62, 0x7368E0D5, 0x736AE0D4, 0x7370E0D6,
+	0x73728A6C, 0x7375E0D8, 0x7377EE43, 0x7378E0D7, 0x737AE0DA, 0x737BE0D9, 0x73848CBA, 0x738797A6,
+	0x73898BCA, 0x738B89A4, 0x73968BE8, 0x73A98ADF, 0x73B297E6, 0x73B3E0DC, 0x73BBE0DE, 0x73BDEE44,
+	0x73C0E0DF, 0x73C289CF, 0x73C8E0DB, 0x73C9EE45, 0x73CA8E58, 0x73CD92BF, 0x73CEE0DD, 0x73D2EE48,
+	0x73D6EE46, 0x73DEE0E2, 0x73E08EEC, 0x73E3EE47, 0x73E5E0E0, 0x73EA8C5D, 0x73ED94C7, 0x73EEE0E1,
+	0x73F1E0FC, 0x73F5EE4A, 0x73F8E0E7, 0x73FE8CBB, 0x74038B85, 0x7405E0E4, 0x7406979D, 0x7407EE49,
+	0x740997AE, 0x742291F4, 0x7425E0E6, 0x7426EE4B, 0x7429EE4D, 0x742AEE4C, 0x742EEE4E, 0x7432E0E8,
+	0x743397D4, 0x74348BD5, 0x743594FA, 0x74369469, 0x743AE0E9, 0x743FE0EB, 0x7441E0EE, 0x7455E0EA,
+	0x7459E0ED, 0x745A8CE8, 0x745B896C, 0x745CE0EF, 0x745E9090, 0x745FE0EC, 0x746097DA, 0x7462EE4F,
+	0x7463E0F2, 0x7464EAA2, 0x7469E0F0, 0x746AE0F3, 0x746FE0E5, 0x7470E0F1, 0x74738DBA, 0x7476E0F4,
+	0x747EE0F5, 0x7483979E, 0x7489EE50, 0x748BE0F6, 0x749EE0F7, 0x749FEE51, 0x74A2E0E3, 0x74A7E0F8,
+	0x74B08AC2, 0x74BD8EA3, 0x74CAE0F9, 0x74CFE0FA, 0x74D4E0FB, 0x74DC895A, 0x74E0E140, 0x74E2955A,
+	0x74E3E141, 0x74E68AA2, 0x74E7E142, 0x74E9E143, 0x74EEE144, 0x74F0E146, 0x74F1E147, 0x74F2E145,
+	0x74F69572, 0x74F7E149, 0x74F8E148, 0x7501EE52, 0x7503E14B, 0x7504E14A, 0x7505E14C, 0x750CE14D,
+	0x750DE14F, 0x750EE14E, 0x75118D99, 0x7513E151, 0x7515E150, 0x75188AC3, 0x751A9072, 0x751C935B,
+	0x751EE152, 0x751F90B6, 0x75238E59, 0x75258999, 0x7526E153, 0x75289770, 0x752B95E1, 0x752CE154,
+	0x752FED8C, 0x75309363, 0x75319752, 0x75328D62, 0x7533905C, 0x7537926A, 0x753899B2, 0x753A92AC,
+	0x753B89E6, 0x753CE155, 0x7544E156, 0x7546E15B, 0x7549E159, 0x754AE158, 0x754B9DC0, 0x754C8A45,
+	0x754DE157, 0x754F88D8, 0x755194A8, 0x755494C8, 0x755997AF, 0x755AE15C, 0x755BE15A, 0x755C927B,
+	0x755D90A4, 0x756094A9, 0x7562954C, 0x7564E15E, 0x756597AA, 0x75668C6C, 0x7567E15F, 0x7569E15D,
+	0x756A94D4, 0x756BE160, 0x756DE161, 0x756FEE53, 0x757088D9, 0x75738FF4, 0x7574E166, 0x7576E163,
+	0x757793EB, 0x7578E162, 0x757F8B45, 0x7582E169, 0x7586E164, 0x7587E165, 0x7589E168, 0x758AE167,
+	0x758B9544, 0x758E9161, 0x758F9160, 0x75918B5E, 0x7594E16A, 0x759AE16B, 0x759DE16C, 0x75A3E16E,
+	0x75A5E16D, 0x75AB8975, 0x75B1E176, 0x75B294E6, 0x75B3E170, 0x75B5E172, 0x75B8E174, 0x75B9905D,
+	0x75BCE175, 0x75BDE173, 0x75BE8EBE, 0x75C2E16F, 0x75C3E171, 0x75C59561, 0x75C78FC7, 0x75CAE178,
+	0x75CDE177, 0x75D2E179, 0x75D48EA4, 0x75D58DAD, 0x75D89397, 0x75D9E17A, 0x75DB92C9, 0x75DEE17C,
+	0x75E2979F, 0x75E3E17B, 0x75E99189, 0x75F0E182, 0x75F2E184, 0x75F3E185, 0x75F49273, 0x75FAE183,
+	0x75FCE180, 0x75FEE17D, 0x75FFE17E, 0x7601E181, 0x7609E188, 0x760BE186, 0x760DE187, 0x761FE189,
+	0x7620E18B, 0x7621E18C, 0x7622E18D, 0x7624E18E, 0x7627E18A, 0x7630E190, 0x7634E18F, 0x763BE191,
+	0x764297C3, 0x7646E194, 0x7647E192, 0x7648E193, 0x764C8AE0, 0x765296FC, 0x765695C8, 0x7658E196,
+	0x765CE195, 0x7661E197, 0x7662E198, 0x7667E19C, 0x7668E199, 0x7669E19A, 0x766AE19B, 0x766CE19D,
+	0x7670E19E, 0x7672E19F, 0x7676E1A0, 0x7678E1A1, 0x767A94AD, 0x767B936F, 0x767CE1A2, 0x767D9492,
+	0x767E9553, 0x7680E1A3, 0x7682EE54, 0x7683E1A4, 0x76849349, 0x76868A46, 0x76878D63, 0x7688E1A5,
+	0x768BE1A6, 0x768EE1A7, 0x76908E48, 0x7693E1A9, 0x7696E1A8, 0x7699E1AA, 0x769AE1AB, 0x769BEE57,
+	0x769CEE55, 0x769EEE56, 0x76A6EE58, 0x76AE94E7, 0x76B0E1AC, 0x76B4E1AD, 0x76B7EA89, 0x76B8E1AE,
+	0x76B9E1AF, 0x76BAE1B0, 0x76BF8E4D, 0x76C2E1B1, 0x76C39475, 0x76C6967E, 0x76C8896D, 0x76CA8976,
+	0x76CDE1B2, 0x76D2E1B4, 0x76D6E1B3, 0x76D79390, 0x76DB90B7, 0x76DC9F58, 0x76DEE1B5, 0x76DF96BF,
+	0x76E1E1B6, 0x76E38AC4, 0x76E494D5, 0x76E5E1B7, 0x76E7E1B8, 0x76EAE1B9, 0x76EE96DA, 0x76F296D3,
+	0x76F492BC, 0x76F8918A, 0x76FBE1BB, 0x76FE8F82, 0x77018FC8, 0x7704E1BE, 0x7707E1BD, 0x7708E1BC,
+	0x770994FB, 0x770B8AC5, 0x770C8CA7, 0x771BE1C4, 0x771EE1C1, 0x771F905E, 0x772096B0, 0x7724E1C0,
+	0x7725E1C2, 0x7726E1C3, 0x7729E1BF, 0x7737E1C5, 0x7738E1C6, 0x773A92AD, 0x773C8AE1, 0x77409285,
+	0x7746EE5A, 0x7747E1C7, 0x775AE1C8, 0x775BE1CB, 0x77619087, 0x776393C2, 0x7765E1CC, 0x77669672,
+	0x7768E1C9, 0x776BE1CA, 0x7779E1CF, 0x777EE1CE, 0x777FE1CD, 0x778BE1D1, 0x778EE1D0, 0x7791E1D2,
+	0x779EE1D4, 0x77A0E1D3, 0x77A595CB, 0x77AC8F75, 0x77AD97C4, 0x77B0E1D5, 0x77B393B5, 0x77B6E1D6,
+	0x77B9E1D7, 0x77BBE1DB, 0x77BCE1D9, 0x77BDE1DA, 0x77BFE1D8, 0x77C7E1DC, 0x77CDE1DD, 0x77D7E1DE,
+	0x77DAE1DF, 0x77DB96B5, 0x77DCE1E0, 0x77E296EE, 0x77E3E1E1, 0x77E5926D, 0x77E7948A, 0x77E98BE9,
+	0x77ED925A, 0x77EEE1E2, 0x77EF8BB8, 0x77F390CE, 0x77FCE1E3, 0x78028DBB, 0x780CE1E4, 0x7812E1E5,
+	0x78148CA4, 0x78158DD3, 0x7820E1E7, 0x7821EE5C, 0x78259375, 0x78268DD4, 0x78278B6D, 0x78329643,
+	0x7834946A, 0x783A9376, 0x783F8D7B, 0x7845E1E9, 0x784EEE5D, 0x785D8FC9, 0x7864EE5E, 0x786B97B0,
+	0x786C8D64, 0x786F8CA5, 0x787294A1, 0x7874E1EB, 0x787AEE5F, 0x787CE1ED, 0x78818CE9, 0x7886E1EC,
+	0x788792F4, 0x788CE1EF, 0x788D8A56, 0x788EE1EA, 0x789194E8, 0x7893894F, 0x78958DEA, 0x78979871,
+	0x789AE1EE, 0x78A3E1F0, 0x78A795C9, 0x78A990D7, 0x78AAE1F2, 0x78AFE1F3, 0x78B5E1F1, 0x78BA8A6D,
+	0x78BCE1F9, 0x78BEE1F8, 0x78C18EA5, 0x78C5E1FA, 0x78C6E1F5, 0x78CAE1FB, 0x78CBE1F6, 0x78D094D6,
+	0x78D1E1F4, 0x78D4E1F7, 0x78DAE241, 0x78E7E240, 0x78E89681, 0x78ECE1FC, 0x78EF88E9, 0x78F4E243,
+	0x78FDE242, 0x79018FCA, 0x7907E244, 0x790E9162, 0x7911E246, 0x7912E245, 0x7919E247, 0x7926E1E6,
+	0x792AE1E8, 0x792BE249, 0x792CE248, 0x7930EE60, 0x793A8EA6, 0x793C97E7, 0x793E8ED0, 0x7940E24A,
+	0x79418C56, 0x79478B5F, 0x79488B46, 0x79498E83, 0x79509753, 0x7953E250, 0x7955E24F, 0x79569163,
+	0x7957E24C, 0x795AE24E, 0x795D8F6A, 0x795E905F, 0x795FE24D, 0x7960E24B, 0x79629449, 0x79658FCB,
+	0x7968955B, 0x796D8DD5, 0x79779398, 0x797AE251, 0x797FE252, 0x7980E268, 0x79818BD6, 0x7984985C,
+	0x79859154, 0x798AE253, 0x798D89D0, 0x798E92F5, 0x798F959F, 0x7994EE64, 0x799BEE66, 0x799DE254,
+	0x79A68B9A, 0x79A7E255, 0x79AAE257, 0x79AEE258, 0x79B09448, 0x79B3E259, 0x79B9E25A, 0x79BAE25B,
+	0x79BD8BD7, 0x79BE89D1, 0x79BF93C3, 0x79C08F47, 0x79C18E84, 0x79C9E25C, 0x79CB8F48, 0x79D189C8,
+	0x79D29562, 0x79D5E25D, 0x79D894E9, 0x79DF9164, 0x79E1E260, 0x79E3E261, 0x79E49489, 0x79E69060,
+	0x79E7E25E, 0x79E99281, 0x79ECE25F, 0x79F08FCC, 0x79FB88DA, 0x7A008B48, 0x7A08E262, 0x7A0B92F6,
+	0x7A0DE263, 0x7A0E90C5, 0x7A1496AB, 0x7A179542, 0x7A18E264, 0x7A19E265, 0x7A1A9274, 0x7A1C97C5,
+	0x7A1FE267, 0x7A20E266, 0x7A2E8EED, 0x7A31E269, 0x7A3288EE, 0x7A37E26C, 0x7A3BE26A, 0x7A3C89D2,
+	0x7A3D8C6D, 0x7A3EE26B, 0x7A3F8D65, 0x7A408D92, 0x7A4295E4, 0x7A43E26D, 0x7A469673, 0x7A49E26F,
+	0x7A4D90CF, 0x7A4E896E, 0x7A4F89B8, 0x7A5088AA, 0x7A57E26E, 0x7A61E270, 0x7A62E271, 0x7A638FF5,
+	0x7A69E272, 0x7A6B8A6E, 0x7A70E274, 0x7A748C8A, 0x7A768B86, 0x7A79E275, 0x7A7A8BF3, 0x7A7DE276,
+	0x7A7F90FA, 0x7A8193CB, 0x7A8390DE, 0x7A848DF3, 0x7A88E277, 0x7A929282, 0x7A93918B, 0x7A95E279,
+	0x7A96E27B, 0x7A97E278, 0x7A98E27A, 0x7A9F8C41, 0x7AA9E27C, 0x7AAA8C45, 0x7AAE8B87, 0x7AAF9771,
+	0x7AB0E27E, 0x7AB6E280, 0x7ABA894D, 0x7ABFE283, 0x7AC38A96, 0x7AC4E282, 0x7AC5E281, 0x7AC7E285,
+	0x7AC8E27D, 0x7ACAE286, 0x7ACB97A7, 0x7ACDE287, 0x7ACFE288, 0x7AD1EE67, 0x7AD29AF2, 0x7AD3E28A,
+	0x7AD5E289, 0x7AD9E28B, 0x7ADAE28C, 0x7ADC97B3, 0x7ADDE28D, 0x7ADFE8ED, 0x7AE08FCD, 0x7AE1E28E,
+	0x7AE2E28F, 0x7AE38F76, 0x7AE593B6, 0x7AE6E290, 0x7AE7EE68, 0x7AEA9247, 0x7AEBEE6A, 0x7AEDE291,
+	0x7AEF925B, 0x7AF0E292, 0x7AF68BA3, 0x7AF8995E, 0x7AF9927C, 0x7AFA8EB1, 0x7AFF8AC6, 0x7B02E293,
+	0x7B04E2A0, 0x7B06E296, 0x7B088B88, 0x7B0AE295, 0x7B0BE2A2, 0x7B0FE294, 0x7B118FCE, 0x7B18E298,
+	0x7B19E299, 0x7B1B934A, 0x7B1EE29A, 0x7B208A7D, 0x7B259079, 0x7B269584, 0x7B28E29C, 0x7B2C91E6,
+	0x7B33E297, 0x7B35E29B, 0x7B36E29D, 0x7B398DF9, 0x7B45E2A4, 0x7B46954D, 0x7B4894A4, 0x7B499399,
+	0x7B4B8BD8, 0x7B4CE2A3, 0x7B4DE2A1, 0x7B4F94B3, 0x7B50E29E, 0x7B51927D, 0x7B52939B, 0x7B54939A,
+	0x7B568DF4, 0x7B5DE2B6, 0x7B65E2A6, 0x7B67E2A8, 0x7B6CE2AB, 0x7B6EE2AC, 0x7B70E2A9, 0x7B71E2AA,
+	0x7B74E2A7, 0x7B75E2A5, 0x7B7AE29F, 0x7B8695CD, 0x7B8789D3, 0x7B8BE2B3, 0x7B8DE2B0, 0x7B8FE2B5,
+	0x7B92E2B4, 0x7B949493, 0x7B9596A5, 0x7B978E5A, 0x7B98E2AE, 0x7B99E2B7, 0x7B9AE2B2, 0x7B9CE2B1,
+	0x7B9DE2AD, 0x7B9EEE6B, 0x7B9FE2AF, 0x7BA18AC7, 0x7BAA925C, 0x7BAD90FB, 0x7BB194A0, 0x7BB4E2BC,
+	0x7BB894A2, 0x7BC090DF, 0x7BC1E2B9, 0x7BC494CD, 0x7BC6E2BD, 0x7BC795D1, 0x7BC9927A, 0x7BCBE2B8,
+	0x7BCCE2BA, 0x7BCFE2BB, 0x7BDDE2BE, 0x7BE08EC2, 0x7BE493C4, 0x7BE5E2C3, 0x7BE6E2C2, 0x7BE9E2BF,
+	0x7BED9855, 0x7BF3E2C8, 0x7BF6E2CC, 0x7BF7E2C9, 0x7C00E2C5, 0x7C07E2C6, 0x7C0DE2CB, 0x7C11E2C0,
+	0x7C1299D3, 0x7C13E2C7, 0x7C14E2C1, 0x7C17E2CA, 0x7C1FE2D0, 0x7C218AC8, 0x7C23E2CD, 0x7C27E2CE,
+	0x7C2AE2CF, 0x7C2BE2D2, 0x7C37E2D1, 0x7C3894F4, 0x7C3DE2D3, 0x7C3E97FA, 0x7C3F95EB, 0x7C40E2D8,
+	0x7C43E2D5, 0x7C4CE2D4, 0x7C4D90D0, 0x7C4FE2D7, 0x7C50E2D9, 0x7C54E2D6, 0x7C56E2DD, 0x7C58E2DA,
+	0x7C5FE2DB, 0x7C60E2C4, 0x7C64E2DC, 0x7C65E2DE, 0x7C6CE2DF, 0x7C7395C4, 0x7C75E2E0, 0x7C7E96E0,
+	0x7C818BCC, 0x7C828C48, 0x7C83E2E1, 0x7C8995B2, 0x7C8B9088, 0x7C8D96AE, 0x7C90E2E2, 0x7C9297B1,
+	0x7C959494, 0x7C979165, 0x7C989453, 0x7C9B8F6C, 0x7C9F88BE, 0x7CA1E2E7, 0x7CA2E2E5, 0x7CA4E2E3,
+	0x7CA58A9F, 0x7CA78FCF, 0x7CA8E2E8, 0x7CABE2E6, 0x7CADE2E4, 0x7CAEE2EC, 0x7CB1E2EB, 0x7CB2E2EA,
+	0x7CB3E2E9, 0x7CB9E2ED, 0x7CBDE2EE, 0x7CBE90B8, 0x7CC0E2EF, 0x7CC2E2F1, 0x7CC5E2F0, 0x7CCA8CD0,
+	0x7CCE9157, 0x7CD2E2F3, 0x7CD6939C, 0x7CD8E2F2, 0x7CDCE2F4, 0x7CDE95B3, 0x7CDF918C, 0x7CE08D66,
+	0x7CE2E2F5, 0x7CE797C6, 0x7CEFE2F7, 0x7CF2E2F8, 0x7CF4E2F9, 0x7CF6E2FA, 0x7CF88E85, 0x7CFAE2FB,
+	0x7CFB8C6E, 0x7CFE8B8A, 0x7D008B49, 0x7D02E340, 0x7D0496F1, 0x7D058D67, 0x7D06E2FC, 0x7D0AE343,
+	0x7D0B96E4, 0x7D0D945B, 0x7D109552, 0x7D148F83, 0x7D15E342, 0x7D178ED1, 0x7D188D68, 0x7D198E86,
+	0x7D1A8B89, 0x7D1B95B4, 0x7D1CE341, 0x7D209166, 0x7D219661, 0x7D228DF5, 0x7D2B8E87, 0x7D2C92DB,
+	0x7D2EE346, 0x7D2F97DD, 0x7D308DD7, 0x7D32E347, 0x7D339061, 0x7D35E349, 0x7D398FD0, 0x7D3A8DAE,
+	0x7D3FE348, 0x7D428F49, 0x7D438CBC, 0x7D449167, 0x7D45E344, 0x7D46E34A, 0x7D48EE6D, 0x7D4BE345,
+	0x7D4C8C6F, 0x7D4EE34D, 0x7D4FE351, 0x7D508C8B, 0x7D56E34C, 0x7D5BE355, 0x7D5CEE6E, 0x7D5E8D69,
+	0x7D61978D, 0x7D6288BA, 0x7D63E352, 0x7D668B8B, 0x7D68E34F, 0x7D6EE350, 0x7D71939D, 0x7D72E34E,
+	0x7D73E34B, 0x7D758A47, 0x7D7690E2, 0x7D798CA6, 0x7D7DE357, 0x7D89E354, 0x7D8FE356, 0x7D93E353,
+	0x7D998C70, 0x7D9A91B1, 0x7D9BE358, 0x7D9C918E, 0x7D9FE365, 0x7DA0EE70, 0x7DA2E361, 0x7DA3E35B,
+	0x7DABE35F, 0x7DAC8EF8, 0x7DAD88DB, 0x7DAEE35A, 0x7DAFE362, 0x7DB0E366, 0x7DB18D6A, 0x7DB296D4,
+	0x7DB492D4, 0x7DB5E35C, 0x7DB7EE6F, 0x7DB8E364, 0x7DBAE359, 0x7DBB925D, 0x7DBDE35E, 0x7DBE88BB,
+	0x7DBF96C8, 0x7DC7E35D, 0x7DCA8BD9, 0x7DCB94EA, 0x7DCF918D, 0x7DD197CE, 0x7DD28F8F, 0x7DD5E38E,
+	0x7DD6EE71, 0x7DD8E367, 0x7DDA90FC, 0x7DDCE363, 0x7DDDE368, 0x7DDEE36A, 0x7DE092F7, 0x7DE1E36D,
+	0x7DE4E369, 0x7DE895D2, 0x7DE98AC9, 0x7DEC96C9, 0x7DEF88DC, 0x7DF2E36C, 0x7DF497FB, 0x7DFBE36B,
+	0x7E01898F, 0x7E0493EA, 0x7E05E36E, 0x7E09E375, 0x7E0AE36F, 0x7E0BE376, 0x7E12E372, 0x7E1B949B,
+	0x7E1E8EC8, 0x7E1FE374, 0x7E21E371, 0x7E22E377, 0x7E23E370, 0x7E268F63, 0x7E2B9644, 0x7E2E8F6B,
+	0x7E31E373, 0x7E32E380, 0x7E35E37B, 0x7E37E37E, 0x7E39E37C, 0x7E3AE381, 0x7E3BE37A, 0x7E3DE360,
+	0x7E3E90D1, 0x7E4194C9, 0x7E43E37D, 0x7E46E378, 0x7E4A9140, 0x7E4B8C71, 0x7E4D8F4A, 0x7E52EE72,
+	0x7E549044, 0x7E559155, 0x7E56E384, 0x7E59E386, 0x7E5AE387, 0x7E5DE383, 0x7E5EE385, 0x7E66E379,
+	0x7E67E382, 0x7E69E38A, 0x7E6AE389, 0x7E6D969A, 0x7E708C4A, 0x7E79E388, 0x7E7BE38C, 0x7E7CE38B,
+	0x7E7DE38F, 0x7E7FE391, 0x7E828E5B, 0x7E83E38D, 0x7E88E392, 0x7E89E393, 0x7E8AED40, 0x7E8CE394,
+	0x7E8EE39A, 0x7E8F935A, 0x7E90E396, 0x7E92E395, 0x7E93E397, 0x7E94E398, 0x7E96E399, 0x7E9BE39B,
+	0x7E9CE39C, 0x7F368ACA, 0x7F38E39D, 0x7F3AE39E, 0x7F45E39F, 0x7F47EE73, 0x7F4CE3A0, 0x7F4DE3A1,
+	0x7F4EE3A2, 0x7F50E3A3, 0x7F51E3A4, 0x7F54E3A6, 0x7F55E3A5, 0x7F58E3A7, 0x7F5FE3A8, 0x7F60E3A9,
+	0x7F67E3AC, 0x7F68E3AA, 0x7F69E3AB, 0x7F6A8DDF, 0x7F6B8C72, 0x7F6E9275, 0x7F7094B1, 0x7F728F90,
+	0x7F75946C, 0x7F7794EB, 0x7F78E3AD, 0x7F799CEB, 0x7F82E3AE, 0x7F83E3B0, 0x7F859785, 0x7F86E3AF,
+	0x7F87E3B2, 0x7F88E3B1, 0x7F8A9772, 0x7F8CE3B3, 0x7F8E94FC, 0x7F94E3B4, 0x7F9AE3B7, 0x7F9DE3B6,
+	0x7F9EE3B5, 0x7FA1EE74, 0x7FA3E3B8, 0x7FA48C51, 0x7FA89141, 0x7FA98B60, 0x7FAEE3BC, 0x7FAFE3B9,
+	0x7FB2E3BA, 0x7FB6E3BD, 0x7FB8E3BE, 0x7FB9E3BB, 0x7FBD8948, 0x7FC189A5, 0x7FC5E3C0, 0x7FC6E3C1,
+	0x7FCAE3C2, 0x7FCC9782, 0x7FD28F4B, 0x7FD4E3C4, 0x7FD5E3C3, 0x7FE09089, 0x7FE1E3C5, 0x7FE6E3C6,
+	0x7FE9E3C7, 0x7FEB8AE3, 0x7FF08ACB, 0x7FF3E3C8, 0x7FF9E3C9, 0x7FFB967C, 0x7FFC9783, 0x80009773,
+	0x80019856, 0x80038D6C, 0x8004E3CC, 0x80058ED2, 0x8006E3CB, 0x800BE3CD, 0x800C8EA7, 0x801091CF,
+	0x8012E3CE, 0x80158D6B, 0x801796D5, 0x8018E3CF, 0x8019E3D0, 0x801CE3D1, 0x8021E3D2, 0x8028E3D3,
+	0x80338EA8, 0x803696EB, 0x803BE3D5, 0x803D925E, 0x803FE3D4, 0x8046E3D7, 0x804AE3D6, 0x8052E3D8,
+	0x805690B9, 0x8058E3D9, 0x805AE3DA, 0x805E95B7, 0x805FE3DB, 0x8061918F, 0x8062E3DC, 0x8068E3DD,
+	0x806F97FC, 0x8070E3E0, 0x8072E3DF, 0x8073E3DE, 0x807492AE, 0x8076E3E1, 0x80779045, 0x8079E3E2,
+	0x807DE3E3, 0x807E9857, 0x807FE3E4, 0x8084E3E5, 0x8085E3E7, 0x8086E3E6, 0x808794A3, 0x808993F7,
+	0x808B985D, 0x808C94A7, 0x8093E3E9, 0x80968FD1, 0x80989549, 0x809AE3EA, 0x809BE3E8, 0x809D8ACC,
+	0x80A18CD2, 0x80A28E88, 0x80A594EC, 0x80A98CA8, 0x80AA9662, 0x80ACE3ED, 0x80ADE3EB, 0x80AF8D6D,
+	0x80B18D6E, 0x80B288E7, 0x80B48DE6, 0x80BA9478, 0x80C388DD, 0x80C4E3F2, 0x80C6925F, 0x80CC9477,
+	0x80CE91D9, 0x80D6E3F4, 0x80D9E3F0, 0x80DAE3F3, 0x80DBE3EE, 0x80DDE3F1, 0x80DE9645, 0x80E18CD3,
+	0x80E488FB, 0x80E5E3EF, 0x80EFE3F6, 0x80F1E3F7, 0x80F493B7, 0x80F88BB9, 0x80FCE445, 0x80FD945C,
+	0x81028E89, 0x81058BBA, 0x810690C6, 0x81079865, 0x810896AC, 0x8109E3F5, 0x810A90D2, 0x811A8B72,
+	0x811BE3F8, 0x8123E3FA, 0x8129E3F9, 0x812FE3FB, 0x81319245, 0x8133945D, 0x813992AF, 0x813EE442,
+	0x8146E441, 0x814BE3FC, 0x814E9074, 0x81509585, 0x8151E444, 0x8153E443, 0x81548D6F, 0x81559872,
+	0x815FE454, 0x8165E448, 0x8166E449, 0x816B8EEE, 0x816EE447, 0x81708D98, 0x8171E446, 0x8174E44A,
+	0x817892B0, 0x817995A0, 0x817A9142, 0x817F91DA, 0x8180E44E, 0x8182E44F, 0x8183E44B, 0x8188E44C,
+	0x818AE44D, 0x818F8D70, 0x8193E455, 0x8195E451, 0x819A9586, 0x819C968C, 0x819D9547, 0x81A0E450,
+	0x81A3E453, 0x81A4E452, 0x81A89663, 0x81A9E456, 0x81B0E457, 0x81B39156, 0x81B5E458, 0x81B8E45A,
+	0x81BAE45E, 0x81BDE45B, 0x81BEE459, 0x81BF945E, 0x81C0E45C, 0x81C2E45D, 0x81C689B0, 0x81C8E464,
+	0x81C9E45F, 0x81CDE460, 0x81D1E461, 0x81D3919F, 0x81D8E463, 0x81D9E462, 0x81DAE465, 0x81DFE466,
+	0x81E0E467, 0x81E39062, 0x81E589E7, 0x81E7E468, 0x81E897D5, 0x81EA8EA9, 0x81ED8F4C, 0x81F38E8A,
+	0x81F49276, 0x81FAE469, 0x81FBE46A, 0x81FC8950, 0x81FEE46B, 0x8201E46C, 0x8202E46D, 0x8205E46E,
+	0x8207E46F, 0x82088BBB, 0x82099DA8, 0x820AE470, 0x820C90E3, 0x820DE471, 0x820E8EC9, 0x8210E472,
+	0x821298AE, 0x8216E473, 0x821795DC, 0x82188ADA, 0x821B9143, 0x821C8F77, 0x821E9591, 0x821F8F4D,
+	0x8229E474, 0x822A8D71, 0x822BE475, 0x822C94CA, 0x822EE484, 0x8233E477, 0x823591C7, 0x82369495,
+	0x82378CBD, 0x8238E476, 0x82399144, 0x8240E478, 0x824792F8, 0x8258E47A, 0x8259E479, 0x825AE47C,
+	0x825DE47B, 0x825FE47D, 0x8262E480, 0x8264E47E, 0x82668ACD, 0x8268E481, 0x826AE482, 0x826BE483,
+	0x826E8DAF, 0x826F97C7, 0x8271E485, 0x82729046, 0x82768990, 0x8277E486, 0x8278E487, 0x827EE488,
+	0x828B88F0, 0x828DE489, 0x8292E48A, 0x82999587, 0x829D8EC5, 0x829FE48C, 0x82A58A48, 0x82A688B0,
+	0x82ABE48B, 0x82ACE48E, 0x82AD946D, 0x82AF9063, 0x82B189D4, 0x82B39646, 0x82B88C7C, 0x82B98BDA,
+	0x82BBE48D, 0x82BD89E8, 0x82C58AA1, 0x82D18991, 0x82D2E492, 0x82D397E8, 0x82D491DB, 0x82D79563,
+	0x82D9E49E, 0x82DB89D5, 0x82DCE49C, 0x82DEE49A, 0x82DFE491, 0x82E1E48F, 0x82E3E490, 0x82E58EE1,
+	0x82E68BEA, 0x82E79297, 0x82EB93CF, 0x82F18970, 0x82F3E494, 0x82F4E493, 0x82F9E499, 0x82FAE495,
+	0x82FBE498, 0x8301EE76, 0x830296CE, 0x8303E497, 0x830489D6, 0x83058A9D, 0x8306E49B, 0x8309E49D,
+	0x830E8C73, 0x8316E4A1, 0x8317E4AA, 0x8318E4AB, 0x831C88A9, 0x8323E4B2, 0x832888EF, 0x832BE4A9,
+	0x832FE4A8, 0x8331E4A3, 0x8332E4A2, 0x8334E4A0, 0x8335E49F, 0x83369283, 0x833891F9, 0x8339E4A5,
+	0x8340E4A4, 0x8345E4A7, 0x83499190, 0x834A8C74, 0x834F8960, 0x8350E4A6, 0x83528D72, 0x83589191,
+	0x8362EE77, 0x8373E4B8, 0x8375E4B9, 0x837789D7, 0x837B89AC, 0x837CE4B6, 0x837FEE78, 0x8385E4AC,
+	0x8387E4B4, 0x8389E4BB, 0x838AE4B5, 0x838EE4B3, 0x8393E496, 0x8396E4B1, 0x839AE4AD, 0x839E8ACE,
+	0x839FE4AF, 0x83A0E4BA, 0x83A2E4B0, 0x83A8E4BC, 0x83AAE4AE, 0x83AB949C, 0x83B19789, 0x83B5E4B7,
+	0x83BDE4CD, 0x83C1E4C5, 0x83C5909B, 0x83C7EE79, 0x83CA8B65, 0x83CC8BDB, 0x83CEE4C0, 0x83D389D9,
+	0x83D68FD2, 0x83D8E4C3, 0x83DC8DD8, 0x83DF9370, 0x83E0E4C8, 0x83E995EC, 0x83EBE4BF, 0x83EF89D8,
+	0x83F08CD4, 0x83F19548, 0x83F2E4C9, 0x83F4E4BD, 0x83F6EE7A, 0x83F7E4C6, 0x83FBE4D0, 0x83FDE4C1,
+	0x8403E4C2, 0x840493B8, 0x8407E4C7, 0x840BE4C4, 0x840C9647, 0x840DE4CA, 0x840E88DE, 0x8413E4BE,
+	0x8420E4CC, 0x8422E4CB, 0x8429948B, 0x842AE4D2, 0x842CE4DD, 0x84318A9E, 0x8435E4E0, 0x8438E4CE,
+	0x843CE4D3, 0x843D978E, 0x8446E4DC, 0x8448EE7B, 0x84499774, 0x844E97A8, 0x84579298, 0x845B8A8B,
+	0x84619592, 0x8462E4E2, 0x8463939F, 0x846688AF, 0x8469E4DB, 0x846BE4D7, 0x846C9192, 0x846DE4D1,
+	0x846EE4D9, 0x846FE4DE, 0x8471944B, 0x847588A8, 0x8477E4D6, 0x8479E4DF, 0x847A9598, 0x8482E4DA,
+	0x8484E4D5, 0x848B8FD3, 0x84908F4E, 0x84948EAA, 0x849996D6, 0x849C9566, 0x849FE4E5, 0x84A1E4EE,
+	0x84ADE4D8, 0x84B28A97, 0x84B4EE7C, 0x84B88FF6, 0x84B9E4E3, 0x84BBE4E8, 0x84BC9193, 0x84BFE4E4,
+	0x84C1E4EB, 0x84C4927E, 0x84C6E4EC, 0x84C99775, 0x84CAE4E1, 0x84CB8A57, 0x84CDE4E7, 0x84D0E4EA,
+	0x84D196AA, 0x84D6E4ED, 0x84D9E4E6, 0x84DAE4E9, 0x84DCED44, 0x84EC9648, 0x84EE9840, 0x84F4E4F1,
+	0x84FCE4F8, 0x84FFE4F0, 0x85008EC1, 0x8506E4CF, 0x851195CC, 0x851396A0, 0x8514E4F7, 0x8515E4F6,
+	0x8517E4F2, 0x8518E4F3, 0x851A8955, 0x851FE4F5, 0x8521E4EF, 0x852692D3, 0x852CE4F4, 0x852D88FC,
+	0x853591A0, 0x853D95C1, 0x8540E4F9, 0x8541E540, 0x854394D7, 0x8548E4FC, 0x85498FD4, 0x854A8EC7,
+	0x854BE542, 0x854E8BBC, 0x8553EE7D, 0x8555E543, 0x85579599, 0x8558E4FB, 0x8559EE7E, 0x855AE4D4,
+	0x8563E4FA, 0x8568986E, 0x856993A0, 0x856A9593, 0x856BEE80, 0x856DE54A, 0x8577E550, 0x857EE551,
+	0x8580E544, 0x85849496, 0x8587E54E, 0x8588E546, 0x858AE548, 0x8590E552, 0x8591E547, 0x8594E54B,
+	0x85978992, 0x859993E3, 0x859BE54C, 0x859CE54F, 0x85A4E545, 0x85A69145, 0x85A8E549, 0x85A98E46,
+	0x85AA9064, 0x85AB8C4F, 0x85AC96F2, 0x85AE96F7, 0x85AF8F92, 0x85B0EE82, 0x85B9E556, 0x85BAE554,
+	0x85C1986D, 0x85C9E553, 0x85CD9795, 0x85CFE555, 0x85D0E557, 0x85D5E558, 0x85DCE55B, 0x85DDE559,
+	0x85E493A1, 0x85E5E55A, 0x85E994CB, 0x85EAE54D, 0x85F78F93, 0x85F9E55C, 0x85FAE561, 0x85FB9194,
+	0x85FEE560, 0x8602E541, 0x8606E562, 0x86079168, 0x860AE55D, 0x860BE55F, 0x8613E55E, 0x86169F50,
+	0x86179F41, 0x861AE564, 0x8622E563, 0x862D9796, 0x862FE1BA, 0x8630E565, 0x863FE566, 0x864DE567,
+	0x864E8CD5, 0x86508B73, 0x8654E569, 0x8655997C, 0x865A8B95, 0x865C97B8, 0x865E8BF1, 0x865FE56A,
+	0x8667E56B, 0x866B928E, 0x8671E56C, 0x867993F8, 0x867B88B8, 0x868A89E1, 0x868BE571, 0x868CE572,
+	0x8693E56D, 0x86958E5C, 0x86A3E56E, 0x86A49461, 0x86A9E56F, 0x86AAE570, 0x86ABE57A, 0x86AFE574,
+	0x86B0E577, 0x86B6E573, 0x86C4E575, 0x86C6E576, 0x86C78ED6, 0x86C9E578, 0x86CB9260, 0x86CD8C75,
+	0x86CE8A61, 0x86D4E57B, 0x86D98A5E, 0x86DBE581, 0x86DEE57C, 0x86DFE580, 0x86E494B8, 0x86E9E57D,
+	0x86ECE57E, 0x86ED9567, 0x86EE94D8, 0x86EFE582, 0x86F891FB, 0x86F9E58C, 0x86FBE588, 0x86FE89E9,
+	0x8700E586, 0x87029649, 0x8703E587, 0x8706E584, 0x8708E585, 0x8709E58A, 0x870AE58D, 0x870DE58B,
+	0x8711E589, 0x8712E583, 0x87189277, 0x871AE594, 0x871C96A8, 0x8725E592, 0x8729E593, 0x8734E58E,
+	0x8737E590, 0x873BE591, 0x873FE58F, 0x874990E4, 0x874B9858, 0x874CE598, 0x874EE599, 0x8753E59F,
+	0x87559049, 0x8757E59B, 0x8759E59E, 0x875FE596, 0x8760E595, 0x8763E5A0, 0x876689DA, 0x8768E59C,
+	0x876AE5A1, 0x876EE59D, 0x8774E59A, 0x877692B1, 0x8778E597, 0x877F9488, 0x8782E5A5, 0x878D975A,
+	0x879FE5A4, 0x87A2E5A3, 0x87ABE5AC, 0x87AFE5A6, 0x87B3E5AE, 0x87BA9786, 0x87BBE5B1, 0x87BDE5A8,
+	0x87C0E5A9, 0x87C4E5AD, 0x87C6E5B0, 0x87C7E5AF, 0x87CBE5A7, 0x87D0E5AA, 0x87D2E5BB, 0x87E0E5B4,
+	0x87EFE5B2, 0x87F2E5B3, 0x87F6E5B8, 0x87F7E5B9, 0x87F98A49, 0x87FB8B61, 0x87FEE5B7, 0x8805E5A2,
+	0x8807EE85, 0x880DE5B6, 0x880EE5BA, 0x880FE5B5, 0x8811E5BC, 0x8815E5BE, 0x8816E5BD, 0x8821E5C0,
+	0x8822E5BF, 0x8823E579, 0x8827E5C4, 0x8831E5C1, 0x8836E5C2, 0x8839E5C3, 0x883BE5C5, 0x88408C8C,
+	0x8842E5C7, 0x8844E5C6, 0x88468F4F, 0x884C8D73, 0x884D9FA5, 0x8852E5C8, 0x88538F70, 0x88578A58,
+	0x8859E5C9, 0x885B8971, 0x885D8FD5, 0x885EE5CA, 0x88618D74, 0x8862E5CB, 0x886388DF, 0x8868955C,
+	0x886BE5CC, 0x8870908A, 0x8872E5D3, 0x8875E5D0, 0x8877928F, 0x887DE5D1, 0x887EE5CE, 0x887F8BDC,
+	0x8881E5CD, 0x8882E5D4, 0x88888C55, 0x888B91DC, 0x888DE5DA, 0x8892E5D6, 0x889691B3, 0x8897E5D5,
+	0x8899E5D8, 0x889EE5CF, 0x88A2E5D9, 0x88A4E5DB, 0x88AB94ED, 0x88AEE5D7, 0x88B0E5DC, 0x88B1E5DE,
+	0x88B48CD1, 0x88B5E5D2, 0x88B788BF, 0x88BFE5DD, 0x88C18DD9, 0x88C297F4, 0x88C3E5DF, 0x88C4E5E0,
+	0x88C59195, 0x88CF97A0, 0x88D4E5E1, 0x88D59754, 0x88D8E5E2, 0x88D9E5E3, 0x88DC95E2, 0x88DDE5E4,
+	0x88DF8DBE, 0x88E197A1, 0x88E8E5E9, 0x88F2E5EA, 0x88F38FD6, 0x88F4E5E8, 0x88F5EE86, 0x88F89787,
+	0x88F9E5E5, 0x88FCE5E7, 0x88FD90BB, 0x88FE909E, 0x8902E5E6, 0x8904E5EB, 0x890795A1, 0x890AE5ED,
+	0x890CE5EC, 0x89108A8C, 0x8912964A, 0x8913E5EE, 0x891CED41, 0x891DE5FA, 0x891EE5F0, 0x8925E5F1,
+	0x892AE5F2, 0x892BE5F3, 0x8936E5F7, 0x8938E5F8, 0x893BE5F6, 0x8941E5F4, 0x8943E5EF, 0x8944E5F5,
+	0x894CE5F9, 0x894DE8B5, 0x895689A6, 0x895EE5FC, 0x895F8BDD, 0x8960E5FB, 0x8964E641, 0x8966E640,
+	0x896AE643, 0x896DE642, 0x896FE644, 0x89728F50, 0x8974E645, 0x8977E646, 0x897EE647, 0x897F90BC,
+	0x89819776, 0x8983E648, 0x898695A2, 0x89879465, 0x8988E649, 0x898AE64A, 0x898B8CA9, 0x898F8B4B,
+	0x8993E64B, 0x89968E8B, 0x89979460, 0x8998E64C, 0x899A8A6F, 0x89A1E64D, 0x89A6E64F, 0x89A79797,
+	0x89A9E64E, 0x89AA9065, 0x89ACE650, 0x89AFE651, 0x89B2E652, 0x89B38ACF, 0x89BAE653, 0x89BDE654,
+	0x89BFE655, 0x89C0E656, 0x89D28A70, 0x89DAE657, 0x89DCE658, 0x89DDE659, 0x89E389F0, 0x89E69047,
+	0x89E7E65A, 0x89F4E65B, 0x89F8E65C, 0x8A008CBE, 0x8A0292F9, 0x8A03E65D, 0x8A088C76, 0x8A0A9075,
+	0x8A0CE660, 0x8A0E93A2, 0x8A10E65F, 0x8A12EE87, 0x8A138C50, 0x8A16E65E, 0x8A1791F5, 0x8A188B4C,
+	0x8A1BE661, 0x8A1DE662, 0x8A1F8FD7, 0x8A238C8D, 0x8A25E663, 0x8A2A964B, 0x8A2D90DD, 0x8A318B96,
+	0x8A3396F3, 0x8A349169, 0x8A36E664, 0x8A37EE88, 0x8A3A9066, 0x8A3B9290, 0x8A3C8FD8, 0x8A41E665,
+	0x8A46E668, 0x8A48E669, 0x8A508DBC, 0x8A5191C0, 0x8A52E667, 0x8A548FD9, 0x8A55955D, 0x8A5BE666,
+	0x8A5E8E8C, 0x8A608972, 0x8A62E66D, 0x8A638C77, 0x8A668E8E, 0x8A698E8D, 0x8A6B986C, 0x8A6CE66C,
+	0x8A6DE66B, 0x8A6E9146, 0x8A708B6C, 0x8A719862, 0x8A728A59, 0x8A738FDA, 0x8A79EE89, 0x8A7CE66A,
+	0x8A82E66F, 0x8A84E670, 0x8A85E66E, 0x8A878CD6, 0x8A89975F, 0x8A8C8E8F, 0x8A8D9446, 0x8A91E673,
+	0x8A9390BE, 0x8A959261, 0x8A989755, 0x8A9AE676, 0x8A9E8CEA, 0x8AA090BD, 0x8AA1E672, 0x8AA3E677,
+	0x8AA48CEB, 0x8AA5E674, 0x8AA6E675, 0x8AA7EE8A, 0x8AA8E671, 0x8AAC90E0, 0x8AAD93C7, 0x8AB0924E,
+	0x8AB289DB, 0x8AB994EE, 0x8ABC8B62, 0x8ABEEE8B, 0x8ABF92B2, 0x8AC2E67A, 0x8AC4E678, 0x8AC7926B,
+	0x8ACB90BF, 0x8ACC8AD0, 0x8ACDE679, 0x8ACF907A, 0x8AD297C8, 0x8AD6985F, 0x8ADAE67B, 0x8ADBE687,
+	0x8ADC92B3, 0x8ADEE686, 0x8ADFEE8C, 0x8AE0E683, 0x8AE1E68B, 0x8AE2E684, 0x8AE4E680, 0x8AE692FA,
+	0x8AE7E67E, 0x8AEBE67C, 0x8AED9740, 0x8AEE8E90, 0x8AF1E681, 0x8AF3E67D, 0x8AF6EE8E, 0x8AF7E685,
+	0x8AF88F94, 0x8AFA8CBF, 0x8AFE91F8, 0x8B009664, 0x8B018979, 0x8B0288E0, 0x8B0493A3, 0x8B07E689,
+	0x8B0CE688, 0x8B0E93E4, 0x8B10E68D, 0x8B14E682, 0x8B16E68C, 0x8B17E68E, 0x8B198CAA, 0x8B1AE68A,
+	0x8B1B8D75, 0x8B1D8ED3, 0x8B20E68F, 0x8B219777, 0x8B26E692, 0x8B28E695, 0x8B2BE693, 0x8B2C9554,
+	0x8B33E690, 0x8B398BDE, 0x8B3EE694, 0x8B41E696, 0x8B49E69A, 0x8B4CE697, 0x8B4EE699, 0x8B4FE698,
+	0x8B53EE8F, 0x8B56E69B, 0x8B588EAF, 0x8B5AE69D, 0x8B5BE69C, 0x8B5C9588, 0x8B5FE69F, 0x8B668C78,
+	0x8B6BE69E, 0x8B6CE6A0, 0x8B6FE6A1, 0x8B708B63, 0x8B71E3BF, 0x8B728FF7, 0x8B74E6A2, 0x8B778CEC,
+	0x8B7DE6A3, 0x8B7FEE90, 0x8B80E6A4, 0x8B838E5D, 0x8B8A9DCC, 0x8B8CE6A5, 0x8B8EE6A6, 0x8B908F51,
+	0x8B92E6A7, 0x8B93E6A8, 0x8B96E6A9, 0x8B99E6AA, 0x8B9AE6AB, 0x8C37924A, 0x8C3AE6AC, 0x8C3FE6AE,
+	0x8C41E6AD, 0x8C4693A4, 0x8C48E6AF, 0x8C4A964C, 0x8C4CE6B0, 0x8C4EE6B1, 0x8C50E6B2, 0x8C55E6B3,
+	0x8C5A93D8, 0x8C618FDB, 0x8C62E6B4, 0x8C6A8D8B, 0x8C6B98AC, 0x8C6CE6B5, 0x8C78E6B6, 0x8C79955E,
+	0x8C7AE6B7, 0x8C7CE6BF, 0x8C82E6B8, 0x8C85E6BA, 0x8C89E6B9, 0x8C8AE6BB, 0x8C8C9665, 0x8C8DE6BC,
+	0x8C8EE6BD, 0x8C94E6BE, 0x8C98E6C0, 0x8C9D8A4C, 0x8C9E92E5, 0x8CA09589, 0x8CA18DE0, 0x8CA28D76,
+	0x8CA7956E, 0x8CA889DD, 0x8CA994CC, 0x8CAAE6C3, 0x8CAB8AD1, 0x8CAC90D3, 0x8CADE6C2, 0x8CAEE6C7,
+	0x8CAF9299, 0x8CB096E1, 0x8CB2E6C5, 0x8CB3E6C6, 0x8CB48B4D, 0x8CB6E6C8, 0x8CB79483, 0x8CB891DD,
+	0x8CBB94EF, 0x8CBC935C, 0x8CBDE6C4, 0x8CBF9666, 0x8CC089EA, 0x8CC1E6CA, 0x8CC29847, 0x8CC392C0,
+	0x8CC49864, 0x8CC78E91, 0x8CC8E6C9, 0x8CCA91AF, 0x8CCDE6DA, 0x8CCE9147, 0x8CD193F6, 0x8CD3956F,
+	0x8CDAE6CD, 0x8CDB8E5E, 0x8CDC8E92, 0x8CDE8FDC, 0x8CE09485, 0x8CE28CAB, 0x8CE3E6CC, 0x8CE4E6CB,
+	0x8CE6958A, 0x8CEA8EBF, 0x8CED9371, 0x8CF0EE91, 0x8CF4EE92, 0x8CFAE6CF, 0x8CFBE6D0, 0x8CFC8D77,
+	0x8CFDE6CE, 0x8D04E6D1, 0x8D05E6D2, 0x8D07E6D4, 0x8D0891A1, 0x8D0AE6D3, 0x8D0B8AE4, 0x8D0DE6D6,
+	0x8D0FE6D5, 0x8D10E6D7, 0x8D12EE93, 0x8D13E6D9, 0x8D14E6DB, 0x8D16E6DC, 0x8D6490D4, 0x8D668ECD,
+	0x8D67E6DD, 0x8D6B8A71, 0x8D6DE6DE, 0x8D709196, 0x8D71E6DF, 0x8D73E6E0, 0x8D74958B, 0x8D76EE94,
+	0x8D778B4E, 0x8D81E6E1, 0x8D8592B4, 0x8D8A897A, 0x8D99E6E2, 0x8DA38EEF, 0x8DA89096, 0x8DB391AB,
+	0x8DBAE6E5, 0x8DBEE6E4, 0x8DC2E6E3, 0x8DCBE6EB, 0x8DCCE6E9, 0x8DCFE6E6, 0x8DD6E6E8, 0x8DDAE6E7,
+	0x8DDBE6EA, 0x8DDD8B97, 0x8DDFE6EE, 0x8DE190D5, 0x8DE3E6EF, 0x8DE88CD7, 0x8DEAE6EC, 0x8DEBE6ED,
+	0x8DEF9848, 0x8DF392B5, 0x8DF59148, 0x8DFCE6F0, 0x8DFFE6F3, 0x8E08E6F1, 0x8E09E6F2, 0x8E0A9778,
+	0x8E0F93A5, 0x8E10E6F6, 0x8E1DE6F4, 0x8E1EE6F5, 0x8E1FE6F7, 0x8E2AE748, 0x8E30E6FA, 0x8E34E6FB,
+	0x8E35E6F9, 0x8E42E6F8, 0x8E4492FB, 0x8E47E740, 0x8E48E744, 0x8E49E741, 0x8E4AE6FC, 0x8E4CE742,
+	0x8E50E743, 0x8E55E74A, 0x8E59E745, 0x8E5F90D6, 0x8E60E747, 0x8E63E749, 0x8E64E746, 0x8E72E74C,
+	0x8E748F52, 0x8E76E74B, 0x8E7CE74D, 0x8E81E74E, 0x8E84E751, 0x8E85E750, 0x8E87E74F, 0x8E8AE753,
+	0x8E8BE752, 0x8E8D96F4, 0x8E91E755, 0x8E93E754, 0x8E94E756, 0x8E99E757, 0x8EA1E759, 0x8EAAE758,
+	0x8EAB9067, 0x8EACE75A, 0x8EAF8BEB, 0x8EB0E75B, 0x8EB1E75D, 0x8EBEE75E, 0x8EC5E75F, 0x8EC6E75C,
+	0x8EC8E760, 0x8ECA8ED4, 0x8ECBE761, 0x8ECC8B4F, 0x8ECD8C52, 0x8ECFEE96, 0x8ED28CAC, 0x8EDBE762,
+	0x8EDF93EE, 0x8EE2935D, 0x8EE3E763, 0x8EEBE766, 0x8EF88EB2, 0x8EFBE765, 0x8EFCE764, 0x8EFD8C79,
+	0x8EFEE767, 0x8F038A72, 0x8F05E769, 0x8F098DDA, 0x8F0AE768, 0x8F0CE771, 0x8F12E76B, 0x8F13E76D,
+	0x8F1495E3, 0x8F15E76A, 0x8F19E76C, 0x8F1BE770, 0x8F1CE76E, 0x8F1D8B50, 0x8F1FE76F, 0x8F26E772,
+	0x8F299479, 0x8F2A97D6, 0x8F2F8F53, 0x8F33E773, 0x8F389741, 0x8F39E775, 0x8F3BE774, 0x8F3EE778,
+	0x8F3F9760, 0x8F42E777, 0x8F448A8D, 0x8F45E776, 0x8F46E77B, 0x8F49E77A, 0x8F4CE779, 0x8F4D9351,
+	0x8F4EE77C, 0x8F57E77D, 0x8F5CE77E, 0x8F5F8D8C, 0x8F618C44, 0x8F62E780, 0x8F63E781, 0x8F64E782,
+	0x8F9B9068, 0x8F9CE783, 0x8F9E8EAB, 0x8F9FE784, 0x8FA3E785, 0x8FA7999F, 0x8FA8999E, 0x8FADE786,
+	0x8FAEE390, 0x8FAFE787, 0x8FB09243, 0x8FB1904A, 0x8FB2945F, 0x8FB7E788, 0x8FBA95D3, 0x8FBB92D2,
+	0x8FBC8D9E, 0x8FBF9248, 0x8FC28949, 0x8FC49698, 0x8FC59076, 0x8FCE8C7D, 0x8FD18BDF, 0x8FD495D4,
+	0x8FDAE789, 0x8FE2E78B, 0x8FE5E78A, 0x8FE689DE, 0x8FE993F4, 0x8FEAE78C, 0x8FEB9497, 0x8FED9352,
+	0x8FEFE78D, 0x8FF08F71, 0x8FF4E78F, 0x8FF796C0, 0x8FF8E79E, 0x8FF9E791, 0x8FFAE792, 0x8FFD92C7,
+	0x900091DE, 0x90019197, 0x900393A6, 0x9005E790, 0x90068B74, 0x900BE799, 0x900DE796, 0x900EE7A3,
+	0x900F93A7, 0x90109280, 0x9011E793, 0x901392FC, 0x90149372, 0x9015E794, 0x9016E798, 0x90179080,
+	0x90199487, 0x901A92CA, 0x901D90C0, 0x901EE797, 0x901F91AC, 0x902091A2, 0x9021E795, 0x902288A7,
+	0x90239841, 0x9027E79A, 0x902E91DF, 0x90318F54, 0x90329069, 0x9035E79C, 0x9036E79B, 0x903888ED,
+	0x9039E79D, 0x903C954E, 0x903EE7A5, 0x904193D9, 0x9042908B, 0x90459278, 0x90478BF6, 0x9049E7A4,
+	0x904A9756, 0x904B895E, 0x904D95D5, 0x904E89DF, 0x904FE79F, 0x9050E7A0, 0x9051E7A1, 0x9052E7A2,
+	0x905393B9, 0x90549242, 0x905588E1, 0x9056E7A6, 0x9058E7A7, 0x9059EAA1, 0x905C91BB, 0x905EE7A8,
+	0x90608993, 0x9061916B, 0x90638CAD, 0x90659779, 0x9067EE99, 0x9068E7A9, 0x9069934B, 0x906D9198,
+	0x906E8ED5, 0x906FE7AA, 0x9072E7AD, 0x90758F85, 0x9076E7AB, 0x9077914A, 0x90789149, 0x907A88E2,
+	0x907C97C9, 0x907DE7AF, 0x907F94F0, 0x9080E7B1, 0x9081E7B0, 0x9082E7AE, 0x9083E284, 0x90848AD2,
+	0x9087E78E, 0x9089E7B3, 0x908AE7B2, 0x908FE7B4, 0x90919757, 0x90A393DF, 0x90A6964D, 0x90A8E7B5,
+	0x90AA8ED7, 0x90AFE7B6, 0x90B1E7B7, 0x90B5E7B8, 0x90B89340, 0x90C188E8, 0x90CA8D78, 0x90CE9859,
+	0x90DBE7BC, 0x90DEEE9A, 0x90E18C53, 0x90E2E7B9, 0x90E4E7BA, 0x90E89594, 0x90ED8A73, 0x90F59758,
+	0x90F78BBD, 0x90FD9373, 0x9102E7BD, 0x9112E7BE, 0x9115EE9C, 0x9119E7BF, 0x9127EE9D, 0x912D9341,
+	0x9130E7C1, 0x9132E7C0, 0x914993D1, 0x914AE7C2, 0x914B8F55, 0x914C8EDE, 0x914D947A, 0x914E9291,
+	0x91528EF0, 0x9154908C, 0x9156E7C3, 0x9158E7C4, 0x9162907C, 0x9163E7C5, 0x9165E7C6, 0x9169E7C7,
+	0x916A978F, 0x916C8F56, 0x9172E7C9, 0x9173E7C8, 0x91758D79, 0x91778D93, 0x91788E5F, 0x9182E7CC,
+	0x91878F86, 0x9189E7CB, 0x918BE7CA, 0x918D91E7, 0x91908CED, 0x919290C1, 0x919794AE, 0x919C8F58,
+	0x91A2E7CD, 0x91A48FDD, 0x91AAE7D0, 0x91ABE7CE, 0x91AFE7CF, 0x91B4E7D2, 0x91B5E7D1, 0x91B88FF8,
+	0x91BAE7D3, 0x91C0E7D4, 0x91C1E7D5, 0x91C694CE, 0x91C78DD1, 0x91C88EDF, 0x91C9E7D6, 0x91CBE7D7,
+	0x91CC97A2, 0x91CD8F64, 0x91CE96EC, 0x91CF97CA, 0x91D0E7D8, 0x91D18BE0, 0x91D6E7D9, 0x91D7EE9F,
+	0x91D89342, 0x91DAEE9E, 0x91DBE7DC, 0x91DC8A98, 0x91DD906A, 0x91DEEEA0, 0x91DFE7DA, 0x91E1E7DB,
+	0x91E392DE, 0x91E4EEA3, 0x91E5EEA4, 0x91E69674, 0x91E78BFA, 0x91EDEEA1, 0x91EEEEA2, 0x91F5E7DE,
+	0x91F6E7DF, 0x91FCE7DD, 0x91FFE7E1, 0x9206EEA5, 0x920AEEA7, 0x920D93DD, 0x920E8A62, 0x9210EEA6,
+	0x9211E7E5, 0x9214E7E2, 0x9215E7E4, 0x921EE7E0, 0x9229E86E, 0x922CE7E3, 0x923497E9, 0x92378CD8,
+	0x9239EEAE, 0x923AEEA8, 0x923CEEAA, 0x923FE7ED, 0x9240EEA9, 0x92449353, 0x9245E7E8, 0x9248E7EB,
+	0x9249E7E9, 0x924BE7EE, 0x924EEEAB, 0x9250E7EF, 0x9251EEAD, 0x9257E7E7, 0x9259EEAC, 0x925AE7F4,
+	0x925B8994, 0x925EE7E6, 0x926294AB, 0x9264E7EA, 0x92668FDE, 0x9267EEAF, 0x92718D7A, 0x9277EEB1,
+	0x9278EEB2, 0x927E9667, 0x92808BE2, 0x92838F65, 0x928593BA, 0x9288ED43, 0x9291914C, 0x9293E7F2,
+	0x9295E7EC, 0x9296E7F1, 0x929896C1, 0x929A92B6, 0x929BE7F3, 0x929CE7F0, 0x92A7EEB0, 0x92AD914B,
+	0x92B7E7F7, 0x92B9E7F6, 0x92CFE7F5, 0x92D0EEB6, 0x92D2964E, 0x92D3EEBA, 0x92D5EEB8, 0x92D7EEB4,
+	0x92D9EEB5, 0x92E0EEB9, 0x92E48F9B, 0x92E7EEB3, 0x92E9E7F8, 0x92EA95DD, 0x92ED8973, 0x92F29565,
+	0x92F39292, 0x92F88B98, 0x92F9ED49, 0x92FAE7FA, 0x92FBEEBD, 0x92FC8D7C, 0x92FFEEC0, 0x9302EEC2,
+	0x93068E4B, 0x930FE7F9, 0x9310908D, 0x9318908E, 0x9319E840, 0x931AE842, 0x931DEEC1, 0x931EEEBF,
+	0x93208FF9, 0x9321EEBC, 0x9322E841, 0x9323E843, 0x9325EEBB, 0x93268BD1, 0x93289564, 0x932B8EE0,
+	0x932C9842, 0x932EE7FC, 0x932F8DF6, 0x9332985E, 0x9335E845, 0x933AE844, 0x933BE846, 0x9344E7FB,
+	0x9348ED42, 0x934B93E7, 0x934D9374, 0x935492D5, 0x9356E84B, 0x9357EEC4, 0x935B9262, 0x935CE847,
+	0x9360E848, 0x936C8C4C, 0x936EE84A, 0x9370EEC3, 0x93758CAE, 0x937CE849, 0x937E8FDF, 0x938C8A99,
+	0x9394E84F, 0x93968DBD, 0x93979199, 0x939A92C8, 0x93A4EEC5, 0x93A78A5A, 0x93ACE84D, 0x93ADE84E,
+	0x93AE92C1, 0x93B0E84C, 0x93B9E850, 0x93C3E856, 0x93C6EEC6, 0x93C8E859, 0x93D0E858, 0x93D1934C,
+	0x93D6E851, 0x93D7E852, 0x93D8E855, 0x93DDE857, 0x93DEEEC7, 0x93E18BBE, 0x93E4E85A, 0x93E5E854,
+	0x93E8E853, 0x93F8EEC8, 0x9403E85E, 0x9407E85F, 0x9410E860, 0x9413E85D, 0x9414E85C, 0x94188FE0,
+	0x941993A8, 0x941AE85B, 0x9421E864, 0x942BE862, 0x9431EEC9, 0x9435E863, 0x9436E861, 0x943891F6,
+	0x943AE865, 0x9441E866, 0x9444E868, 0x9445EECA, 0x9448EECB, 0x94518AD3, 0x9452E867, 0x945396F8,
+	0x945AE873, 0x945BE869, 0x945EE86C, 0x9460E86A, 0x9462E86B, 0x946AE86D, 0x9470E86F, 0x9475E870,
+	0x9477E871, 0x947CE874, 0x947DE872, 0x947EE875, 0x947FE877, 0x9481E876, 0x957792B7, 0x958096E5,
+	0x9582E878, 0x9583914D, 0x9587E879, 0x958995C2, 0x958AE87A, 0x958B8A4A, 0x958F895B, 0x95918AD5,
+	0x9592EECC, 0x95938AD4, 0x9594E87B, 0x9596E87C, 0x9598E87D, 0x9599E87E, 0x95A0E880, 0x95A28AD6,
+	0x95A38A74, 0x95A48D7D, 0x95A594B4, 0x95A7E882, 0x95A8E881, 0x95ADE883, 0x95B2897B, 0x95B9E886,
+	0x95BBE885, 0x95BCE884, 0x95BEE887, 0x95C3E88A, 0x95C788C5, 0x95CAE888, 0x95CCE88C, 0x95CDE88B,
+	0x95D4E88E, 0x95D5E88D, 0x95D6E88F, 0x95D893AC, 0x95DCE890, 0x95E1E891, 0x95E2E893, 0x95E5E892,
+	0x961C958C, 0x9621E894, 0x9628E895, 0x962A8DE3, 0x962EE896, 0x962FE897, 0x96329668, 0x963B916A,
+	0x963F88A2, 0x964091C9, 0x9642E898, 0x9644958D, 0x964BE89B, 0x964CE899, 0x964D8D7E, 0x964FE89A,
+	0x96508CC0, 0x965B95C3, 0x965CE89D, 0x965DE89F, 0x965EE89E, 0x965FE8A0, 0x96628940, 0x96639077,
+	0x96648F9C, 0x96658AD7, 0x9666E8A1, 0x966A9486, 0x966CE8A3, 0x96708941, 0x9672E8A2, 0x967392C2,
+	0x967597CB, 0x967693A9, 0x9677E89C, 0x967897A4, 0x967A8CAF, 0x967D977A, 0x96858BF7, 0x968697B2,
+	0x96888C47, 0x968A91E0, 0x968BE440, 0x968DE8A4, 0x968E8A4B, 0x968F908F, 0x96948A75, 0x9695E8A6,
+	0x9697E8A7, 0x9698E8A5, 0x96998C84, 0x969B8DDB, 0x969C8FE1, 0x969DEECF, 0x96A08942, 0x96A397D7,
+	0x96A7E8A9, 0x96A8E7AC, 0x96AAE8A8, 0x96AFEED0, 0x96B0E8AC, 0x96B1E8AA, 0x96B2E8AB, 0x96B4E8AD,
+	0x96B6E8AE, 0x96B797EA, 0x96B8E8AF, 0x96B9E8B0, 0x96BB90C7, 0x96BC94B9, 0x96C0909D, 0x96C18AE5,
+	0x96C49759, 0x96C589EB, 0x96C68F57, 0x96C78CD9, 0x96C9E8B3, 0x96CBE8B2, 0x96CC8E93, 0x96CDE8B4,
+	0x96CEE8B1, 0x96D18E47, 0x96D5E8B8, 0x96D6E5AB, 0x96D999D4, 0x96DB9097, 0x96DCE8B6, 0x96E297A3,
+	0x96E393EF, 0x96E8894A, 0x96EA90E1, 0x96EB8EB4, 0x96F095B5, 0x96F2895F, 0x96F697EB, 0x96F7978B,
+	0x96F9E8B9, 0x96FB9364, 0x97008EF9, 0x9704E8BA, 0x9706E8BB, 0x9707906B, 0x9708E8BC, 0x970A97EC,
+	0x970DE8B7, 0x970EE8BE, 0x970FE8C0, 0x9711E8BF, 0x9713E8BD, 0x9716E8C1, 0x9719E8C2, 0x971C919A,
+	0x971E89E0, 0x9724E8C3, 0x972796B6, 0x972AE8C4, 0x9730E8C5, 0x97329849, 0x9733EED1, 0x97389E50,
+	0x9739E8C6, 0x973BEED2, 0x973DE8C7, 0x973EE8C8, 0x9742E8CC, 0x9743EED3, 0x9744E8C9, 0x9746E8CA,
+	0x9748E8CB, 0x9749E8CD, 0x974DEED4, 0x974FEED5, 0x9751EED6, 0x975290C2, 0x9755EED7, 0x975696F5,
+	0x975990C3, 0x975CE8CE, 0x975E94F1, 0x9760E8CF, 0x9761EA72, 0x976296CA, 0x9764E8D0, 0x9766E8D1,
+	0x9768E8D2, 0x97698A76, 0x976BE8D4, 0x976D9078, 0x9771E8D5, 0x97748C43, 0x9779E8D6, 0x977AE8DA,
+	0x977CE8D8, 0x9781E8D9, 0x97848A93, 0x9785E8D7, 0x9786E8DB, 0x978BE8DC, 0x978D88C6, 0x978FE8DD,
+	0x9790E8DE, 0x97988FE2, 0x979CE8DF, 0x97A08B66, 0x97A3E8E2, 0x97A6E8E1, 0x97A8E8E0, 0x97ABE691,
+	0x97AD95DA, 0x97B3E8E3, 0x97B4E8E4, 0x97C3E8E5, 0x97C6E8E6, 0x97C8E8E7, 0x97CBE8E8, 0x97D38AD8,
+	0x97DCE8E9, 0x97EDE8EA, 0x97EE9442, 0x97F2E8EC, 0x97F389B9, 0x97F5E8EF, 0x97F6E8EE, 0x97FB8943,
+	0x97FF8BBF, 0x980195C5, 0x980292B8, 0x98038DA0, 0x98058D80, 0x98068F87, 0x9808907B, 0x980CE8F1,
+	0x980FE8F0, 0x98109761, 0x98118AE6, 0x981294D0, 0x981393DA, 0x9817909C, 0x981897CC, 0x981A8C7A,
+	0x9821E8F4, 0x9824E8F3, 0x982C966A, 0x982D93AA, 0x9834896F, 0x9837E8F5, 0x9838E8F2, 0x983B9570,
+	0x983C978A, 0x983DE8F6, 0x9846E8F7, 0x984BE8F9, 0x984C91E8, 0x984D8A7A, 0x984E8A7B, 0x984FE8F8,
+	0x98548AE7, 0x98558CB0, 0x9857EED8, 0x98588AE8, 0x985B935E, 0x985E97DE, 0x9865EED9, 0x98678CDA,
+	0x986BE8FA, 0x986FE8FB, 0x9870E8FC, 0x9871E940, 0x9873E942, 0x9874E941, 0x98A89597, 0x98AAE943,
+	0x98AFE944, 0x98B1E945, 0x98B6E946, 0x98C3E948, 0x98C4E947, 0x98C6E949, 0x98DB94F2, 0x98DCE3CA,
+	0x98DF9048, 0x98E28B51, 0x98E9E94A, 0x98EBE94B, 0x98ED99AA, 0x98EE9F5A, 0x98EF94D1, 0x98F288F9,
+	0x98F488B9, 0x98FC8E94, 0x98FD964F, 0x98FE8FFC, 0x9903E94C, 0x990596DD, 0x9909E94D, 0x990A977B,
+	0x990C8961, 0x99108E60, 0x9912E94E, 0x991389EC, 0x9914E94F, 0x9918E950, 0x991DE952, 0x991EE953,
+	0x9920E955, 0x9921E951, 0x9924E954, 0x9927EEDC, 0x99288AD9, 0x992CE956, 0x992EE957, 0x993DE958,
+	0x993EE959, 0x9942E95A, 0x9945E95C, 0x9949E95B, 0x994BE95E, 0x994CE961, 0x9950E95D, 0x9951E95F,
+	0x9952E960, 0x9955E962, 0x99578BC0, 0x99968EF1, 0x9997E963, 0x9998E964, 0x99998D81, 0x999EEEDE,
+	0x99A5E965, 0x99A88A5D, 0x99AC946E, 0x99ADE966, 0x99AEE967, 0x99B39279, 0x99B493E9, 0x99BCE968,
+	0x99C1949D, 0x99C491CA, 0x99C58977, 0x99C68BEC, 0x99C88BED, 0x99D09293, 0x99D1E96D, 0x99D28BEE,
+	0x99D589ED, 0x99D8E96C, 0x99DBE96A, 0x99DDE96B, 0x99DFE969, 0x99E2E977, 0x99EDE96E, 0x99EEE96F,
+	0x99F1E970, 0x99F2E971, 0x99F8E973, 0x99FBE972, 0x99FF8F78, 0x9A01E974, 0x9A05E976, 0x9A0E8B52,
+	0x9A0FE975, 0x9A12919B, 0x9A138CB1, 0x9A19E978, 0x9A2891CB, 0x9A2BE979, 0x9A3093AB, 0x9A37E97A,
+	0x9A3EE980, 0x9A40E97D, 0x9A42E97C, 0x9A43E97E, 0x9A45E97B, 0x9A4DE982, 0x9A4EEEDF, 0x9A55E981,
+	0x9A57E984, 0x9A5A8BC1, 0x9A5BE983, 0x9A5FE985, 0x9A62E986, 0x9A64E988, 0x9A65E987, 0x9A69E989,
+	0x9A6AE98B, 0x9A6BE98A, 0x9AA88D9C, 0x9AADE98C, 0x9AB0E98D, 0x9AB88A5B, 0x9ABCE98E, 0x9AC0E98F,
+	0x9AC49091, 0x9ACFE990, 0x9AD1E991, 0x9AD3E992, 0x9AD4E993, 0x9AD88D82, 0x9AD9EEE0, 0x9ADCEEE1,
+	0x9ADEE994, 0x9ADFE995, 0x9AE2E996, 0x9AE3E997, 0x9AE6E998, 0x9AEA94AF, 0x9AEBE99A, 0x9AED9545,
+	0x9AEEE99B, 0x9AEFE999, 0x9AF1E99D, 0x9AF4E99C, 0x9AF7E99E, 0x9AFBE99F, 0x9B06E9A0, 0x9B18E9A1,
+	0x9B1AE9A2, 0x9B1FE9A3, 0x9B22E9A4, 0x9B23E9A5, 0x9B25E9A6, 0x9B27E9A7, 0x9B28E9A8, 0x9B29E9A9,
+	0x9B2AE9AA, 0x9B2EE9AB, 0x9B2FE9AC, 0x9B319F54, 0x9B32E9AD, 0x9B3BE2F6, 0x9B3C8B53, 0x9B418A40,
+	0x9B428DB0, 0x9B43E9AF, 0x9B44E9AE, 0x9B4596A3, 0x9B4DE9B1, 0x9B4EE9B2, 0x9B4FE9B0, 0x9B51E9B3,
+	0x9B549682, 0x9B58E9B4, 0x9B5A8B9B, 0x9B6F9844, 0x9B72EEE3, 0x9B74E9B5, 0x9B75EEE2, 0x9B83E9B7,
+	0x9B8E88BC, 0x9B8FEEE4, 0x9B91E9B8, 0x9B9295A9, 0x9B93E9B6, 0x9B96E9B9, 0x9B97E9BA, 0x9B9FE9BB,
+	0x9BA0E9BC, 0x9BA8E9BD, 0x9BAA968E, 0x9BAB8E4C, 0x9BAD8DF8, 0x9BAE914E, 0x9BB1EEE5, 0x9BB4E9BE,
+	0x9BB9E9C1, 0x9BBBEEE6, 0x9BC0E9BF, 0x9BC6E9C2, 0x9BC98CEF, 0x9BCAE9C0, 0x9BCFE9C3, 0x9BD1E9C4,
+	0x9BD2E9C5, 0x9BD4E9C9, 0x9BD68E49, 0x9BDB91E2, 0x9BE1E9CA, 0x9BE2E9C7, 0x9BE3E9C6, 0x9BE4E9C8,
+	0x9BE88C7E, 0x9BF0E9CE, 0x9BF1E9CD, 0x9BF2E9CC, 0x9BF588B1, 0x9C00EEE7, 0x9C04E9D8, 0x9C06E9D4,
+	0x9C08E9D5, 0x9C09E9D1, 0x9C0AE9D7, 0x9C0CE9D3, 0x9C0D8A82, 0x9C10986B, 0x9C12E9D6, 0x9C13E9D2,
+	0x9C14E9D0, 0x9C15E9CF, 0x9C1BE9DA, 0x9C21E9DD, 0x9C24E9DC, 0x9C25E9DB, 0x9C2D9568, 0x9C2EE9D9,
+	0x9C2F88F1, 0x9C30E9DE, 0x9C32E9E0, 0x9C398A8F, 0x9C3AE9CB, 0x9C3B8956, 0x9C3EE9E2, 0x9C46E9E1,
+	0x9C47E9DF, 0x9C48924C, 0x9C529690, 0x9C5797D8, 0x9C5AE9E3, 0x9C60E9E4, 0x9C67E9E5, 0x9C76E9E6,
+	0x9C78E9E7, 0x9CE592B9, 0x9CE7E9E8, 0x9CE994B5, 0x9CEBE9ED, 0x9CECE9E9, 0x9CF0E9EA, 0x9CF39650,
+	0x9CF496C2, 0x9CF693CE, 0x9D03E9EE, 0x9D06E9EF, 0x9D0793BC, 0x9D08E9EC, 0x9D09E9EB, 0x9D0E89A8,
+	0x9D12E9F7, 0x9D15E9F6, 0x9D1B8995, 0x9D1FE9F4, 0x9D23E9F3, 0x9D26E9F1, 0x9D288A9B, 0x9D2AE9F0,
+	0x9D2B8EB0, 0x9D2C89A7, 0x9D3B8D83, 0x9D3EE9FA, 0x9D3FE9F9, 0x9D41E9F8, 0x9D44E9F5, 0x9D46E9FB,
+	0x9D48E9FC, 0x9D50EA44, 0x9D51EA43, 0x9D59EA45, 0x9D5C894C, 0x9D5DEA40, 0x9D5EEA41, 0x9D608D94,
+	0x9D6196B7, 0x9D64EA42, 0x9D6BEEE9, 0x9D6C9651, 0x9D6FEA4A, 0x9D70EEE8, 0x9D72EA46, 0x9D7AEA4B,
+	0x9D87EA48, 0x9D89EA47, 0x9D8F8C7B, 0x9D9AEA4C, 0x9DA4EA4D, 0x9DA9EA4E, 0x9DABEA49, 0x9DAFE9F2,
+	0x9DB2EA4F, 0x9DB492DF, 0x9DB8EA53, 0x9DBAEA54, 0x9DBBEA52, 0x9DC1EA51, 0x9DC2EA57, 0x9DC4EA50,
+	0x9DC6EA55, 0x9DCFEA56, 0x9DD3EA59, 0x9DD9EA58, 0x9DE6EA5B, 0x9DEDEA5C, 0x9DEFEA5D, 0x9DF29868,
+	0x9DF8EA5A, 0x9DF991E9, 0x9DFA8DEB, 0x9DFDEA5E, 0x9E19EEEB, 0x9E1AEA5F, 0x9E1BEA60, 0x9E1EEA61,
+	0x9E75EA62, 0x9E788CB2, 0x9E79EA63, 0x9E7DEA64, 0x9E7F8EAD, 0x9E81EA65, 0x9E88EA66, 0x9E8BEA67,
+	0x9E8CEA68, 0x9E91EA6B, 0x9E92EA69, 0x9E93985B, 0x9E95EA6A, 0x9E9797ED, 0x9E9DEA6C, 0x9E9F97D9,
+	0x9EA5EA6D, 0x9EA6949E, 0x9EA9EA6E, 0x9EAAEA70, 0x9EADEA71, 0x9EB8EA6F, 0x9EB98D8D, 0x9EBA96CB,
+	0x9EBB9683, 0x9EBC9BF5, 0x9EBE9F80, 0x9EBF969B, 0x9EC489A9, 0x9ECCEA73, 0x9ECD8B6F, 0x9ECEEA74,
+	0x9ECFEA75, 0x9ED0EA76, 0x9ED1EEEC, 0x9ED28D95, 0x9ED4EA77, 0x9ED8E0D2, 0x9ED996D9, 0x9EDB91E1,
+	0x9EDCEA78, 0x9EDDEA7A, 0x9EDEEA79, 0x9EE0EA7B, 0x9EE5EA7C, 0x9EE8EA7D, 0x9EEFEA7E, 0x9EF4EA80,
+	0x9EF6EA81, 0x9EF7EA82, 0x9EF9EA83, 0x9EFBEA84, 0x9EFCEA85, 0x9EFDEA86, 0x9F07EA87, 0x9F08EA88,
+	0x9F0E9343, 0x9F138CDB, 0x9F15EA8A, 0x9F20916C, 0x9F21EA8B, 0x9F2CEA8C, 0x9F3B9540, 0x9F3EEA8D,
+	0x9F4AEA8E, 0x9F4BE256, 0x9F4EE6D8, 0x9F4FE8EB, 0x9F52EA8F, 0x9F54EA90, 0x9F5FEA92, 0x9F60EA93,
+	0x9F61EA94, 0x9F6297EE, 0x9F63EA91, 0x9F66EA95, 0x9F67EA96, 0x9F6AEA98, 0x9F6CEA97, 0x9F72EA9A,
+	0x9F76EA9B, 0x9F77EA99, 0x9F8D97B4, 0x9F95EA9C, 0x9F9CEA9D, 0x9F9DE273, 0x9FA0EA9E, 0xE000F040,
+	0xE001F041, 0xE002F042, 0xE003F043, 0xE004F044, 0xE005F045, 0xE006F046, 0xE007F047, 0xE008F048,
+	0xE009F049, 0xE00AF04A, 0xE00BF04B, 0xE00CF04C, 0xE00DF04D, 0xE00EF04E, 0xE00FF04F, 0xE010F050,
+	0xE011F051, 0xE012F052, 0xE013F053, 0xE014F054, 0xE015F055, 0xE016F056, 0xE017F057, 0xE018F058,
+	0xE019F059, 0xE01AF05A, 0xE01BF05B, 0xE01CF05C, 0xE01DF05D, 0xE01EF05E, 0xE01FF05F, 0xE020F060,
+	0xE021F061, 0xE022F062, 0xE023F063, 0xE024F064, 0xE025F065, 0xE026F066, 0xE027F067, 0xE028F068,
+	0xE029F069, 0xE02AF06A, 0xE02BF06B, 0xE02CF06C, 0xE02DF06D, 0xE02EF06E, 0xE02FF06F, 0xE030F070,
+	0xE031F071, 0xE032F072, 0xE033F073, 0xE034F074, 0xE035F075, 0xE036F076, 0xE037F077, 0xE038F078,
+	0xE039F079, 0xE03AF07A, 0xE03BF07B, 0xE03CF07C, 0xE03DF07D, 0xE03EF07E, 0xE03FF080, 0xE040F081,
+	0xE041F082, 0xE042F083, 0xE043F084, 0xE044F085, 0xE045F086, 0xE046F087, 0xE047F088, 0xE048F089,
+	0xE049F08A, 0xE04AF08B, 0xE04BF08C, 0xE04CF08D, 0xE04DF08E, 0xE04EF08F, 0xE04FF090, 0xE050F091,
+	0xE051F092, 0xE052F093, 0xE053F094, 0xE054F095, 0xE055F096, 0xE056F097, 0xE057F098, 0xE058F099,
+	0xE059F09A, 0xE05AF09B, 0xE05BF09C, 0xE05CF09D, 0xE05DF09E, 0xE05EF09F, 0xE05FF0A0, 0xE060F0A1,
+	0xE061F0A2, 0xE062F0A3, 0xE063F0A4, 0xE064F0A5, 0xE065F0A6, 0xE066F0A7, 0xE067F0A8, 0xE068F0A9,
+	0xE069F0AA, 0xE06AF0AB, 0xE06BF0AC, 0xE06CF0AD, 0xE06DF0AE, 0xE06EF0AF, 0xE06FF0B0, 0xE070F0B1,
+	0xE071F0B2, 0xE072F0B3, 0xE073F0B4, 0xE074F0B5, 0xE075F0B6, 0xE076F0B7, 0xE077F0B8, 0xE078F0B9,
+	0xE079F0BA, 0xE07AF0BB, 0xE07BF0BC, 0xE07CF0BD, 0xE07DF0BE, 0xE07EF0BF, 0xE07FF0C0, 0xE080F0C1,
+	0xE081F0C2, 0xE082F0C3, 0xE083F0C4, 0xE084F0C5, 0xE085F0C6, 0xE086F0C7, 0xE087F0C8, 0xE088F0C9,
+	0xE089F0CA, 0xE08AF0CB, 0xE08BF0CC, 0xE08CF0CD, 0xE08DF0CE, 0xE08EF0CF, 0xE08FF0D0, 0xE090F0D1,
+	0xE091F0D2, 0xE092F0D3, 0xE093F0D4, 0xE094F0D5, 0xE095F0D6, 0xE096F0D7, 0xE097F0D8, 0xE098F0D9,
+	0xE099F0DA, 0xE09AF0DB, 0xE09BF0DC, 0xE09CF0DD, 0xE09DF0DE, 0xE09EF0DF, 0xE09FF0E0, 0xE0A0F0E1,
+	0xE0A1F0E2, 0xE0A2F0E3, 0xE0A3F0E4, 0xE0A4F0E5, 0xE0A5F0E6, 0xE0A6F0E7, 0xE0A7F0E8, 0xE0A8F0E9,
+	0xE0A9F0EA, 0xE0AAF0EB, 0xE0ABF0EC, 0xE0ACF0ED, 0xE0ADF0EE, 0xE0AEF0EF, 0xE0AFF0F0, 0xE0B0F0F1,
+	0xE0B1F0F2, 0xE0B2F0F3, 0xE0B3F0F4, 0xE0B4F0F5, 0xE0B5F0F6, 0xE0B6F0F7, 0xE0B7F0F8, 0xE0B8F0F9,
+	0xE0B9F0FA, 0xE0BAF0FB, 0xE0BBF0FC, 0xE0BCF140, 0xE0BDF141, 0xE0BEF142, 0xE0BFF143, 0xE0C0F144,
+	0xE0C1F145, 0xE0C2F146, 0xE0C3F147, 0xE0C4F148, 0xE0C5F149, 0xE0C6F14A, 0xE0C7F14B, 0xE0C8F14C,
+	0xE0C9F14D, 0xE0CAF14E, 0xE0CBF14F, 0xE0CCF150, 0xE0CDF151, 0xE0CEF152, 0xE0CFF153, 0xE0D0F154,
+	0xE0D1F155, 0xE0D2F156, 0xE0D3F157, 0xE0D4F158, 0xE0D5F159, 0xE0D6F15A, 0xE0D7F15B, 0xE0D8F15C,
+	0xE0D9F15D, 0xE0DAF15E, 0xE0DBF15F, 0xE0DCF160, 0xE0DDF161, 0xE0DEF162, 0xE0DFF163, 0xE0E0F164,
+	0xE0E1F165, 0xE0E2F166, 0xE0E3F167, 0xE0E4F168, 0xE0E5F169, 0xE0E6F16A, 0xE0E7F16B, 0xE0E8F16C,
+	0xE0E9F16D, 0xE0EAF16E, 0xE0EBF16F, 0xE0ECF170, 0xE0EDF171, 0xE0EEF172, 0xE0EFF173, 0xE0F0F174,
+	0xE0F1F175, 0xE0F2F176, 0xE0F3F177, 0xE0F4F178, 0xE0F5F179, 0xE0F6F17A, 0xE0F7F17B, 0xE0F8F17C,
+	0xE0F9F17D, 0xE0FAF17E, 0xE0FBF180, 0xE0FCF181, 0xE0FDF182, 0xE0FEF183, 0xE0FFF184, 0xE100F185,
+	0xE101F186, 0xE102F187, 0xE103F188, 0xE104F189, 0xE105F18A, 0xE106F18B, 0xE107F18C, 0xE108F18D,
+	0xE109F18E, 0xE10AF18F, 0xE10BF190, 0xE10CF191, 0xE10DF192, 0xE10EF193, 0xE10FF194, 0xE110F195,
+	0xE111F196, 0xE112F197, 0xE113F198, 0xE114F199, 0xE115F19A, 0xE116F19B, 0xE117F19C, 0xE118F19D,
+	0xE119F19E, 0xE11AF19F, 0xE11BF1A0, 0xE11CF1A1, 0xE11DF1A2, 0xE11EF1A3, 0xE11FF1A4, 0xE120F1A5,
+	0xE121F1A6, 0xE122F1A7, 0xE123F1A8, 0xE124F1A9, 0xE125F1AA, 0xE126F1AB, 0xE127F1AC, 0xE128F1AD,
+	0xE129F1AE, 0xE12AF1AF, 0xE12BF1B0, 0xE12CF1B1, 0xE12DF1B2, 0xE12EF1B3, 0xE12FF1B4, 0xE130F1B5,
+	0xE131F1B6, 0xE132F1B7, 0xE133F1B8, 0xE134F1B9, 0xE135F1BA, 0xE136F1BB, 0xE137F1BC, 0xE138F1BD,
+	0xE139F1BE, 0xE13AF1BF, 0xE13BF1C0, 0xE13CF1C1, 0xE13DF1C2, 0xE13EF1C3, 0xE13FF1C4, 0xE140F1C5,
+	0xE141F1C6, 0xE142F1C7, 0xE143F1C8, 0xE144F1C9, 0xE145F1CA, 0xE146F1CB, 0xE147F1CC, 0xE148F1CD,
+	0xE149F1CE, 0xE14AF1CF, 0xE14BF1D0, 0xE14CF1D1, 0xE14DF1D2, 0xE14EF1D3, 0xE14FF1D4, 0xE150F1D5,
+	0xE151F1D6, 0xE152F1D7, 0xE153F1D8, 0xE154F1D9, 0xE155F1DA, 0xE156F1DB, 0xE157F1DC, 0xE158F1DD,
+	0xE159F1DE, 0xE15AF1DF, 0xE15BF1E0, 0xE15CF1E1, 0xE15DF1E2, 0xE15EF1E3, 0xE15FF1E4, 0xE160F1E5,
+	0xE161F1E6, 0xE162F1E7, 0xE163F1E8, 0xE164F1E9, 0xE165F1EA, 0xE166F1EB, 0xE167F1EC, 0xE168F1ED,
+	0xE169F1EE, 0xE16AF1EF, 0xE16BF1F0, 0xE16CF1F1, 0xE16DF1F2, 0xE16EF1F3, 0xE16FF1F4, 0xE170F1F5,
+	0xE171F1F6, 0xE172F1F7, 0xE173F1F8, 0xE174F1F9, 0xE175F1FA, 0xE176F1FB, 0xE177F1FC, 0xE178F240,
+	0xE179F241, 0xE17AF242, 0xE17BF243, 0xE17CF244, 0xE17DF245, 0xE17EF246, 0xE17FF247, 0xE180F248,
+	0xE181F249, 0xE182F24A, 0xE183F24B, 0xE184F24C, 0xE185F24D, 0xE186F24E, 0xE187F24F, 0xE188F250,
+	0xE189F251, 0xE18AF252, 0xE18BF253, 0xE18CF254, 0xE18DF255, 0xE18EF256, 0xE18FF257, 0xE190F258,
+	0xE191F259, 0xE192F25A, 0xE193F25B, 0xE194F25C, 0xE195F25D, 0xE196F25E, 0xE197F25F, 0xE198F260,
+	0xE199F261, 0xE19AF262, 0xE19BF263, 0xE19CF264, 0xE19DF265, 0xE19EF266, 0xE19FF267, 0xE1A0F268,
+	0xE1A1F269, 0xE1A2F26A, 0xE1A3F26B, 0xE1A4F26C, 0xE1A5F26D, 0xE1A6F26E, 0xE1A7F26F, 0xE1A8F270,
+	0xE1A9F271, 0xE1AAF272, 0xE1ABF273, 0xE1ACF274, 0xE1ADF275, 0xE1AEF276, 0xE1AFF277, 0xE1B0F278,
+	0xE1B1F279, 0xE1B2F27A, 0xE1B3F27B, 0xE1B4F27C, 0xE1B5F27D, 0xE1B6F27E, 0xE1B7F280, 0xE1B8F281,
+	0xE1B9F282, 0xE1BAF283, 0xE1BBF284, 0xE1BCF285, 0xE1BDF286, 0xE1BEF287, 0xE1BFF288, 0xE1C0F289,
+	0xE1C1F28A, 0xE1C2F28B, 0xE1C3F28C, 0xE1C4F28D, 0xE1C5F28E, 0xE1C6F28F, 0xE1C7F290, 0xE1C8F291,
+	0xE1C9F292, 0xE1CAF293, 0xE1CBF294, 0xE1CCF295, 0xE1CDF296, 0xE1CEF297, 0xE1CFF298, 0xE1D0F299,
+	0xE1D1F29A, 0xE1D2F29B, 0xE1D3F29C, 0xE1D4F29D, 0xE1D5F29E, 0xE1D6F29F, 0xE1D7F2A0, 0xE1D8F2A1,
+	0xE1D9F2A2, 0xE1DAF2A3, 0xE1DBF2A4, 0xE1DCF2A5, 0xE1DDF2A6, 0xE1DEF2A7, 0xE1DFF2A8, 0xE1E0F2A9,
+	0xE1E1F2AA, 0xE1E2F2AB, 0xE1E3F2AC, 0xE1E4F2AD, 0xE1E5F2AE, 0xE1E6F2AF, 0xE1E7F2B0, 0xE1E8F2B1,
+	0xE1E9F2B2, 0xE1EAF2B3, 0xE1EBF2B4, 0xE1ECF2B5, 0xE1EDF2B6, 0xE1EEF2B7, 0xE1EFF2B8, 0xE1F0F2B9,
+	0xE1F1F2BA, 0xE1F2F2BB, 0xE1F3F2BC, 0xE1F4F2BD, 0xE1F5F2BE, 0xE1F6F2BF, 0xE1F7F2C0, 0xE1F8F2C1,
+	0xE1F9F2C2, 0xE1FAF2C3, 0xE1FBF2C4, 0xE1FCF2C5, 0xE1FDF2C6, 0xE1FEF2C7, 0xE1FFF2C8, 0xE200F2C9,
+	0xE201F2CA, 0xE202F2CB, 0xE203F2CC, 0xE204F2CD, 0xE205F2CE, 0xE206F2CF, 0xE207F2D0, 0xE208F2D1,
+	0xE209F2D2, 0xE20AF2D3, 0xE20BF2D4, 0xE20CF2D5, 0xE20DF2D6, 0xE20EF2D7, 0xE20FF2D8, 0xE210F2D9,
+	0xE211F2DA, 0xE212F2DB, 0xE213F2DC, 0xE214F2DD, 0xE215F2DE, 0xE216F2DF, 0xE217F2E0, 0xE218F2E1,
+	0xE219F2E2, 0xE21AF2E3, 0xE21BF2E4, 0xE21CF2E5, 0xE21DF2E6, 0xE21EF2E7, 0xE21FF2E8, 0xE220F2E9,
+	0xE221F2EA, 0xE222F2EB, 0xE223F2EC, 0xE224F2ED, 0xE225F2EE, 0xE226F2EF, 0xE227F2F0, 0xE228F2F1,
+	0xE229F2F2, 0xE22AF2F3, 0xE22BF2F4, 0xE22CF2F5, 0xE22DF2F6, 0xE22EF2F7, 0xE22FF2F8, 0xE230F2F9,
+	0xE231F2FA, 0xE232F2FB, 0xE233F2FC, 0xE234F340, 0xE235F341, 0xE236F342, 0xE237F343, 0xE238F344,
+	0xE239F345, 0xE23AF346, 0xE23BF347, 0xE23CF348, 0xE23DF349, 0xE23EF34A, 0xE23FF34B, 0xE240F34C,
+	0xE241F34D, 0xE242F34E, 0xE243F34F, 0xE244F350, 0xE245F351, 0xE246F352, 0xE247F353, 0xE248F354,
+	0xE249F355, 0xE24AF356, 0xE24BF357, 0xE24CF358, 0xE24DF359, 0xE24EF35A, 0xE24FF35B, 0xE250F35C,
+	0xE251F35D, 0xE252F35E, 0xE253F35F, 0xE254F360, 0xE255F361, 0xE256F362, 0xE257F363, 0xE258F364,
+	0xE259F365, 0xE25AF366, 0xE25BF367, 0xE25CF368, 0xE25DF369, 0xE25EF36A, 0xE25FF36B, 0xE260F36C,
+	0xE261F36D, 0xE262F36E, 0xE263F36F, 0xE264F370, 0xE265F371, 0xE266F372, 0xE267F373, 0xE268F374,
+	0xE269F375, 0xE26AF376, 0xE26BF377, 0xE26CF378, 0xE26DF379, 0xE26EF37A, 0xE26FF37B, 0xE270F37C,
+	0xE271F37D, 0xE272F37E, 0xE273F380, 0xE274F381, 0xE275F382, 0xE276F383, 0xE277F384, 0xE278F385,
+	0xE279F386, 0xE27AF387, 0xE27BF388, 0xE27CF389, 0xE27DF38A, 0xE27EF38B, 0xE27FF38C, 0xE280F38D,
+	0xE281F38E, 0xE282F38F, 0xE283F390, 0xE284F391, 0xE285F392, 0xE286F393, 0xE287F394, 0xE288F395,
+	0xE289F396, 0xE28AF397, 0xE28BF398, 0xE28CF399, 0xE28DF39A, 0xE28EF39B, 0xE28FF39C, 0xE290F39D,
+	0xE291F39E, 0xE292F39F, 0xE293F3A0, 0xE294F3A1, 0xE295F3A2, 0xE296F3A3, 0xE297F3A4, 0xE298F3A5,
+	0xE299F3A6, 0xE29AF3A7, 0xE29BF3A8, 0xE29CF3A9, 0xE29DF3AA, 0xE29EF3AB, 0xE29FF3AC, 0xE2A0F3AD,
+	0xE2A1F3AE, 0xE2A2F3AF, 0xE2A3F3B0, 0xE2A4F3B1, 0xE2A5F3B2, 0xE2A6F3B3, 0xE2A7F3B4, 0xE2A8F3B5,
+	0xE2A9F3B6, 0xE2AAF3B7, 0xE2ABF3B8, 0xE2ACF3B9, 0xE2ADF3BA, 0xE2AEF3BB, 0xE2AFF3BC, 0xE2B0F3BD,
+	0xE2B1F3BE, 0xE2B2F3BF, 0xE2B3F3C0, 0xE2B4F3C1, 0xE2B5F3C2, 0xE2B6F3C3, 0xE2B7F3C4, 0xE2B8F3C5,
+	0xE2B9F3C6, 0xE2BAF3C7, 0xE2BBF3C8, 0xE2BCF3C9, 0xE2BDF3CA, 0xE2BEF3CB, 0xE2BFF3CC, 0xE2C0F3CD,
+	0xE2C1F3CE, 0xE2C2F3CF, 0xE2C3F3D0, 0xE2C4F3D1, 0xE2C5F3D2, 0xE2C6F3D3, 0xE2C7F3D4, 0xE2C8F3D5,
+	0xE2C9F3D6, 0xE2CAF3D7, 0xE2CBF3D8, 0xE2CCF3D9, 0xE2CDF3DA, 0xE2CEF3DB, 0xE2CFF3DC, 0xE2D0F3DD,
+	0xE2D1F3DE, 0xE2D2F3DF, 0xE2D3F3E0, 0xE2D4F3E1, 0xE2D5F3E2, 0xE2D6F3E3, 0xE2D7F3E4, 0xE2D8F3E5,
+	0xE2D9F3E6, 0xE2DAF3E7, 0xE2DBF3E8, 0xE2DCF3E9, 0xE2DDF3EA, 0xE2DEF3EB, 0xE2DFF3EC, 0xE2E0F3ED,
+	0xE2E1F3EE, 0xE2E2F3EF, 0xE2E3F3F0, 0xE2E4F3F1, 0xE2E5F3F2, 0xE2E6F3F3, 0xE2E7F3F4, 0xE2E8F3F5,
+	0xE2E9F3F6, 0xE2EAF3F7, 0xE2EBF3F8, 0xE2ECF3F9, 0xE2EDF3FA, 0xE2EEF3FB, 0xE2EFF3FC, 0xE2F0F440,
+	0xE2F1F441, 0xE2F2F442, 0xE2F3F443, 0xE2F4F444, 0xE2F5F445, 0xE2F6F446, 0xE2F7F447, 0xE2F8F448,
+	0xE2F9F449, 0xE2FAF44A, 0xE2FBF44B, 0xE2FCF44C, 0xE2FDF44D, 0xE2FEF44E, 0xE2FFF44F, 0xE300F450,
+	0xE301F451, 0xE302F452, 0xE303F453, 0xE304F454, 0xE305F455, 0xE306F456, 0xE307F457, 0xE308F458,
+	0xE309F459, 0xE30AF45A, 0xE30BF45B, 0xE30CF45C, 0xE30DF45D, 0xE30EF45E, 0xE30FF45F, 0xE310F460,
+	0xE311F461, 0xE312F462, 0xE313F463, 0xE314F464, 0xE315F465, 0xE316F466, 0xE317F467, 0xE318F468,
+	0xE319F469, 0xE31AF46A, 0xE31BF46B, 0xE31CF46C, 0xE31DF46D, 0xE31EF46E, 0xE31FF46F, 0xE320F470,
+	0xE321F471, 0xE322F472, 0xE323F473, 0xE324F474, 0xE325F475, 0xE326F476, 0xE327F477, 0xE328F478,
+	0xE329F479, 0xE32AF47A, 0xE32BF47B, 0xE32CF47C, 0xE32DF47D, 0xE32EF47E, 0xE32FF480, 0xE330F481,
+	0xE331F482, 0xE332F483, 0xE333F484, 0xE334F485, 0xE335F486, 0xE336F487, 0xE337F488, 0xE338F489,
+	0xE339F48A, 0xE33AF48B, 0xE33BF48C, 0xE33CF48D, 0xE33DF48E, 0xE33EF48F, 0xE33FF490, 0xE340F491,
+	0xE341F492, 0xE342F493, 0xE343F494, 0xE344F495, 0xE345F496, 0xE346F497, 0xE347F498, 0xE348F499,
+	0xE349F49A, 0xE34AF49B, 0xE34BF49C, 0xE34CF49D, 0xE34DF49E, 0xE34EF49F, 0xE34FF4A0, 0xE350F4A1,
+	0xE351F4A2, 0xE352F4A3, 0xE353F4A4, 0xE354F4A5, 0xE355F4A6, 0xE356F4A7, 0xE357F4A8, 0xE358F4A9,
+	0xE359F4AA, 0xE35AF4AB, 0xE35BF4AC, 0xE35CF4AD, 0xE35DF4AE, 0xE35EF4AF, 0xE35FF4B0, 0xE360F4B1,
+	0xE361F4B2, 0xE362F4B3, 0xE363F4B4, 0xE364F4B5, 0xE365F4B6, 0xE366F4B7, 0xE367F4B8, 0xE368F4B9,
+	0xE369F4BA, 0xE36AF4BB, 0xE36BF4BC, 0xE36CF4BD, 0xE36DF4BE, 0xE36EF4BF, 0xE36FF4C0, 0xE370F4C1,
+	0xE371F4C2, 0xE372F4C3, 0xE373F4C4, 0xE374F4C5, 0xE375F4C6, 0xE376F4C7, 0xE377F4C8, 0xE378F4C9,
+	0xE379F4CA, 0xE37AF4CB, 0xE37BF4CC, 0xE37CF4CD, 0xE37DF4CE, 0xE37EF4CF, 0xE37FF4D0, 0xE380F4D1,
+	0xE381F4D2, 0xE382F4D3, 0xE383F4D4, 0xE384F4D5, 0xE385F4D6, 0xE386F4D7, 0xE387F4D8, 0xE388F4D9,
+	0xE389F4DA, 0xE38AF4DB, 0xE38BF4DC, 0xE38CF4DD, 0xE38DF4DE, 0xE38EF4DF, 0xE38FF4E0, 0xE390F4E1,
+	0xE391F4E2, 0xE392F4E3, 0xE393F4E4, 0xE394F4E5, 0xE395F4E6, 0xE396F4E7, 0xE397F4E8, 0xE398F4E9,
+	0xE399F4EA, 0xE39AF4EB, 0xE39BF4EC, 0xE39CF4ED, 0xE39DF4EE, 0xE39EF4EF, 0xE39FF4F0, 0xE3A0F4F1,
+	0xE3A1F4F2, 0xE3A2F4F3, 0xE3A3F4F4, 0xE3A4F4F5, 0xE3A5F4F6, 0xE3A6F4F7, 0xE3A7F4F8, 0xE3A8F4F9,
+	0xE3A9F4FA, 0xE3AAF4FB, 0xE3ABF4FC, 0xE3ACF540, 0xE3ADF541, 0xE3AEF542, 0xE3AFF543, 0xE3B0F544,
+	0xE3B1F545, 0xE3B2F546, 0xE3B3F547, 0xE3B4F548, 0xE3B5F549, 0xE3B6F54A, 0xE3B7F54B, 0xE3B8F54C,
+	0xE3B9F54D, 0xE3BAF54E, 0xE3BBF54F, 0xE3BCF550, 0xE3BDF551, 0xE3BEF552, 0xE3BFF553, 0xE3C0F554,
+	0xE3C1F555, 0xE3C2F556, 0xE3C3F557, 0xE3C4F558, 0xE3C5F559, 0xE3C6F55A, 0xE3C7F55B, 0xE3C8F55C,
+	0xE3C9F55D, 0xE3CAF55E, 0xE3CBF55F, 0xE3CCF560, 0xE3CDF561, 0xE3CEF562, 0xE3CFF563, 0xE3D0F564,
+	0xE3D1F565, 0xE3D2F566, 0xE3D3F567, 0xE3D4F568, 0xE3D5F569, 0xE3D6F56A, 0xE3D7F56B, 0xE3D8F56C,
+	0xE3D9F56D, 0xE3DAF56E, 0xE3DBF56F, 0xE3DCF570, 0xE3DDF571, 0xE3DEF572, 0xE3DFF573, 0xE3E0F574,
+	0xE3E1F575, 0xE3E2F576, 0xE3E3F577, 0xE3E4F578, 0xE3E5F579, 0xE3E6F57A, 0xE3E7F57B, 0xE3E8F57C,
+	0xE3E9F57D, 0xE3EAF57E, 0xE3EBF580, 0xE3ECF581, 0xE3EDF582, 0xE3EEF583, 0xE3EFF584, 0xE3F0F585,
+	0xE3F1F586, 0xE3F2F587, 0xE3F3F588, 0xE3F4F589, 0xE3F5F58A, 0xE3F6F58B, 0xE3F7F58C, 0xE3F8F58D,
+	0xE3F9F58E, 0xE3FAF58F, 0xE3FBF590, 0xE3FCF591, 0xE3FDF592, 0xE3FEF593, 0xE3FFF594, 0xE400F595,
+	0xE401F596, 0xE402F597, 0xE403F598, 0xE404F599, 0xE405F59A, 0xE406F59B, 0xE407F59C, 0xE408F59D,
+	0xE409F59E, 0xE40AF59F, 0xE40BF5A0, 0xE40CF5A1, 0xE40DF5A2, 0xE40EF5A3, 0xE40FF5A4, 0xE410F5A5,
+	0xE411F5A6, 0xE412F5A7, 0xE413F5A8, 0xE414F5A9, 0xE415F5AA, 0xE416F5AB, 0xE417F5AC, 0xE418F5AD,
+	0xE419F5AE, 0xE41AF5AF, 0xE41BF5B0, 0xE41CF5B1, 0xE41DF5B2, 0xE41EF5B3, 0xE41FF5B4, 0xE420F5B5,
+	0xE421F5B6, 0xE422F5B7, 0xE423F5B8, 0xE424F5B9, 0xE425F5BA, 0xE426F5BB, 0xE427F5BC, 0xE428F5BD,
+	0xE429F5BE, 0xE42AF5BF, 0xE42BF5C0, 0xE42CF5C1, 0xE42DF5C2, 0xE42EF5C3, 0xE42FF5C4, 0xE430F5C5,
+	0xE431F5C6, 0xE432F5C7, 0xE433F5C8, 0xE434F5C9, 0xE435F5CA, 0xE436F5CB, 0xE437F5CC, 0xE438F5CD,
+	0xE439F5CE, 0xE43AF5CF, 0xE43BF5D0, 0xE43CF5D1, 0xE43DF5D2, 0xE43EF5D3, 0xE43FF5D4, 0xE440F5D5,
+	0xE441F5D6, 0xE442F5D7, 0xE443F5D8, 0xE444F5D9, 0xE445F5DA, 0xE446F5DB, 0xE447F5DC, 0xE448F5DD,
+	0xE449F5DE, 0xE44AF5DF, 0xE44BF5E0, 0xE44CF5E1, 0xE44DF5E2, 0xE44EF5E3, 0xE44FF5E4, 0xE450F5E5,
+	0xE451F5E6, 0xE452F5E7, 0xE453F5E8, 0xE454F5E9, 0xE455F5EA, 0xE456F5EB, 0xE457F5EC, 0xE458F5ED,
+	0xE459F5EE, 0xE45AF5EF, 0xE45BF5F0, 0xE45CF5F1, 0xE45DF5F2, 0xE45EF5F3, 0xE45FF5F4, 0xE460F5F5,
+	0xE461F5F6, 0xE462F5F7, 0xE463F5F8, 0xE464F5F9, 0xE465F5FA, 0xE466F5FB, 0xE467F5FC, 0xE468F640,
+	0xE469F641, 0xE46AF642, 0xE46BF643, 0xE46CF644, 0xE46DF645, 0xE46EF646, 0xE46FF647, 0xE470F648,
+	0xE471F649, 0xE472F64A, 0xE473F64B, 0xE474F64C, 0xE475F64D, 0xE476F64E, 0xE477F64F, 0xE478F650,
+	0xE479F651, 0xE47AF652, 0xE47BF653, 0xE47CF654, 0xE47DF655, 0xE47EF656, 0xE47FF657, 0xE480F658,
+	0xE481F659, 0xE482F65A, 0xE483F65B, 0xE484F65C, 0xE485F65D, 0xE486F65E, 0xE487F65F, 0xE488F660,
+	0xE489F661, 0xE48AF662, 0xE48BF663, 0xE48CF664, 0xE48DF665, 0xE48EF666, 0xE48FF667, 0xE490F668,
+	0xE491F669, 0xE492F66A, 0xE493F66B, 0xE494F66C, 0xE495F66D, 0xE496F66E, 0xE497F66F, 0xE498F670,
+	0xE499F671, 0xE49AF672, 0xE49BF673, 0xE49CF674, 0xE49DF675, 0xE49EF676, 0xE49FF677, 0xE4A0F678,
+	0xE4A1F679, 0xE4A2F67A, 0xE4A3F67B, 0xE4A4F67C, 0xE4A5F67D, 0xE4A6F67E, 0xE4A7F680, 0xE4A8F681,
+	0xE4A9F682, 0xE4AAF683, 0xE4ABF684, 0xE4ACF685, 0xE4ADF686, 0xE4AEF687, 0xE4AFF688, 0xE4B0F689,
+	0xE4B1F68A, 0xE4B2F68B, 0xE4B3F68C, 0xE4B4F68D, 0xE4B5F68E, 0xE4B6F68F, 0xE4B7F690, 0xE4B8F691,
+	0xE4B9F692, 0xE4BAF693, 0xE4BBF694, 0xE4BCF695, 0xE4BDF696, 0xE4BEF697, 0xE4BFF698, 0xE4C0F699,
+	0xE4C1F69A, 0xE4C2F69B, 0xE4C3F69C, 0xE4C4F69D, 0xE4C5F69E, 0xE4C6F69F, 0xE4C7F6A0, 0xE4C8F6A1,
+	0xE4C9F6A2, 0xE4CAF6A3, 0xE4CBF6A4, 0xE4CCF6A5, 0xE4CDF6A6, 0xE4CEF6A7, 0xE4CFF6A8, 0xE4D0F6A9,
+	0xE4D1F6AA, 0xE4D2F6AB, 0xE4D3F6AC, 0xE4D4F6AD, 0xE4D5F6AE, 0xE4D6F6AF, 0xE4D7F6B0, 0xE4D8F6B1,
+	0xE4D9F6B2, 0xE4DAF6B3, 0xE4DBF6B4, 0xE4DCF6B5, 0xE4DDF6B6, 0xE4DEF6B7, 0xE4DFF6B8, 0xE4E0F6B9,
+	0xE4E1F6BA, 0xE4E2F6BB, 0xE4E3F6BC, 0xE4E4F6BD, 0xE4E5F6BE, 0xE4E6F6BF, 0xE4E7F6C0, 0xE4E8F6C1,
+	0xE4E9F6C2, 0xE4EAF6C3, 0xE4EBF6C4, 0xE4ECF6C5, 0xE4EDF6C6, 0xE4EEF6C7, 0xE4EFF6C8, 0xE4F0F6C9,
+	0xE4F1F6CA, 0xE4F2F6CB, 0xE4F3F6CC, 0xE4F4F6CD, 0xE4F5F6CE, 0xE4F6F6CF, 0xE4F7F6D0, 0xE4F8F6D1,
+	0xE4F9F6D2, 0xE4FAF6D3, 0xE4FBF6D4, 0xE4FCF6D5, 0xE4FDF6D6, 0xE4FEF6D7, 0xE4FFF6D8, 0xE500F6D9,
+	0xE501F6DA, 0xE502F6DB, 0xE503F6DC, 0xE504F6DD, 0xE505F6DE, 0xE506F6DF, 0xE507F6E0, 0xE508F6E1,
+	0xE509F6E2, 0xE50AF6E3, 0xE50BF6E4, 0xE50CF6E5, 0xE50DF6E6, 0xE50EF6E7, 0xE50FF6E8, 0xE510F6E9,
+	0xE511F6EA, 0xE512F6EB, 0xE513F6EC, 0xE514F6ED, 0xE515F6EE, 0xE516F6EF, 0xE517F6F0, 0xE518F6F1,
+	0xE519F6F2, 0xE51AF6F3, 0xE51BF6F4, 0xE51CF6F5, 0xE51DF6F6, 0xE51EF6F7, 0xE51FF6F8, 0xE520F6F9,
+	0xE521F6FA, 0xE522F6FB, 0xE523F6FC, 0xE524F740, 0xE525F741, 0xE526F742, 0xE527F743, 0xE528F744,
+	0xE529F745, 0xE52AF746, 0xE52BF747, 0xE52CF748, 0xE52DF749, 0xE52EF74A, 0xE52FF74B, 0xE530F74C,
+	0xE531F74D, 0xE532F74E, 0xE533F74F, 0xE534F750, 0xE535F751, 0xE536F752, 0xE537F753, 0xE538F754,
+	0xE539F755, 0xE53AF756, 0xE53BF757, 0xE53CF758, 0xE53DF759, 0xE53EF75A, 0xE53FF75B, 0xE540F75C,
+	0xE541F75D, 0xE542F75E, 0xE543F75F, 0xE544F760, 0xE545F761, 0xE546F762, 0xE547F763, 0xE548F764,
+	0xE549F765, 0xE54AF766, 0xE54BF767, 0xE54CF768, 0xE54DF769, 0xE54EF76A, 0xE54FF76B, 0xE550F76C,
+	0xE551F76D, 0xE552F76E, 0xE553F76F, 0xE554F770, 0xE555F771, 0xE556F772, 0xE557F773, 0xE558F774,
+	0xE559F775, 0xE55AF776, 0xE55BF777, 0xE55CF778, 0xE55DF779, 0xE55EF77A, 0xE55FF77B, 0xE560F77C,
+	0xE561F77D, 0xE562F77E, 0xE563F780, 0xE564F781, 0xE565F782, 0xE566F783, 0xE567F784, 0xE568F785,
+	0xE569F786, 0xE56AF787, 0xE56BF788, 0xE56CF789, 0xE56DF78A, 0xE56EF78B, 0xE56FF78C, 0xE570F78D,
+	0xE571F78E, 0xE572F78F, 0xE573F790, 0xE574F791, 0xE575F792, 0xE576F793, 0xE577F794, 0xE578F795,
+	0xE579F796, 0xE57AF797, 0xE57BF798, 0xE57CF799, 0xE57DF79A, 0xE57EF79B, 0xE57FF79C, 0xE580F79D,
+	0xE581F79E, 0xE582F79F, 0xE583F7A0, 0xE584F7A1, 0xE585F7A2, 0xE586F7A3, 0xE587F7A4, 0xE588F7A5,
+	0xE589F7A6, 0xE58AF7A7, 0xE58BF7A8, 0xE58CF7A9, 0xE58DF7AA, 0xE58EF7AB, 0xE58FF7AC, 0xE590F7AD,
+	0xE591F7AE, 0xE592F7AF, 0xE593F7B0, 0xE594F7B1, 0xE595F7B2, 0xE596F7B3, 0xE597F7B4, 0xE598F7B5,
+	0xE599F7B6, 0xE59AF7B7, 0xE59BF7B8, 0xE59CF7B9, 0xE59DF7BA, 0xE59EF7BB, 0xE59FF7BC, 0xE5A0F7BD,
+	0xE5A1F7BE, 0xE5A2F7BF, 0xE5A3F7C0, 0xE5A4F7C1, 0xE5A5F7C2, 0xE5A6F7C3, 0xE5A7F7C4, 0xE5A8F7C5,
+	0xE5A9F7C6, 0xE5AAF7C7, 0xE5ABF7C8, 0xE5ACF7C9, 0xE5ADF7CA, 0xE5AEF7CB, 0xE5AFF7CC, 0xE5B0F7CD,
+	0xE5B1F7CE, 0xE5B2F7CF, 0xE5B3F7D0, 0xE5B4F7D1, 0xE5B5F7D2, 0xE5B6F7D3, 0xE5B7F7D4, 0xE5B8F7D5,
+	0xE5B9F7D6, 0xE5BAF7D7, 0xE5BBF7D8, 0xE5BCF7D9, 0xE5BDF7DA, 0xE5BEF7DB, 0xE5BFF7DC, 0xE5C0F7DD,
+	0xE5C1F7DE, 0xE5C2F7DF, 0xE5C3F7E0, 0xE5C4F7E1, 0xE5C5F7E2, 0xE5C6F7E3, 0xE5C7F7E4, 0xE5C8F7E5,
+	0xE5C9F7E6, 0xE5CAF7E7, 0xE5CBF7E8, 0xE5CCF7E9, 0xE5CDF7EA, 0xE5CEF7EB, 0xE5CFF7EC, 0xE5D0F7ED,
+	0xE5D1F7EE, 0xE5D2F7EF, 0xE5D3F7F0, 0xE5D4F7F1, 0xE5D5F7F2, 0xE5D6F7F3, 0xE5D7F7F4, 0xE5D8F7F5,
+	0xE5D9F7F6, 0xE5DAF7F7, 0xE5DBF7F8, 0xE5DCF7F9, 0xE5DDF7FA, 0xE5DEF7FB, 0xE5DFF7FC, 0xE5E0F840,
+	0xE5E1F841, 0xE5E2F842, 0xE5E3F843, 0xE5E4F844, 0xE5E5F845, 0xE5E6F846, 0xE5E7F847, 0xE5E8F848,
+	0xE5E9F849, 0xE5EAF84A, 0xE5EBF84B, 0xE5ECF84C, 0xE5EDF84D, 0xE5EEF84E, 0xE5EFF84F, 0xE5F0F850,
+	0xE5F1F851, 0xE5F2F852, 0xE5F3F853, 0xE5F4F854, 0xE5F5F855, 0xE5F6F856, 0xE5F7F857, 0xE5F8F858,
+	0xE5F9F859, 0xE5FAF85A, 0xE5FBF85B, 0xE5FCF85C, 0xE5FDF85D, 0xE5FEF85E, 0xE5FFF85F, 0xE600F860,
+	0xE601F861, 0xE602F862, 0xE603F863, 0xE604F864, 0xE605F865, 0xE606F866, 0xE607F867, 0xE608F868,
+	0xE609F869, 0xE60AF86A, 0xE60BF86B, 0xE60CF86C, 0xE60DF86D, 0xE60EF86E, 0xE60FF86F, 0xE610F870,
+	0xE611F871, 0xE612F872, 0xE613F873, 0xE614F874, 0xE615F875, 0xE616F876, 0xE617F877, 0xE618F878,
+	0xE619F879, 0xE61AF87A, 0xE61BF87B, 0xE61CF87C, 0xE61DF87D, 0xE61EF87E, 0xE61FF880, 0xE620F881,
+	0xE621F882, 0xE622F883, 0xE623F884, 0xE624F885, 0xE625F886, 0xE626F887, 0xE627F888, 0xE628F889,
+	0xE629F88A, 0xE62AF88B, 0xE62BF88C, 0xE62CF88D, 0xE62DF88E, 0xE62EF88F, 0xE62FF890, 0xE630F891,
+	0xE631F892, 0xE632F893, 0xE633F894, 0xE634F895, 0xE635F896, 0xE636F897, 0xE637F898, 0xE638F899,
+	0xE639F89A, 0xE63AF89B, 0xE63BF89C, 0xE63CF89D, 0xE63DF89E, 0xE63EF89F, 0xE63FF8A0, 0xE640F8A1,
+	0xE641F8A2, 0xE642F8A3, 0xE643F8A4, 0xE644F8A5, 0xE645F8A6, 0xE646F8A7, 0xE647F8A8, 0xE648F8A9,
+	0xE649F8AA, 0xE64AF8AB, 0xE64BF8AC, 0xE64CF8AD, 0xE64DF8AE, 0xE64EF8AF, 0xE64FF8B0, 0xE650F8B1,
+	0xE651F8B2, 0xE652F8B3, 0xE653F8B4, 0xE654F8B5, 0xE655F8B6, 0xE656F8B7, 0xE657F8B8, 0xE658F8B9,
+	0xE659F8BA, 0xE65AF8BB, 0xE65BF8BC, 0xE65CF8BD, 0xE65DF8BE, 0xE65EF8BF, 0xE65FF8C0, 0xE660F8C1,
+	0xE661F8C2, 0xE662F8C3, 0xE663F8C4, 0xE664F8C5, 0xE665F8C6, 0xE666F8C7, 0xE667F8C8, 0xE668F8C9,
+	0xE669F8CA, 0xE66AF8CB, 0xE66BF8CC, 0xE66CF8CD, 0xE66DF8CE, 0xE66EF8CF, 0xE66FF8D0, 0xE670F8D1,
+	0xE671F8D2, 0xE672F8D3, 0xE673F8D4, 0xE674F8D5, 0xE675F8D6, 0xE676F8D7, 0xE677F8D8, 0xE678F8D9,
+	0xE679F8DA, 0xE67AF8DB, 0xE67BF8DC, 0xE67CF8DD, 0xE67DF8DE, 0xE67EF8DF, 0xE67FF8E0, 0xE680F8E1,
+	0xE681F8E2, 0xE682F8E3, 0xE683F8E4, 0xE684F8E5, 0xE685F8E6, 0xE686F8E7, 0xE687F8E8, 0xE688F8E9,
+	0xE689F8EA, 0xE68AF8EB, 0xE68BF8EC, 0xE68CF8ED, 0xE68DF8EE, 0xE68EF8EF, 0xE68FF8F0, 0xE690F8F1,
+	0xE691F8F2, 0xE692F8F3, 0xE693F8F4, 0xE694F8F5, 0xE695F8F6, 0xE696F8F7, 0xE697F8F8, 0xE698F8F9,
+	0xE699F8FA, 0xE69AF8FB, 0xE69BF8FC, 0xE69CF940, 0xE69DF941, 0xE69EF942, 0xE69FF943, 0xE6A0F944,
+	0xE6A1F945, 0xE6A2F946, 0xE6A3F947, 0xE6A4F948, 0xE6A5F949, 0xE6A6F94A, 0xE6A7F94B, 0xE6A8F94C,
+	0xE6A9F94D, 0xE6AAF94E, 0xE6ABF94F, 0xE6ACF950, 0xE6ADF951, 0xE6AEF952, 0xE6AFF953, 0xE6B0F954,
+	0xE6B1F955, 0xE6B2F956, 0xE6B3F957, 0xE6B4F958, 0xE6B5F959, 0xE6B6F95A, 0xE6B7F95B, 0xE6B8F95C,
+	0xE6B9F95D, 0xE6BAF95E, 0xE6BBF95F, 0xE6BCF960, 0xE6BDF961, 0xE6BEF962, 0xE6BFF963, 0xE6C0F964,
+	0xE6C1F965, 0xE6C2F966, 0xE6C3F967, 0xE6C4F968, 0xE6C5F969, 0xE6C6F96A, 0xE6C7F96B, 0xE6C8F96C,
+	0xE6C9F96D, 0xE6CAF96E, 0xE6CBF96F, 0xE6CCF970, 0xE6CDF971, 0xE6CEF972, 0xE6CFF973, 0xE6D0F974,
+	0xE6D1F975, 0xE6D2F976, 0xE6D3F977, 0xE6D4F978, 0xE6D5F979, 0xE6D6F97A, 0xE6D7F97B, 0xE6D8F97C,
+	0xE6D9F97D, 0xE6DAF97E, 0xE6DBF980, 0xE6DCF981, 0xE6DDF982, 0xE6DEF983, 0xE6DFF984, 0xE6E0F985,
+	0xE6E1F986, 0xE6E2F987, 0xE6E3F988, 0xE6E4F989, 0xE6E5F98A, 0xE6E6F98B, 0xE6E7F98C, 0xE6E8F98D,
+	0xE6E9F98E, 0xE6EAF98F, 0xE6EBF990, 0xE6ECF991, 0xE6EDF992, 0xE6EEF993, 0xE6EFF994, 0xE6F0F995,
+	0xE6F1F996, 0xE6F2F997, 0xE6F3F998, 0xE6F4F999, 0xE6F5F99A, 0xE6F6F99B, 0xE6F7F99C, 0xE6F8F99D,
+	0xE6F9F99E, 0xE6FAF99F, 0xE6FBF9A0, 0xE6FCF9A1, 0xE6FDF9A2, 0xE6FEF9A3, 0xE6FFF9A4, 0xE700F9A5,
+	0xE701F9A6, 0xE702F9A7, 0xE703F9A8, 0xE704F9A9, 0xE705F9AA, 0xE706F9AB, 0xE707F9AC, 0xE708F9AD,
+	0xE709F9AE, 0xE70AF9AF, 0xE70BF9B0, 0xE70CF9B1, 0xE70DF9B2, 0xE70EF9B3, 0xE70FF9B4, 0xE710F9B5,
+	0xE711F9B6, 0xE712F9B7, 0xE713F9B8, 0xE714F9B9, 0xE715F9BA, 0xE716F9BB, 0xE717F9BC, 0xE718F9BD,
+	0xE719F9BE, 0xE71AF9BF, 0xE71BF9C0, 0xE71CF9C1, 0xE71DF9C2, 0xE71EF9C3, 0xE71FF9C4, 0xE720F9C5,
+	0xE721F9C6, 0xE722F9C7, 0xE723F9C8, 0xE724F9C9, 0xE725F9CA, 0xE726F9CB, 0xE727F9CC, 0xE728F9CD,
+	0xE729F9CE, 0xE72AF9CF, 0xE72BF9D0, 0xE72CF9D1, 0xE72DF9D2, 0xE72EF9D3, 0xE72FF9D4, 0xE730F9D5,
+	0xE731F9D6, 0xE732F9D7, 0xE733F9D8, 0xE734F9D9, 0xE735F9DA, 0xE736F9DB, 0xE737F9DC, 0xE738F9DD,
+	0xE739F9DE, 0xE73AF9DF, 0xE73BF9E0, 0xE73CF9E1, 0xE73DF9E2, 0xE73EF9E3, 0xE73FF9E4, 0xE740F9E5,
+	0xE741F9E6, 0xE742F9E7, 0xE743F9E8, 0xE744F9E9, 0xE745F9EA, 0xE746F9EB, 0xE747F9EC, 0xE748F9ED,
+	0xE749F9EE, 0xE74AF9EF, 0xE74BF9F0, 0xE74CF9F1, 0xE74DF9F2, 0xE74EF9F3, 0xE74FF9F4, 0xE750F9F5,
+	0xE751F9F6, 0xE752F9F7, 0xE753F9F8, 0xE754F9F9, 0xE755F9FA, 0xE756F9FB, 0xE757F9FC, 0xF8F000A0,
+	0xF8F100FD, 0xF8F200FE, 0xF8F300FF, 0xF929EDC4, 0xF9DCEECD, 0xFA0EED73, 0xFA0FED7E, 0xFA10ED80,
+	0xFA11ED95, 0xFA12EDBC, 0xFA13EDCC, 0xFA14EDCE, 0xFA15EDF9, 0xFA16EE42, 0xFA17EE59, 0xFA18EE61,
+	0xFA19EE62, 0xFA1AEE63, 0xFA1BEE65, 0xFA1CEE69, 0xFA1DEE6C, 0xFA1EEE75, 0xFA1FEE81, 0xFA20EE83,
+	0xFA21EE84, 0xFA22EE8D, 0xFA23EE95, 0xFA24EE97, 0xFA25EE98, 0xFA26EE9B, 0xFA27EEB7, 0xFA28EEBE,
+	0xFA29EECE, 0xFA2AEEDA, 0xFA2BEEDB, 0xFA2CEEDD, 0xFA2DEEEA, 0xFF018149, 0xFF02EEFC, 0xFF038194,
+	0xFF048190, 0xFF058193, 0xFF068195, 0xFF07EEFB, 0xFF088169, 0xFF09816A, 0xFF0A8196, 0xFF0B817B,
+	0xFF0C8143, 0xFF0D817C, 0xFF0E8144, 0xFF0F815E, 0xFF10824F, 0xFF118250, 0xFF128251, 0xFF138252,
+	0xFF148253, 0xFF158254, 0xFF168255, 0xFF178256, 0xFF188257, 0xFF198258, 0xFF1A8146, 0xFF1B8147,
+	0xFF1C8183, 0xFF1D8181, 0xFF1E8184, 0xFF1F8148, 0xFF208197, 0xFF218260, 0xFF228261, 0xFF238262,
+	0xFF248263, 0xFF258264, 0xFF268265, 0xFF278266, 0xFF288267, 0xFF298268, 0xFF2A8269, 0xFF2B826A,
+	0xFF2C826B, 0xFF2D826C, 0xFF2E826D, 0xFF2F826E, 0xFF30826F, 0xFF318270, 0xFF328271, 0xFF338272,
+	0xFF348273, 0xFF358274, 0xFF368275, 0xFF378276, 0xFF388277, 0xFF398278, 0xFF3A8279, 0xFF3B816D,
+	0xFF3C815F, 0xFF3D816E, 0xFF3E814F, 0xFF3F8151, 0xFF40814D, 0xFF418281, 0xFF428282, 0xFF438283,
+	0xFF448284, 0xFF458285, 0xFF468286, 0xFF478287, 0xFF488288, 0xFF498289, 0xFF4A828A, 0xFF4B828B,
+	0xFF4C828C, 0xFF4D828D, 0xFF4E828E, 0xFF4F828F, 0xFF508290, 0xFF518291, 0xFF528292, 0xFF538293,
+	0xFF548294, 0xFF558295, 0xFF568296, 0xFF578297, 0xFF588298, 0xFF598299, 0xFF5A829A, 0xFF5B816F,
+	0xFF5C8162, 0xFF5D8170, 0xFF5E8160, 0xFF6100A1, 0xFF6200A2, 0xFF6300A3, 0xFF6400A4, 0xFF6500A5,
+	0xFF6600A6, 0xFF6700A7, 0xFF6800A8, 0xFF6900A9, 0xFF6A00AA, 0xFF6B00AB, 0xFF6C00AC, 0xFF6D00AD,
+	0xFF6E00AE, 0xFF6F00AF, 0xFF7000B0, 0xFF7100B1, 0xFF7200B2, 0xFF7300B3, 0xFF7400B4, 0xFF7500B5,
+	0xFF7600B6, 0xFF7700B7, 0xFF7800B8, 0xFF7900B9, 0xFF7A00BA, 0xFF7B00BB, 0xFF7C00BC, 0xFF7D00BD,
+	0xFF7E00BE, 0xFF7F00BF, 0xFF8000C0, 0xFF8100C1, 0xFF8200C2, 0xFF8300C3, 0xFF8400C4, 0xFF8500C5,
+	0xFF8600C6, 0xFF8700C7, 0xFF8800C8, 0xFF8900C9, 0xFF8A00CA, 0xFF8B00CB, 0xFF8C00CC, 0xFF8D00CD,
+	0xFF8E00CE, 0xFF8F00CF, 0xFF9000D0, 0xFF9100D1, 0xFF9200D2, 0xFF9300D3, 0xFF9400D4, 0xFF9500D5,
+	0xFF9600D6, 0xFF9700D7, 0xFF9800D8, 0xFF9900D9, 0xFF9A00DA, 0xFF9B00DB, 0xFF9C00DC, 0xFF9D00DD,
+	0xFF9E00DE, 0xFF9F00DF, 0xFFE08191, 0xFFE18192, 0xFFE281CA, 0xFFE38150, 0xFFE4EEFA, 0xFFE5818F,
+}
@@ -0,0 +1,234 @@
+// This file implements data-quality checks evaluated while rows are exported.
+
+package exporter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-data-exporter/exporter/internal/colstats"
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// Check is a data-quality rule evaluated against the values of a column during an
+// export. A check passes if the share of values satisfying it is at least MinRatio.
+type Check struct {
+	Name     string  // Description of the check used in results and errors.
+	Column   string  // Name of the checked column.
+	MinRatio float64 // Minimum share of checked values that must satisfy the rule, from 0 to 1.
+
+	skipNULL bool
+	test     func(v any, s tostring.String) bool
+}
+
+// NotNull checks that at least minRatio of the values of column are not NULL.
+func NotNull(column string, minRatio float64) Check {
+	return Check{
+		Name:     fmt.Sprintf("not null(%s)", column),
+		Column:   column,
+		MinRatio: minRatio,
+		test: func(_ any, s tostring.String) bool {
+			return !s.IsNULL
+		},
+	}
+}
+
+// Matches checks that at least minRatio of the non-NULL values of column, in their
+// string form, match pattern.
+func Matches(column string, pattern *regexp.Regexp, minRatio float64) Check {
+	return Check{
+		Name:     fmt.Sprintf("matches(%s, %s)", column, pattern),
+		Column:   column,
+		MinRatio: minRatio,
+		skipNULL: true,
+		test: func(_ any, s tostring.String) bool {
+			return pattern.MatchString(s.String)
+		},
+	}
+}
+
+// InRange checks that every non-NULL value of column is a number between lo and hi
+// inclusive. Strings are parsed as numbers.
+func InRange(column string, lo, hi float64) Check {
+	return Check{
+		Name:     fmt.Sprintf("in range(%s, %g, %g)", column, lo, hi),
+		Column:   column,
+		MinRatio: 1,
+		skipNULL: true,
+		test: func(v any, s tostring.String) bool {
+			f, ok := numericValue(v, s)
+			return ok && f >= lo && f <= hi
+		},
+	}
+}
+
+// InSet checks that every non-NULL value of column, in its string form, is one of
+// values, e.g. the keys of a referenced table.
+func InSet(column string, values ...string) Check {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return Check{
+		Name:     fmt.Sprintf("in set(%s)", column),
+		Column:   column,
+		MinRatio: 1,
+		skipNULL: true,
+		test: func(_ any, s tostring.String) bool {
+			_, ok := set[s.String]
+			return ok
+		},
+	}
+}
+
+// CheckResult reports the outcome of a Check.
+type CheckResult struct {
+	Check      string // Name of the check.
+	Column     string // Name of the checked column.
+	Checked    int64  // Number of values the rule was evaluated against.
+	Violations int64  // Number of values that did not satisfy the rule.
+	Passed     bool   // Whether the share of satisfying values reached MinRatio.
+}
+
+// CheckError is returned by an export with WithFailOnCheck if any check failed.
+// The output has been written completely; destinations are aborted like on any error.
+type CheckError struct {
+	Failed []CheckResult
+}
+
+// Error implements the error interface.
+func (e *CheckError) Error() string {
+	names := make([]string, len(e.Failed))
+	for i, r := range e.Failed {
+		names[i] = fmt.Sprintf("%s: %d of %d values violate the check", r.Check, r.Violations, r.Checked)
+	}
+	return "exporter: checks failed: " + strings.Join(names, "; ")
+}
+
+// WithChecks evaluates the checks against the exported rows in the same pass and
+// reports their results in Stats.Checks. See WithFailOnCheck to fail the export instead.
+func WithChecks(checks ...Check) Option {
+	return func(e *Exporter) {
+		e.checks = append(e.checks, checks...)
+	}
+}
+
+// WithFailOnCheck makes an export return a *CheckError if any check configured with
+// WithChecks failed, so that exports can double as data contract verification.
+func WithFailOnCheck(fail bool) Option {
+	return func(e *Exporter) {
+		e.failOnCheck = fail
+	}
+}
+
+// checkRows wraps a Rows and evaluates checks against the scanned values.
+type checkRows struct {
+	scanner.Rows
+
+	checks  []Check
+	indexes []int
+	results []CheckResult
+}
+
+// newCheckRows wraps rows with the given checks.
+func newCheckRows(rows scanner.Rows, checks []Check) *checkRows {
+	c := &checkRows{Rows: rows, checks: checks, results: make([]CheckResult, len(checks))}
+	for i, check := range checks {
+		c.results[i] = CheckResult{Check: check.Name, Column: check.Column}
+	}
+	return c
+}
+
+// Columns returns the column metadata and resolves the checked columns.
+func (c *checkRows) Columns() ([]scanner.Column, error) {
+	cols, err := c.Rows.Columns()
+	if err != nil || c.indexes != nil {
+		return cols, err
+	}
+	indexes := make([]int, len(c.checks))
+	for i, check := range c.checks {
+		indexes[i] = -1
+		for j, col := range cols {
+			if col.Name() == check.Column {
+				indexes[i] = j
+				break
+			}
+		}
+		if indexes[i] < 0 {
+			return nil, fmt.Errorf("exporter: check %q: unknown column %q", check.Name, check.Column)
+		}
+	}
+	c.indexes = indexes
+	return cols, nil
+}
+
+// ScanRow returns the current row and evaluates the checks against it.
+func (c *checkRows) ScanRow() ([]any, error) {
+	if c.indexes == nil {
+		if _, err := c.Columns(); err != nil {
+			return nil, err
+		}
+	}
+	row, err := c.Rows.ScanRow()
+	if err != nil {
+		return nil, err
+	}
+	for i, check := range c.checks {
+		var v any
+		if c.indexes[i] < len(row) {
+			v = row[c.indexes[i]]
+		}
+		s := tostring.ToString(v)
+		if s.IsNULL && check.skipNULL {
+			continue
+		}
+		c.results[i].Checked++
+		if !check.test(v, s) {
+			c.results[i].Violations++
+		}
+	}
+	return row, nil
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (c *checkRows) RawValue(column int) (any, bool) {
+	return scanner.RawValue(c.Rows, column)
+}
+
+// EstimateRows returns the row estimate of the underlying rows.
+func (c *checkRows) EstimateRows() (int64, bool) {
+	return scanner.EstimateRows(c.Rows)
+}
+
+// result returns the check results and a *CheckError for the failed checks.
+func (c *checkRows) result() ([]CheckResult, error) {
+	var failed []CheckResult
+	for i, check := range c.checks {
+		r := &c.results[i]
+		r.Passed = r.Checked == 0 || float64(r.Checked-r.Violations) >= check.MinRatio*float64(r.Checked)
+		if !r.Passed {
+			failed = append(failed, *r)
+		}
+	}
+	if failed != nil {
+		return c.results, &CheckError{Failed: failed}
+	}
+	return c.results, nil
+}
+
+// numericValue returns v as a float64 if it is a number or a string holding one.
+func numericValue(v any, s tostring.String) (float64, bool) {
+	switch v := colstats.Comparable(v).(type) {
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(s.String), 64)
+	return f, err == nil
+}
@@ -0,0 +1,292 @@
+// This file implements delivering an export in chunks, e.g. as HTTP requests.
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// ChunkSender delivers the chunks written by WriteChunks.
+type ChunkSender interface {
+	// Send delivers a chunk. Chunks are numbered from 0 and sent in order.
+	Send(chunk int, data []byte) error
+
+	// Complete is called once all chunks have been sent successfully.
+	Complete(chunks int) error
+}
+
+// WithChunkSize limits the chunks written by WriteChunks to at most rows rows and,
+// approximately, bytes bytes each. The byte limit is checked between rows against
+// the output of the codec, so a chunk may exceed it by its last row and by data the
+// codec still buffers (see the flush options of the codecs). Every chunk contains at
+// least one row. A non-positive value disables the respective limit. A row limit of
+// the codec, such as csvcodec.WithLimit, applies to every chunk rather than to the
+// whole export; a codec that ends a chunk without reading a row, e.g. with a limit
+// of 0, fails the export.
+func WithChunkSize(rows int, bytes int64) Option {
+	return func(e *Exporter) {
+		e.chunkRows = rows
+		e.chunkBytes = bytes
+	}
+}
+
+// WriteChunks splits the rows into chunks as configured with WithChunkSize and hands
+// every chunk, encoded as a complete document with the codec and compressed if
+// configured, to sender. Once all chunks have been sent, sender.Complete is called.
+// A chunk is held in memory until it has been sent. Rejects, checks and column
+// statistics cover all chunks; a check failing with WithFailOnCheck fails the
+// export after the last chunk has been sent, and Complete is not called.
+// WithMaxBytes, WithIntegrityFooter and WithAsyncWrite are not supported. If the
+// source rows implement io.Closer, they are closed once the export has finished or
+// failed.
+func (cs *Exporter) WriteChunks(sender ChunkSender) error {
+	chunks, err := cs.eachChunk(cs.chunkRows, cs.chunkBytes, func(chunk int, data []byte) error {
+		if err := sender.Send(chunk, data); err != nil {
+			return fmt.Errorf("exporter: failed to send chunk %d: %w", chunk, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return sender.Complete(chunks)
+}
+
+// errStopChunks stops eachChunk when the consumer of Chunks stops iterating.
+var errStopChunks = errors.New("exporter: chunk iteration stopped")
+
+// Chunks returns a sequence of readers, each holding a complete document of
+// approximately maxBytes bytes at most, encoded with the codec and compressed if
+// configured, e.g. to return an export as pages from an API. As with WithChunkSize,
+// the limit is checked between rows, so a chunk may exceed it by its last row and
+// by data the codec still buffers, and every chunk contains at least one row. The
+// row limit of WithChunkSize and the per-chunk row limit of the codec also apply,
+// as described there. A non-positive maxBytes disables the byte limit. An error,
+// including a check failing with WithFailOnCheck after the last chunk, ends the
+// sequence with a nil reader. WithMaxBytes, WithIntegrityFooter and WithAsyncWrite
+// are not supported. Each chunk is held in memory, and the sequence can be iterated
+// only once. If the source rows implement
+// io.Closer, they are closed once the sequence ends or the loop is left early.
+func (cs *Exporter) Chunks(maxBytes int64) iter.Seq2[io.Reader, error] {
+	return func(yield func(io.Reader, error) bool) {
+		_, err := cs.eachChunk(cs.chunkRows, maxBytes, func(_ int, data []byte) error {
+			if !yield(bytes.NewReader(bytes.Clone(data)), nil) {
+				return errStopChunks
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errStopChunks) {
+			yield(nil, err)
+		}
+	}
+}
+
+// eachChunk splits the rows into chunks of at most maxRows rows and approximately
+// maxBytes bytes and calls fn with every chunk encoded as a complete document. The
+// data passed to fn is only valid until fn returns. It returns the number of chunks.
+// If the source rows implement io.Closer, they are closed before eachChunk returns.
+func (cs *Exporter) eachChunk(maxRows int, maxBytes int64, fn func(chunk int, data []byte) error) (chunk int, err error) {
+	defer func() {
+		if closeErr := scanner.Close(cs.rows); err == nil {
+			err = closeErr
+		}
+	}()
+	if err := cs.errSplitOutput(); err != nil {
+		return 0, err
+	}
+	src, observers := cs.observedSource()
+	defer func() {
+		err = observers.finish(cs, &Stats{}, err)
+	}()
+	var buf bytes.Buffer
+	pending := false
+	for ; ; chunk++ {
+		if !pending {
+			if !src.Next() {
+				break
+			}
+			pending = true
+		}
+		buf.Reset()
+		rows := &chunkRows{Rows: src, pending: &pending, buf: &buf, maxRows: maxRows, maxBytes: maxBytes}
+		if err := cs.writeChunk(&buf, rows); err != nil {
+			return chunk, err
+		}
+		if rows.n == 0 {
+			// The pending row would start every further chunk as well.
+			return chunk, fmt.Errorf("exporter: the codec read no row of chunk %d, e.g. because of a row limit of 0", chunk)
+		}
+		if err := fn(chunk, buf.Bytes()); err != nil {
+			return chunk, err
+		}
+	}
+	return chunk, src.Err()
+}
+
+// writeChunk writes a single chunk with the codec.
+func (cs *Exporter) writeChunk(buf *bytes.Buffer, rows scanner.Rows) error {
+	out, closeOut, err := cs.compressor(buf)
+	if err != nil {
+		return err
+	}
+	err = cs.export(out, rows)
+	if closeErr := closeOut(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// chunkRows is the Rows of a single chunk. It stops before the row that would
+// start the next chunk, which stays pending on the source.
+type chunkRows struct {
+	scanner.Rows
+
+	pending  *bool // Whether Next of the source has returned a row not read yet.
+	buf      *bytes.Buffer
+	n        int
+	maxRows  int
+	maxBytes int64
+}
+
+// Next reports whether another row belongs to the chunk.
+func (c *chunkRows) Next() bool {
+	if c.n > 0 && ((c.maxRows > 0 && c.n >= c.maxRows) || (c.maxBytes > 0 && int64(c.buf.Len()) >= c.maxBytes)) {
+		return false
+	}
+	if *c.pending {
+		*c.pending = false
+	} else if !c.Rows.Next() {
+		return false
+	}
+	c.n++
+	return true
+}
+
+// HTTPChunkOption defines a functional option for configuring NewHTTPChunkSender.
+type HTTPChunkOption func(*httpChunkSender)
+
+// WithHTTPRetries retries a chunk request up to retries times after network errors
+// and responses with status 429 or 5xx, waiting backoff before the first retry and
+// twice as long before every further retry.
+func WithHTTPRetries(retries int, backoff time.Duration) HTTPChunkOption {
+	return func(s *httpChunkSender) {
+		s.retries = retries
+		s.backoff = backoff
+	}
+}
+
+// WithHTTPHeader sets an HTTP header of every request, e.g. Authorization.
+func WithHTTPHeader(key, value string) HTTPChunkOption {
+	return func(s *httpChunkSender) {
+		s.header.Set(key, value)
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for the requests (default http.DefaultClient).
+func WithHTTPClient(client *http.Client) HTTPChunkOption {
+	return func(s *httpChunkSender) {
+		s.client = client
+	}
+}
+
+// WithHTTPCompletion POSTs {"chunks":N} to url once all chunks have been delivered,
+// so that the receiver knows the export is complete.
+func WithHTTPCompletion(url string) HTTPChunkOption {
+	return func(s *httpChunkSender) {
+		s.completionURL = url
+	}
+}
+
+// httpChunkSender POSTs every chunk to an HTTP endpoint.
+type httpChunkSender struct {
+	ctx           context.Context
+	url           string
+	client        *http.Client
+	header        http.Header
+	retries       int
+	backoff       time.Duration
+	completionURL string
+}
+
+// NewHTTPChunkSender returns a ChunkSender that POSTs every chunk to url with the
+// given content type, e.g. "application/x-ndjson", for receivers that accept data
+// only through an HTTP API. Every request carries the chunk number in the
+// X-Chunk-Index header. A response status other than 2xx fails the chunk.
+func NewHTTPChunkSender(ctx context.Context, url, contentType string, opts ...HTTPChunkOption) ChunkSender {
+	s := &httpChunkSender{
+		ctx:    ctx,
+		url:    url,
+		client: http.DefaultClient,
+		header: make(http.Header),
+	}
+	s.header.Set("Content-Type", contentType)
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Send POSTs the chunk, retrying as configured.
+func (s *httpChunkSender) Send(chunk int, data []byte) error {
+	header := s.header.Clone()
+	header.Set("X-Chunk-Index", strconv.Itoa(chunk))
+	return s.post(s.url, header, data)
+}
+
+// Complete POSTs the completion notice if configured.
+func (s *httpChunkSender) Complete(chunks int) error {
+	if s.completionURL == "" {
+		return nil
+	}
+	header := s.header.Clone()
+	header.Set("Content-Type", "application/json")
+	return s.post(s.completionURL, header, []byte(fmt.Sprintf(`{"chunks":%d}`, chunks)))
+}
+
+// post sends a request, retrying after network errors and retryable statuses.
+func (s *httpChunkSender) post(url string, header http.Header, data []byte) error {
+	backoff := s.backoff
+	for attempt := 0; ; attempt++ {
+		retryable, err := s.do(url, header, data)
+		if err == nil || !retryable || attempt >= s.retries {
+			return err
+		}
+		select {
+		case <-time.After(backoff):
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+// do sends a single request and reports whether a failure may be retried.
+func (s *httpChunkSender) do(url string, header http.Header, data []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return false, err
+	}
+	req.Header = header
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return s.ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return false, nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retryable, fmt.Errorf("%s: %s", resp.Status, bytes.TrimSpace(msg))
+}
@@ -0,0 +1,137 @@
+// This file implements a destination that inserts an export into ClickHouse over HTTP.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ClickHouseOption defines a functional option for configuring ClickHouseDestination.
+type ClickHouseOption func(*clickHouseDestination)
+
+// WithClickHouseAuth authenticates the insert as user with password.
+func WithClickHouseAuth(user, password string) ClickHouseOption {
+	return func(d *clickHouseDestination) {
+		d.header.Set("X-ClickHouse-User", user)
+		d.header.Set("X-ClickHouse-Key", password)
+	}
+}
+
+// WithClickHouseDatabase sets the database of the table (default: the user's default database).
+func WithClickHouseDatabase(database string) ClickHouseOption {
+	return func(d *clickHouseDestination) {
+		d.params.Set("database", database)
+	}
+}
+
+// WithClickHouseSetting sets a ClickHouse setting for the insert, e.g.
+// "input_format_skip_unknown_fields" or "async_insert".
+func WithClickHouseSetting(name, value string) ClickHouseOption {
+	return func(d *clickHouseDestination) {
+		d.params.Set(name, value)
+	}
+}
+
+// WithClickHouseHeader sets an HTTP header of the insert request, e.g.
+// Content-Encoding: gzip when the export is compressed with WithCompression.
+func WithClickHouseHeader(key, value string) ClickHouseOption {
+	return func(d *clickHouseDestination) {
+		d.header.Set(key, value)
+	}
+}
+
+// WithClickHouseClient sets the HTTP client used for the insert (default http.DefaultClient).
+func WithClickHouseClient(client *http.Client) ClickHouseOption {
+	return func(d *clickHouseDestination) {
+		d.client = client
+	}
+}
+
+// ClickHouseDestination returns a Destination that streams the export into table
+// through the ClickHouse HTTP interface at baseURL, e.g. "http://localhost:8123",
+// as the body of an INSERT INTO table FORMAT format statement. The format must
+// match the codec, e.g. CSVWithNames for codec.CSV() or JSONEachRow for
+// newline-delimited codec.JSON(). The data is sent while it is written, without
+// an intermediate file.
+//
+// Close finishes the request and returns an error if ClickHouse rejected the insert.
+// Abort cancels the request; ClickHouse may still keep blocks that were already
+// inserted unless the table deduplicates inserts.
+func ClickHouseDestination(ctx context.Context, baseURL, table, format string, opts ...ClickHouseOption) (Destination, error) {
+	d := &clickHouseDestination{
+		client: http.DefaultClient,
+		header: make(http.Header),
+		params: make(url.Values),
+		done:   make(chan error, 1),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("clickhouse: invalid URL: %w", err)
+	}
+	d.params.Set("query", fmt.Sprintf("INSERT INTO %s FORMAT %s", table, format))
+	u.RawQuery = d.params.Encode()
+
+	pr, pw := io.Pipe()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), pr)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = d.header
+	d.body = pw
+	go func() {
+		d.done <- d.send(req)
+		// Unblock writes if the request ended before the body was read completely.
+		pr.CloseWithError(io.ErrClosedPipe)
+	}()
+	return d, nil
+}
+
+// clickHouseDestination streams the export as the body of an HTTP insert.
+type clickHouseDestination struct {
+	client *http.Client
+	header http.Header
+	params url.Values
+
+	body *io.PipeWriter
+	done chan error
+}
+
+// Write sends p as part of the request body.
+func (d *clickHouseDestination) Write(p []byte) (int, error) {
+	return d.body.Write(p)
+}
+
+// Close ends the request body and waits for the response of ClickHouse.
+func (d *clickHouseDestination) Close() error {
+	d.body.Close()
+	return <-d.done
+}
+
+// Abort cancels the request body and waits for the request to end.
+func (d *clickHouseDestination) Abort(err error) error {
+	d.body.CloseWithError(err)
+	<-d.done
+	return nil
+}
+
+// send performs the insert request and checks its response.
+func (d *clickHouseDestination) send(req *http.Request) error {
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("clickhouse: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("clickhouse: insert failed with status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
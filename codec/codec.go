@@ -9,16 +9,47 @@ import (
 	csvcodec "github.com/go-data-exporter/exporter/codec/csv"
 	htmlcodec "github.com/go-data-exporter/exporter/codec/html"
 	jsoncodec "github.com/go-data-exporter/exporter/codec/json"
+	parquetcodec "github.com/go-data-exporter/exporter/codec/parquet"
+	tablecodec "github.com/go-data-exporter/exporter/codec/table"
 	xmlcodec "github.com/go-data-exporter/exporter/codec/xml"
 	"github.com/go-data-exporter/exporter/scanner"
 )
 
 // Codec defines the interface for encoding and writing tabular data
 // from a scanner.Rows source to an io.Writer.
+//
+// A Codec is configured once when it is created and keeps no state between
+// calls: all state of an export lives in the Write call. A configured codec can
+// therefore be reused and shared by parallel exports on many goroutines, as long
+// as the functions registered with its options, such as custom type mappers and
+// preprocessors, are safe for concurrent use as well. The codecs of this module
+// follow this contract; exportertest.Concurrent checks it for custom codecs.
 type Codec interface {
 	Write(rows scanner.Rows, writer io.Writer) error
 }
 
+// Appender is an optional interface implemented by codecs whose documents can be
+// continued by a later export, such as a JSON array, as used by
+// Exporter.AppendFile. A codec in append mode leaves out the opening of the
+// document and starts without a separator, but closes the document as usual.
+type Appender interface {
+	// AppendSyntax returns the sequence that opens a document, the separator
+	// between rows and the sequence that closes a document, ignoring whitespace.
+	// All three are empty if documents can be concatenated as they are. It
+	// reports false if the codec is not configured for appending.
+	AppendSyntax() (open, separator, close string, ok bool)
+}
+
+// Trailer is an optional interface implemented by codecs whose documents can end
+// with a line that readers of the format ignore, such as a comment, as used by
+// exporter.WithIntegrityFooter.
+type Trailer interface {
+	// TrailerLine returns a line embedding text, including its line break. The
+	// text is a single line without markup characters. It reports false if the
+	// configuration of the codec allows no such line.
+	TrailerLine(text string) (string, bool)
+}
+
 // JSON returns a Codec that writes data in JSON format.
 // Optional configuration can be provided via functional options.
 func JSON(opts ...jsoncodec.Option) Codec {
@@ -42,3 +73,15 @@ func HTML(opts ...htmlcodec.Option) Codec {
 func XML(opts ...xmlcodec.Option) Codec {
 	return xmlcodec.New(opts...)
 }
+
+// Parquet returns a Codec that writes data as an Apache Parquet file.
+// Optional configuration can be provided via functional options.
+func Parquet(opts ...parquetcodec.Option) Codec {
+	return parquetcodec.New(opts...)
+}
+
+// Table returns a Codec that writes data as an aligned text table for terminals.
+// Optional configuration can be provided via functional options.
+func Table(opts ...tablecodec.Option) Codec {
+	return tablecodec.New(opts...)
+}
@@ -5,12 +5,20 @@
 package csvcodec
 
 import (
+	"bytes"
 	"encoding/csv"
 	"errors"
 	"fmt"
 	"io"
 	"reflect"
+	"strings"
 
+	"github.com/go-data-exporter/exporter/charset"
+	"github.com/go-data-exporter/exporter/internal/blob"
+	"github.com/go-data-exporter/exporter/internal/rowbuf"
+	"github.com/go-data-exporter/exporter/internal/rowcounter"
+	"github.com/go-data-exporter/exporter/internal/rowiter"
+	"github.com/go-data-exporter/exporter/internal/typecache"
 	"github.com/go-data-exporter/exporter/scanner"
 	"github.com/go-data-exporter/exporter/tostring"
 )
@@ -18,16 +26,22 @@ import (
 // csvCodec implements the Codec interface for exporting tabular data in CSV format.
 type csvCodec struct {
 	customMapper     map[reflect.Type]func(any, scanner.Metadata) tostring.String
+	converter        *tostring.Converter
 	preProcessorFunc func(rowID int, row []string) ([]string, bool)
 
 	delimiter         rune
 	useCRLF           bool
 	writeHeader       bool
 	writeHeaderNoData bool
+	writeTypeHeader   bool
 	customHeader      []string
+	commentLines      []string
+	commentPrefix     string
 
-	nullValue string
-	limit     int
+	nullValue  string
+	limit      int
+	flushEvery int
+	charset    *charset.Charset
 }
 
 // Option defines a functional option for configuring the CSV codec.
@@ -37,6 +51,7 @@ type Option func(*csvCodec)
 func New(opts ...Option) *csvCodec {
 	c := &csvCodec{
 		customMapper:      make(map[reflect.Type]func(any, scanner.Metadata) tostring.String),
+		converter:         tostring.New(),
 		delimiter:         ',',
 		writeHeader:       true,
 		writeHeaderNoData: true,
@@ -62,8 +77,20 @@ func WithCustomType[T any](fn func(v T, metadata scanner.Metadata) tostring.Stri
 	}
 }
 
+// WithToString sets the converter used for values without a custom type mapping.
+// It controls time layouts, float formatting, bool literals and []byte encoding.
+func WithToString(converter *tostring.Converter) Option {
+	return func(c *csvCodec) {
+		if converter != nil {
+			c.converter = converter
+		}
+	}
+}
+
 // WithPreProcessorFunc sets a function to preprocess or filter each row before writing.
 // The function receives the row ID and the row values, and can return modified values or skip the row.
+// Row IDs number the written rows starting from 1, so a skipped row passes its ID on to the next row.
+// Streamed scanner.Blob values appear as opaque placeholders that are replaced when written.
 func WithPreProcessorFunc(fn func(rowID int, row []string) ([]string, bool)) Option {
 	return func(c *csvCodec) {
 		c.preProcessorFunc = fn
@@ -91,6 +118,27 @@ func WithHeader(writeHeader bool) Option {
 	}
 }
 
+// WithTypeHeader controls whether a second header row containing the DatabaseTypeName
+// of each column is written after the header row, as expected by formats such as
+// ClickHouse CSVWithNamesAndTypes. It has no effect if the header is disabled.
+func WithTypeHeader(writeTypeHeader bool) Option {
+	return func(c *csvCodec) {
+		c.writeTypeHeader = writeTypeHeader
+	}
+}
+
+// WithCommentHeader writes lines as comment lines, each starting with prefix
+// (default "#"), at the beginning of the output before the header, e.g.
+// WithCommentHeader([]string{"generated_at=2024-05-06T07:00:00Z"}, "# ") for
+// ingestion specs that require such metadata. Lines containing line breaks are
+// written as several comment lines. The lines are written even if there are no rows.
+func WithCommentHeader(lines []string, prefix string) Option {
+	return func(c *csvCodec) {
+		c.commentLines = lines
+		c.commentPrefix = prefix
+	}
+}
+
 // WithWriteHeaderWhenNoData controls whether a header should be written even when no data rows exist.
 func WithWriteHeaderWhenNoData(writeHeaderNoData bool) Option {
 	return func(c *csvCodec) {
@@ -113,15 +161,27 @@ func WithCustomNULL(nullValue string) Option {
 }
 
 // WithLimit sets a limit on the number of rows to write. A negative value means no limit.
+// Rows skipped by the preprocessor do not count towards the limit.
 func WithLimit(limit int) Option {
 	return func(c *csvCodec) {
 		c.limit = limit
 	}
 }
 
+// WithFlushEveryRows flushes the buffered output to the writer after every n data rows,
+// so that write errors such as broken pipes are detected promptly and memory does not
+// build up on slow writers. A non-positive n flushes only at the end (default).
+func WithFlushEveryRows(n int) Option {
+	return func(c *csvCodec) {
+		c.flushEvery = n
+	}
+}
+
 // Write writes the scanned rows to the given writer in CSV format.
 // It supports optional headers, row preprocessing, NULL conversion, and row limits.
-func (c *csvCodec) Write(rows scanner.Rows, writer io.Writer) error {
+// scanner.Blob values are streamed as base64 without being loaded into memory.
+func (c *csvCodec) Write(rows scanner.Rows, writer io.Writer) (err error) {
+	writer = charset.NewWriter(writer, c.charset, charset.Replace)
 	cols, err := rows.Columns()
 	if err != nil {
 		return err
@@ -137,75 +197,194 @@ func (c *csvCodec) Write(rows scanner.Rows, writer io.Writer) error {
 		}
 		header = c.customHeader
 	}
-	csvWriter := csv.NewWriter(writer)
-	if c.delimiter != 0 {
-		csvWriter.Comma = c.delimiter
+	if err := c.writeComments(writer); err != nil {
+		return fmt.Errorf("failed to write comments: %w", err)
 	}
-	csvWriter.UseCRLF = c.useCRLF
-	defer csvWriter.Flush()
+	csvWriter := c.newCSVWriter(writer)
+	defer func() {
+		csvWriter.Flush()
+		if flushErr := csvWriter.Error(); err == nil && flushErr != nil {
+			err = fmt.Errorf("failed to flush: %w", flushErr)
+		}
+	}()
 
 	if c.writeHeader && c.writeHeaderNoData && len(header) != 0 {
-		if err = csvWriter.Write(header); err != nil {
-			return fmt.Errorf("failed to write headers: %w", err)
+		if err = c.writeHeaders(csvWriter, header, cols); err != nil {
+			return err
 		}
 	}
-	if c.limit == 0 {
+	counter := rowcounter.New(rows, c.limit)
+	if counter.Done() {
 		return nil
 	}
-	rowID := 1
-	for rows.Next() {
-		values, err := rows.ScanRow()
+	driver := rows.Driver()
+	nullValue := c.nullValue
+	if scanner.NullPolicyOf(rows) != scanner.NullDefault {
+		nullValue = ""
+	}
+	mappers := typecache.New(c.customMapper, len(cols))
+	buf := rowbuf.Get()
+	defer rowbuf.Put(buf)
+	blobs := blob.New()
+	var row []string
+	it := rowiter.New(rows)
+	for it.Next() {
+		values, err := it.ScanRow()
 		if err != nil {
 			return err
 		}
-		row := make([]string, len(values))
+		rowID := counter.Scan()
+		buf.Reset()
+		blobs.Reset()
 		for i := range columnNames {
+			if b, ok := values[i].(scanner.Blob); ok {
+				buf.AppendString(tostring.String{String: blobs.Placeholder(b)}, nullValue)
+				continue
+			}
 			meta := scanner.Metadata{
 				RowID:  rowID,
-				Driver: rows.Driver(),
+				Driver: driver,
 				Column: cols[i],
 			}
-			row[i] = c.toString(values[i], meta)
+			meta.Raw, _ = it.RawValue(i)
+			fn, _ := mappers.Lookup(i, values[i])
+			c.appendCell(buf, values[i], fn, meta, nullValue)
+		}
+		if c.preProcessorFunc != nil {
+			// The preprocessor may retain the row, so it is not reused.
+			row = nil
 		}
+		row = buf.Strings(row)
 		writeRow := true
 		if c.preProcessorFunc != nil {
 			row, writeRow = c.preProcessorFunc(rowID, row)
 		}
-		if writeRow {
-			if c.writeHeader && rowID == 1 && !c.writeHeaderNoData {
-				if err = csvWriter.Write(header); err != nil {
-					return fmt.Errorf("failed to write headers: %w", err)
-				}
+		if !writeRow {
+			continue
+		}
+		if c.writeHeader && counter.Written() == 0 && !c.writeHeaderNoData {
+			if err = c.writeHeaders(csvWriter, header, cols); err != nil {
+				return err
 			}
-			if err = csvWriter.Write(row); err != nil {
-				return fmt.Errorf("could not write %d row: %s", rowID, err.Error())
+		}
+		if blobs.Len() != 0 {
+			if err = c.writeBlobRow(csvWriter, writer, row, blobs); err != nil {
+				return fmt.Errorf("could not write %d row: %w", rowID, err)
 			}
-			if c.limit >= 0 && rowID >= c.limit {
-				return nil
+		} else if err = csvWriter.Write(row); err != nil {
+			return fmt.Errorf("could not write %d row: %s", rowID, err.Error())
+		}
+		limitReached := counter.Write()
+		if c.flushEvery > 0 && counter.Written()%c.flushEvery == 0 {
+			csvWriter.Flush()
+			if err = csvWriter.Error(); err != nil {
+				return fmt.Errorf("could not flush after %d row: %w", rowID, err)
 			}
-			rowID++
 		}
+		if limitReached {
+			return nil
+		}
+	}
+	return it.Err()
+}
+
+// WithCharset writes the output in a legacy charset such as charset.Windows1252
+// or charset.ShiftJIS instead of UTF-8 (default). Characters the charset cannot
+// encode are replaced with a question mark.
+func WithCharset(cs *charset.Charset) Option {
+	return func(c *csvCodec) {
+		c.charset = cs
+	}
+}
+
+// newCSVWriter creates a csv.Writer with the configured delimiter and line endings.
+func (c *csvCodec) newCSVWriter(writer io.Writer) *csv.Writer {
+	csvWriter := csv.NewWriter(writer)
+	if c.delimiter != 0 {
+		csvWriter.Comma = c.delimiter
+	}
+	csvWriter.UseCRLF = c.useCRLF
+	return csvWriter
+}
+
+// writeBlobRow writes a row containing streamed blobs. The row is encoded with the
+// blob placeholders, and the base64-encoded blobs are spliced directly into the output.
+func (c *csvCodec) writeBlobRow(csvWriter *csv.Writer, writer io.Writer, row []string, blobs *blob.Splicer) error {
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return err
+	}
+	var encoded bytes.Buffer
+	rowWriter := c.newCSVWriter(&encoded)
+	if err := rowWriter.Write(row); err != nil {
+		return err
 	}
-	return rows.Err()
+	rowWriter.Flush()
+	return blobs.Write(writer, encoded.Bytes())
 }
 
-// toString converts a single value to its string representation,
-// using a custom type mapper if available, or falling back to the default converter.
-// If the value is NULL, the configured nullValue is returned.
-func (c *csvCodec) toString(v any, metadata scanner.Metadata) string {
-	if v == nil {
-		return c.nullValue
+// TrailerLine implements codec.Trailer with a comment line, using the prefix of
+// WithCommentHeader or "# ".
+func (c *csvCodec) TrailerLine(text string) (string, bool) {
+	prefix, newline := c.commentPrefix, "\n"
+	if prefix == "" {
+		prefix = "# "
 	}
-	if fn, ok := c.customMapper[reflect.TypeOf(v)]; ok {
-		s := fn(v, metadata)
-		if s.IsNULL {
-			return c.nullValue
+	if c.useCRLF {
+		newline = "\r\n"
+	}
+	return prefix + text + newline, true
+}
+
+// writeComments writes the comment lines of WithCommentHeader.
+func (c *csvCodec) writeComments(writer io.Writer) error {
+	if len(c.commentLines) == 0 {
+		return nil
+	}
+	prefix, newline := c.commentPrefix, "\n"
+	if prefix == "" {
+		prefix = "#"
+	}
+	if c.useCRLF {
+		newline = "\r\n"
+	}
+	var b strings.Builder
+	for _, line := range c.commentLines {
+		for _, part := range strings.Split(strings.ReplaceAll(line, "\r\n", "\n"), "\n") {
+			b.WriteString(prefix)
+			b.WriteString(part)
+			b.WriteString(newline)
 		}
-		return s.String
 	}
-	s := tostring.ToString(v)
-	if s.IsNULL {
-		return c.nullValue
+	_, err := io.WriteString(writer, b.String())
+	return err
+}
+
+// writeHeaders writes the header row and, if enabled, the column type row.
+func (c *csvCodec) writeHeaders(csvWriter *csv.Writer, header []string, cols []scanner.Column) error {
+	if err := csvWriter.Write(header); err != nil {
+		return fmt.Errorf("failed to write headers: %w", err)
+	}
+	if !c.writeTypeHeader {
+		return nil
+	}
+	types := make([]string, len(cols))
+	for i, col := range cols {
+		types[i] = col.DatabaseTypeName()
+	}
+	if err := csvWriter.Write(types); err != nil {
+		return fmt.Errorf("failed to write type headers: %w", err)
+	}
+	return nil
+}
+
+// appendCell converts a single value and appends it to buf,
+// using the custom type mapper fn if not nil, or falling back to the default converter.
+// If the value is NULL, nullValue is appended.
+func (c *csvCodec) appendCell(buf *rowbuf.Row, v any, fn func(any, scanner.Metadata) tostring.String, metadata scanner.Metadata, nullValue string) {
+	if v != nil && fn != nil {
+		buf.AppendString(fn(v, metadata), nullValue)
+		return
 	}
-	return s.String
+	buf.AppendValue(c.converter, v, metadata.Driver, metadata.DatabaseTypeName(), nullValue)
 }
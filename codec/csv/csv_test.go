@@ -0,0 +1,155 @@
+package csvcodec
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-data-exporter/exporter/charset"
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+func TestWithTypeHeader(t *testing.T) {
+	data := [][]any{{1, "a"}, {2, nil}}
+	var buf bytes.Buffer
+	if err := New(WithTypeHeader(true), WithCustomNULL(`\N`)).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "column_0,column_1\nint,string\n1,a\n2,\\N\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+
+	buf.Reset()
+	c := New(WithTypeHeader(true), WithWriteHeaderWhenNoData(false))
+	if err := c.Write(scanner.FromData([][]any{}), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %q", buf.String())
+	}
+}
+
+// failingWriter accepts a limited number of bytes and then fails.
+type failingWriter struct {
+	n int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		w.n = 0
+		return 0, errors.New("broken pipe")
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func TestWriteReportsFlushErrors(t *testing.T) {
+	data := [][]any{{1}, {2}, {3}}
+	if err := New().Write(scanner.FromData(data), &failingWriter{}); err == nil {
+		t.Error("expected error from final flush")
+	}
+
+	err := New(WithFlushEveryRows(1)).Write(scanner.FromData(data), &failingWriter{n: 5})
+	if err == nil || !strings.Contains(err.Error(), "after 1 row") {
+		t.Errorf("expected error after first row, got %v", err)
+	}
+}
+
+func TestLimitCountsWrittenRows(t *testing.T) {
+	data := [][]any{{1}, {2}, {3}, {4}}
+	var rowIDs []int
+	skipSecond := func(rowID int, row []string) ([]string, bool) {
+		rowIDs = append(rowIDs, rowID)
+		return row, row[0] != "2"
+	}
+	var buf bytes.Buffer
+	c := New(WithHeader(false), WithLimit(2), WithPreProcessorFunc(skipSecond))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "1\n3\n" {
+		t.Errorf("got %q", buf.String())
+	}
+	// Row IDs number the written rows, as the skipped row passes its ID on.
+	if fmt.Sprint(rowIDs) != "[1 2 2]" {
+		t.Errorf("unexpected row IDs %v", rowIDs)
+	}
+}
+
+func TestBlobStreaming(t *testing.T) {
+	data := [][]any{
+		{1, scanner.Blob{Reader: strings.NewReader("hello")}, "a,b"},
+		{2, nil, "c"},
+	}
+	var buf bytes.Buffer
+	if err := New().Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "column_0,column_1,column_2\n1,aGVsbG8=,\"a,b\"\n2,,c\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func FuzzWrite(f *testing.F) {
+	for seed := range uint64(8) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed uint64) {
+		var buf bytes.Buffer
+		if err := New().Write(scanner.Fuzz(seed), &buf); err != nil {
+			t.Fatal(err)
+		}
+		records, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatalf("invalid CSV: %v", err)
+		}
+		if len(records) != 65 {
+			t.Fatalf("got %d records, want a header and 64 rows", len(records))
+		}
+	})
+}
+
+func TestWithCharset(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New(WithCharset(charset.Windows1252)).Write(scanner.FromData([][]any{{"café", "日本"}}), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if want := "column_0,column_1\ncaf\xe9,??\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestCustomTypeRawValue(t *testing.T) {
+	rows := scanner.Cast(scanner.FromData([][]any{{"12345678901234567890.5"}}), map[string]scanner.TargetType{"column_0": scanner.TypeFloat64})
+	c := New(WithHeader(false), WithCustomType(func(v float64, meta scanner.Metadata) tostring.String {
+		if raw, ok := meta.Raw.(string); ok {
+			return tostring.String{String: raw}
+		}
+		return tostring.String{String: fmt.Sprint(v)}
+	}))
+	var buf bytes.Buffer
+	if err := c.Write(rows, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if want := "12345678901234567890.5\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestWithCommentHeader(t *testing.T) {
+	var buf bytes.Buffer
+	c := New(WithCommentHeader([]string{"generated_at=2024-05-06T07:00:00Z", "source=users\nrows=1"}, "# "), WithCRLF(true))
+	if err := c.Write(scanner.FromData([][]any{{1}}), &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "# generated_at=2024-05-06T07:00:00Z\r\n# source=users\r\n# rows=1\r\ncolumn_0\r\n1\r\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,287 @@
+// Package dbwriter inserts tabular data into a table of a database/sql database,
+// so that rows read through the scanner pipeline can be copied from one database
+// to another instead of being encoded to a file. Rows are inserted with a prepared
+// statement in batched transactions, optionally creating the table from the schema.
+package dbwriter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-data-exporter/exporter/quote"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// defaultBatchSize is the number of rows inserted per transaction by default.
+const defaultBatchSize = 1000
+
+// Dialect describes the SQL syntax of a target database.
+type Dialect struct {
+	// Placeholder returns the bind parameter for the i-th value, starting from 1.
+	Placeholder func(i int) string
+	// Quote quotes a table or column identifier.
+	Quote func(name string) string
+	// ColumnType returns the column type used to create the table for col.
+	ColumnType func(col scanner.Column) string
+}
+
+var (
+	// Generic uses ? placeholders, double-quoted identifiers and standard SQL types.
+	// It suits SQLite and most other databases with ? placeholders (default).
+	Generic = Dialect{Placeholder: questionMark, Quote: quote.DoubleQuotes, ColumnType: standardType(nil)}
+	// Postgres uses $1 placeholders and double-quoted identifiers.
+	Postgres = Dialect{Placeholder: dollar, Quote: quote.Postgres.Ident, ColumnType: standardType(map[scanner.Kind]string{
+		scanner.KindBinary:      "BYTEA",
+		scanner.KindTimestampTZ: "TIMESTAMPTZ",
+	})}
+	// MySQL uses ? placeholders and backtick-quoted identifiers.
+	MySQL = Dialect{Placeholder: questionMark, Quote: quote.MySQL.Ident, ColumnType: standardType(map[scanner.Kind]string{
+		scanner.KindBinary:      "LONGBLOB",
+		scanner.KindFloat64:     "DOUBLE",
+		scanner.KindDecimal:     "DOUBLE",
+		scanner.KindTimestamp:   "DATETIME(6)",
+		scanner.KindTimestampTZ: "DATETIME(6)",
+	})}
+	// SQLServer uses @p1 placeholders and bracket-quoted identifiers.
+	SQLServer = Dialect{Placeholder: atP, Quote: brackets, ColumnType: standardType(map[scanner.Kind]string{
+		scanner.KindBoolean:     "BIT",
+		scanner.KindFloat32:     "REAL",
+		scanner.KindFloat64:     "FLOAT",
+		scanner.KindDecimal:     "FLOAT",
+		scanner.KindString:      "NVARCHAR(MAX)",
+		scanner.KindBinary:      "VARBINARY(MAX)",
+		scanner.KindTimestamp:   "DATETIME2",
+		scanner.KindTimestampTZ: "DATETIMEOFFSET",
+	})}
+)
+
+// Option defines a functional configuration option for dbWriter.
+type Option func(*dbWriter)
+
+// dbWriter inserts rows into a database table.
+type dbWriter struct {
+	db          *sql.DB
+	table       string
+	dialect     Dialect
+	batchSize   int
+	createTable bool
+}
+
+// New creates a writer that inserts rows into table of db.
+func New(db *sql.DB, table string, opts ...Option) *dbWriter {
+	w := &dbWriter{
+		db:        db,
+		table:     table,
+		dialect:   Generic,
+		batchSize: defaultBatchSize,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// WithDialect sets the SQL syntax of the target database (default Generic).
+func WithDialect(dialect Dialect) Option {
+	return func(w *dbWriter) {
+		w.dialect = dialect
+	}
+}
+
+// WithBatchSize sets the number of rows inserted per transaction (default 1000).
+// Every transaction is committed before the next one starts, so a failure leaves
+// the rows of the previous batches in the table.
+func WithBatchSize(batchSize int) Option {
+	return func(w *dbWriter) {
+		w.batchSize = batchSize
+	}
+}
+
+// WithCreateTable creates the table with CREATE TABLE IF NOT EXISTS before inserting,
+// with column types derived from the column metadata of the rows and a primary key
+// from their scanner.ColumnInfo, if any.
+func WithCreateTable(createTable bool) Option {
+	return func(w *dbWriter) {
+		w.createTable = createTable
+	}
+}
+
+// Write inserts all rows into the table and returns the number of rows inserted.
+// scanner.Blob values are read into memory before they are inserted.
+func (w *dbWriter) Write(ctx context.Context, rows scanner.Rows) (int64, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	if w.createTable {
+		if _, err := w.db.ExecContext(ctx, w.createStatement(cols)); err != nil {
+			return 0, fmt.Errorf("dbwriter: failed to create table: %w", err)
+		}
+	}
+	insert := w.insertStatement(cols)
+	batchSize := w.batchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	var (
+		inserted int64
+		tx       *sql.Tx
+		stmt     *sql.Stmt
+		pending  int
+	)
+	rollback := func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}
+	args := make([]any, len(cols))
+	for rows.Next() {
+		values, err := rows.ScanRow()
+		if err != nil {
+			rollback()
+			return inserted, err
+		}
+		if tx == nil {
+			if tx, err = w.db.BeginTx(ctx, nil); err != nil {
+				return inserted, err
+			}
+			if stmt, err = tx.PrepareContext(ctx, insert); err != nil {
+				rollback()
+				return inserted, err
+			}
+		}
+		for i := range args {
+			if args[i], err = value(values[i]); err != nil {
+				rollback()
+				return inserted, fmt.Errorf("dbwriter: column %q: %w", cols[i].Name(), err)
+			}
+		}
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			rollback()
+			return inserted, fmt.Errorf("dbwriter: failed to insert row: %w", err)
+		}
+		if pending++; pending >= batchSize {
+			if err := tx.Commit(); err != nil {
+				return inserted, err
+			}
+			inserted += int64(pending)
+			tx, pending = nil, 0
+		}
+	}
+	if err := rows.Err(); err != nil {
+		rollback()
+		return inserted, err
+	}
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			return inserted, err
+		}
+		inserted += int64(pending)
+	}
+	return inserted, nil
+}
+
+// insertStatement returns the INSERT statement for a single row.
+func (w *dbWriter) insertStatement(cols []scanner.Column) string {
+	names := make([]string, len(cols))
+	params := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = w.dialect.Quote(col.Name())
+		params[i] = w.dialect.Placeholder(i + 1)
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		w.dialect.Quote(w.table), strings.Join(names, ", "), strings.Join(params, ", "))
+}
+
+// createStatement returns the CREATE TABLE statement for the columns, with a
+// primary key on the columns that report one in their scanner.ColumnInfo.
+func (w *dbWriter) createStatement(cols []scanner.Column) string {
+	defs := make([]string, len(cols), len(cols)+1)
+	var keys []string
+	for i, col := range cols {
+		defs[i] = w.dialect.Quote(col.Name()) + " " + w.dialect.ColumnType(col)
+		if info, ok := scanner.Info(col); ok && info.PrimaryKey {
+			keys = append(keys, w.dialect.Quote(col.Name()))
+		}
+	}
+	if len(keys) != 0 {
+		defs = append(defs, "PRIMARY KEY ("+strings.Join(keys, ", ")+")")
+	}
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", w.dialect.Quote(w.table), strings.Join(defs, ", "))
+}
+
+// value prepares a scanned value for insertion.
+func value(v any) (any, error) {
+	if b, ok := v.(scanner.Blob); ok {
+		return io.ReadAll(b)
+	}
+	return v, nil
+}
+
+// standardTypes are the standard SQL column types of the column kinds.
+// Kinds without an entry are created as TEXT.
+var standardTypes = map[scanner.Kind]string{
+	scanner.KindBoolean:     "BOOLEAN",
+	scanner.KindInt16:       "SMALLINT",
+	scanner.KindInt32:       "INTEGER",
+	scanner.KindInt64:       "BIGINT",
+	scanner.KindFloat32:     "REAL",
+	scanner.KindFloat64:     "DOUBLE PRECISION",
+	scanner.KindDecimal:     "DOUBLE PRECISION",
+	scanner.KindBinary:      "BLOB",
+	scanner.KindDate:        "DATE",
+	scanner.KindTime:        "TIME",
+	scanner.KindTimestamp:   "TIMESTAMP",
+	scanner.KindTimestampTZ: "TIMESTAMP",
+}
+
+// standardType returns a ColumnType function mapping the kind of a column, as
+// returned by scanner.ColumnKind, to standard SQL types, except for the kinds
+// in overrides. Numeric columns with a known precision and scale are created as
+// DECIMAL.
+func standardType(overrides map[scanner.Kind]string) func(col scanner.Column) string {
+	return func(col scanner.Column) string {
+		kind := scanner.ColumnKind(col)
+		switch kind {
+		case scanner.KindFloat32, scanner.KindFloat64, scanner.KindDecimal, scanner.KindUnknown:
+			if precision, scale, ok := col.DecimalSize(); ok {
+				return fmt.Sprintf("DECIMAL(%d, %d)", precision, scale)
+			}
+		}
+		if typ, ok := overrides[kind]; ok {
+			return typ
+		}
+		if typ, ok := standardTypes[kind]; ok {
+			return typ
+		}
+		if typ, ok := overrides[scanner.KindString]; ok {
+			return typ
+		}
+		return "TEXT"
+	}
+}
+
+// questionMark returns a ? placeholder.
+func questionMark(int) string {
+	return "?"
+}
+
+// dollar returns a $i placeholder.
+func dollar(i int) string {
+	return "$" + strconv.Itoa(i)
+}
+
+// atP returns an @pi placeholder.
+func atP(i int) string {
+	return "@p" + strconv.Itoa(i)
+}
+
+// brackets quotes an identifier with square brackets.
+func brackets(name string) string {
+	return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+}
@@ -0,0 +1,106 @@
+package dbwriter
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// recorder is a database/sql driver that records the statements it executes.
+type recorder struct {
+	mu      sync.Mutex
+	log     []string
+	commits int
+}
+
+func (r *recorder) Open(string) (driver.Conn, error) { return &recordConn{r}, nil }
+
+func (r *recorder) record(format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.log = append(r.log, fmt.Sprintf(format, args...))
+}
+
+type recordConn struct{ r *recorder }
+
+func (c *recordConn) Prepare(query string) (driver.Stmt, error) {
+	return &recordStmt{r: c.r, query: query}, nil
+}
+func (c *recordConn) Close() error              { return nil }
+func (c *recordConn) Begin() (driver.Tx, error) { return &recordTx{c.r}, nil }
+
+type recordTx struct{ r *recorder }
+
+func (tx *recordTx) Commit() error {
+	tx.r.mu.Lock()
+	defer tx.r.mu.Unlock()
+	tx.r.commits++
+	return nil
+}
+func (tx *recordTx) Rollback() error { return nil }
+
+type recordStmt struct {
+	r     *recorder
+	query string
+}
+
+func (s *recordStmt) Close() error  { return nil }
+func (s *recordStmt) NumInput() int { return -1 }
+func (s *recordStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.r.record("%s %v", s.query, args)
+	return driver.RowsAffected(1), nil
+}
+func (s *recordStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func TestWrite(t *testing.T) {
+	rec := &recorder{}
+	sql.Register("dbwriter-recorder", rec)
+	db, err := sql.Open("dbwriter-recorder", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	data := [][]any{{int64(1), "a"}, {int64(2), "b"}, {int64(3), nil}}
+	n, err := New(db, "copy", WithDialect(Postgres), WithBatchSize(2), WithCreateTable(true)).
+		Write(context.Background(), scanner.FromData(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("inserted %d rows, want 3", n)
+	}
+	want := []string{
+		`CREATE TABLE IF NOT EXISTS "copy" ("column_0" BIGINT, "column_1" TEXT) []`,
+		`INSERT INTO "copy" ("column_0", "column_1") VALUES ($1, $2) [1 a]`,
+		`INSERT INTO "copy" ("column_0", "column_1") VALUES ($1, $2) [2 b]`,
+		`INSERT INTO "copy" ("column_0", "column_1") VALUES ($1, $2) [3 <nil>]`,
+	}
+	if !reflect.DeepEqual(rec.log, want) {
+		t.Errorf("got statements\n%q\nwant\n%q", rec.log, want)
+	}
+	if rec.commits != 2 {
+		t.Errorf("got %d commits, want 2", rec.commits)
+	}
+}
+
+func TestCreateStatementPrimaryKey(t *testing.T) {
+	rows := scanner.WithColumnInfo(scanner.FromData([][]any{{int64(1), "a"}}),
+		map[string]scanner.ColumnInfo{"column_0": {PrimaryKey: true}})
+	cols, err := rows.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `CREATE TABLE IF NOT EXISTS "copy" ("column_0" BIGINT, "column_1" TEXT, PRIMARY KEY ("column_0"))`
+	if got := New(nil, "copy", WithDialect(Postgres)).createStatement(cols); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
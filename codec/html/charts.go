@@ -0,0 +1,258 @@
+// Package htmlcodec provides an HTML implementation of the Codec interface.
+// This file implements the charts drawn below the table as inline SVG.
+package htmlcodec
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// ChartKind selects how WithChart draws its columns.
+type ChartKind int
+
+const (
+	ChartLine ChartKind = iota // A line per column.
+	ChartBar                   // A group of bars per row, one bar per column.
+)
+
+// chartSpec is a chart configured with WithChart.
+type chartSpec struct {
+	kind    ChartKind
+	columns []string
+}
+
+// WithSparklines draws a sparkline of every numeric column of the table in a
+// charts section below the table, for a quick visual summary of the data. The
+// values of the written rows are kept in memory until the table is complete.
+func WithSparklines(sparklines bool) Option {
+	return func(c *htmlCodec) {
+		c.sparklines = sparklines
+	}
+}
+
+// WithChart draws a line or bar chart of the named columns in the charts section
+// below the table, with a point or bar per written row. Values that are not
+// numbers, including NULL, are skipped. Columns may be hidden in the table. The
+// option may be given several times for several charts. The values of the charted
+// columns are kept in memory until the table is complete.
+func WithChart(kind ChartKind, columnNames ...string) Option {
+	return func(c *htmlCodec) {
+		c.charts = append(c.charts, chartSpec{kind: kind, columns: columnNames})
+	}
+}
+
+// chartSeries holds the values of a column, NaN for values that are not numbers.
+type chartSeries struct {
+	name   string
+	field  int // The index of the source column.
+	values []float64
+}
+
+// chartData collects the values of the charted columns while the rows are written.
+type chartData struct {
+	sparklines []*chartSeries
+	charts     []chart
+	series     []*chartSeries // All series, each column once.
+}
+
+// chart is a chartSpec resolved against the columns of the table.
+type chart struct {
+	kind   ChartKind
+	series []*chartSeries
+}
+
+// newChartData returns the chart data of the table with the given source columns
+// and visible fields, or nil if no charts are configured.
+func (c *htmlCodec) newChartData(cols []scanner.Column, fields []int) (*chartData, error) {
+	if !c.sparklines && len(c.charts) == 0 {
+		return nil, nil
+	}
+	d := &chartData{}
+	byField := make(map[int]*chartSeries)
+	seriesOf := func(field int) *chartSeries {
+		s, ok := byField[field]
+		if !ok {
+			s = &chartSeries{name: cols[field].Name(), field: field}
+			byField[field] = s
+			d.series = append(d.series, s)
+		}
+		return s
+	}
+	if c.sparklines {
+		for _, field := range fields {
+			if scanner.ColumnKind(cols[field]).IsNumeric() {
+				d.sparklines = append(d.sparklines, seriesOf(field))
+			}
+		}
+	}
+	for _, spec := range c.charts {
+		ch := chart{kind: spec.kind}
+		for _, name := range spec.columns {
+			field := slices.IndexFunc(cols, func(col scanner.Column) bool { return col.Name() == name })
+			if field < 0 {
+				return nil, fmt.Errorf("htmlcodec: unknown column %q", name)
+			}
+			ch.series = append(ch.series, seriesOf(field))
+		}
+		d.charts = append(d.charts, ch)
+	}
+	return d, nil
+}
+
+// add adds the values of a written row.
+func (d *chartData) add(values []any) {
+	for _, s := range d.series {
+		s.values = append(s.values, chartValue(values[s.field]))
+	}
+}
+
+// chartValue returns v as a number, or NaN if it is not one.
+func chartValue(v any) float64 {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); !math.IsInf(f, 0) {
+			return f
+		}
+		return math.NaN()
+	}
+	s := tostring.ToString(v)
+	if s.IsNULL {
+		return math.NaN()
+	}
+	f, err := strconv.ParseFloat(s.String, 64)
+	if err != nil || math.IsInf(f, 0) {
+		return math.NaN()
+	}
+	return f
+}
+
+// Chart dimensions in pixels.
+const (
+	sparklineWidth  = 120
+	sparklineHeight = 24
+	chartWidth      = 600
+	chartHeight     = 240
+	chartMargin     = 40 // Room for the axis labels.
+)
+
+// chartColors are the colors of the series of a chart.
+var chartColors = []string{"#4e79a7", "#f28e2b", "#e15759", "#76b7b2", "#59a14f", "#edc948", "#b07aa1", "#ff9da7"}
+
+// write writes the charts section.
+func (d *chartData) write(w io.Writer) {
+	var b strings.Builder
+	b.WriteString(`<section class="charts" style="padding:15px;">`)
+	for _, s := range d.sparklines {
+		fmt.Fprintf(&b, `<figure style="display:inline-block;margin:0 20px 10px 0;"><figcaption style="font-size:12px;color:#555;">%s</figcaption>`, html.EscapeString(s.name))
+		lo, hi := valueRange(s.values)
+		fmt.Fprintf(&b, `<svg width="%d" height="%d" viewBox="0 0 %d %d">`, sparklineWidth, sparklineHeight, sparklineWidth, sparklineHeight)
+		writeLine(&b, s.values, lo, hi, 0, 0, sparklineWidth, sparklineHeight, chartColors[0])
+		b.WriteString(`</svg></figure>`)
+	}
+	for _, ch := range d.charts {
+		ch.write(&b)
+	}
+	b.WriteString(`</section>`)
+	io.WriteString(w, b.String())
+}
+
+// write writes a line or bar chart with axis labels and a legend.
+func (ch chart) write(b *strings.Builder) {
+	lo, hi := 0.0, 0.0
+	rows := 0
+	for _, s := range ch.series {
+		l, h := valueRange(s.values)
+		lo, hi = min(lo, l), max(hi, h)
+		rows = max(rows, len(s.values))
+	}
+	if hi == lo {
+		hi = lo + 1
+	}
+	x, y := float64(chartMargin), float64(10)
+	width, height := float64(chartWidth-chartMargin-10), float64(chartHeight-chartMargin)
+	fmt.Fprintf(b, `<figure style="margin:0 0 20px 0;"><svg width="%d" height="%d" viewBox="0 0 %d %d" font-size="11" font-family="sans-serif">`, chartWidth, chartHeight, chartWidth, chartHeight)
+	fmt.Fprintf(b, `<line x1="%g" y1="%g" x2="%g" y2="%g" stroke="#999"/>`, x, y, x, y+height)
+	zero := y + height - (0-lo)/(hi-lo)*height
+	fmt.Fprintf(b, `<line x1="%g" y1="%g" x2="%g" y2="%g" stroke="#999"/>`, x, zero, x+width, zero)
+	fmt.Fprintf(b, `<text x="%g" y="%g" text-anchor="end">%s</text>`, x-4, y+4, formatTick(hi))
+	fmt.Fprintf(b, `<text x="%g" y="%g" text-anchor="end">%s</text>`, x-4, y+height, formatTick(lo))
+	for i, s := range ch.series {
+		color := chartColors[i%len(chartColors)]
+		if ch.kind == ChartBar {
+			slot := width / float64(max(rows, 1))
+			bar := slot * 0.8 / float64(len(ch.series))
+			for row, v := range s.values {
+				if math.IsNaN(v) {
+					continue
+				}
+				top := y + height - (v-lo)/(hi-lo)*height
+				fmt.Fprintf(b, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="%s"/>`,
+					x+float64(row)*slot+slot*0.1+float64(i)*bar, min(top, zero), bar, math.Abs(zero-top), color)
+			}
+		} else {
+			writeLine(b, s.values, lo, hi, x, y, width, height, color)
+		}
+		fmt.Fprintf(b, `<text x="%g" y="%d" fill="%s">%s</text>`, x+float64(i)*120, chartHeight-8, color, html.EscapeString(s.name))
+	}
+	b.WriteString(`</svg></figure>`)
+}
+
+// writeLine writes a polyline of values scaled from lo..hi into the box at x, y.
+// Values that are not numbers are skipped.
+func writeLine(b *strings.Builder, values []float64, lo, hi, x, y, width, height float64, color string) {
+	if hi == lo {
+		lo, hi = lo-1, hi+1
+	}
+	step := width
+	if len(values) > 1 {
+		step = width / float64(len(values)-1)
+	}
+	fmt.Fprintf(b, `<polyline fill="none" stroke="%s" stroke-width="1.5" points="`, color)
+	first := true
+	for i, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprintf(b, "%.2f,%.2f", x+float64(i)*step, y+height-(v-lo)/(hi-lo)*height)
+	}
+	b.WriteString(`"/>`)
+}
+
+// valueRange returns the smallest and largest number of values, or 0, 0 if there are none.
+func valueRange(values []float64) (lo, hi float64) {
+	first := true
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		if first {
+			lo, hi, first = v, v, false
+			continue
+		}
+		lo, hi = min(lo, v), max(hi, v)
+	}
+	return lo, hi
+}
+
+// formatTick formats an axis label.
+func formatTick(v float64) string {
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
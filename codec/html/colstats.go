@@ -0,0 +1,157 @@
+// Package htmlcodec provides an HTML implementation of the Codec interface.
+// This file implements the column statistics shown in the table header.
+package htmlcodec
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/go-data-exporter/exporter/internal/colstats"
+	"github.com/go-data-exporter/exporter/internal/rowiter"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// defaultStatsSample is the number of rows column statistics are computed over by default.
+const defaultStatsSample = 1000
+
+// maxStatsValueLength is the length in characters at which min and max values are shortened.
+const maxStatsValueLength = 20
+
+// WithColumnStats shows statistics of every column under its header cell: the
+// minimum and maximum, the share of NULL values and the estimated number of
+// distinct values. Since the header precedes the rows, the statistics are computed
+// over a sample of the first rows (see WithColumnStatsSample), which is held in
+// memory before the table is written; the header notes when the table has more
+// rows than the sample. Statistics describe the source values before
+// preprocessing.
+func WithColumnStats(columnStats bool) Option {
+	return func(c *htmlCodec) {
+		c.columnStats = columnStats
+	}
+}
+
+// WithColumnStatsSample sets the number of rows the statistics of WithColumnStats
+// are computed over (default 1000).
+func WithColumnStatsSample(rows int) Option {
+	return func(c *htmlCodec) {
+		c.statsSample = rows
+	}
+}
+
+// rowIterator reads the rows of the table, see rowiter.Iter.
+type rowIterator interface {
+	Next() bool
+	ScanRow() ([]any, error)
+	RawValue(column int) (any, bool)
+	Err() error
+}
+
+// sampleIter replays the sampled rows before the remaining rows of an iterator.
+type sampleIter struct {
+	*rowiter.Iter
+	rows [][]any // The sampled rows not replayed yet.
+	raws [][]any // The raw values of the sampled rows.
+
+	row []any // The current replayed row, nil once the sample is exhausted.
+	raw []any
+}
+
+// sample reads up to n rows from it and returns an iterator replaying them
+// followed by the remaining rows, with the statistics of the sampled rows and
+// whether the sample holds all rows.
+func sample(it *rowiter.Iter, columns, n int) (*sampleIter, []colstats.Column, bool, error) {
+	s := &sampleIter{Iter: it}
+	stats := make([]colstats.Column, columns)
+	interner := scanner.NewInterner(0)
+	for len(s.rows) < n {
+		if !it.Next() {
+			if err := it.Err(); err != nil {
+				return nil, nil, false, err
+			}
+			return s, stats, true, nil
+		}
+		row, err := it.ScanRow()
+		if err != nil {
+			return nil, nil, false, err
+		}
+		row = scanner.CloneRow(row)
+		interner.Intern(row)
+		raws := make([]any, len(row))
+		for i, v := range row {
+			raws[i], _ = it.RawValue(i)
+			if i < len(stats) {
+				stats[i].Add(v)
+			}
+		}
+		s.rows = append(s.rows, row)
+		s.raws = append(s.raws, raws)
+	}
+	return s, stats, false, nil
+}
+
+// Next advances to the next sampled row, or to the next row of the iterator.
+func (s *sampleIter) Next() bool {
+	if len(s.rows) != 0 {
+		s.row, s.raw = s.rows[0], s.raws[0]
+		s.rows, s.raws = s.rows[1:], s.raws[1:]
+		return true
+	}
+	s.row, s.raw = nil, nil
+	return s.Iter.Next()
+}
+
+// ScanRow returns the current row.
+func (s *sampleIter) ScanRow() ([]any, error) {
+	if s.row != nil {
+		return s.row, nil
+	}
+	return s.Iter.ScanRow()
+}
+
+// RawValue returns the raw value of the current row.
+func (s *sampleIter) RawValue(column int) (any, bool) {
+	if s.row != nil {
+		return s.raw[column], s.raw[column] != nil
+	}
+	return s.Iter.RawValue(column)
+}
+
+// statsCSS holds the style rules of the statistics written by WithColumnStats.
+const statsCSS = `p.stats { margin-top: 5px; font-size: 11px; font-weight: normal; color: #666; }`
+
+// statsParagraph returns the statistics shown under the header of a column, or an
+// empty string if the sample has no rows.
+func (c *htmlCodec) statsParagraph(s *colstats.Column, sampled int, complete bool) string {
+	total := s.Count + s.Nulls
+	if total == 0 {
+		return ""
+	}
+	var parts []string
+	if s.Min != nil {
+		parts = append(parts, "min "+c.statsValue(s.Min), "max "+c.statsValue(s.Max))
+	}
+	nulls := float64(s.Nulls) * 100 / float64(total)
+	prec := 1
+	if nulls == math.Trunc(nulls) {
+		prec = 0
+	}
+	parts = append(parts, strconv.FormatFloat(nulls, 'f', prec, 64)+"% null")
+	parts = append(parts, fmt.Sprintf("%d distinct", min(s.Distinct(), uint64(s.Count))))
+	title := ""
+	if !complete {
+		title = fmt.Sprintf(` title="Statistics of the first %d rows"`, sampled)
+	}
+	return `<p class=stats` + title + `>` + html.EscapeString(strings.Join(parts, " · ")) + `</p>`
+}
+
+// statsValue formats a minimum or maximum, shortening long values.
+func (c *htmlCodec) statsValue(v any) string {
+	s := []rune(c.converter.ToString(v).String)
+	if len(s) > maxStatsValueLength {
+		return string(s[:maxStatsValueLength-1]) + "…"
+	}
+	return string(s)
+}
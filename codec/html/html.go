@@ -5,23 +5,58 @@ package htmlcodec
 
 import (
 	"fmt"
+	"html"
+	"html/template"
 	"io"
+	"maps"
 	"reflect"
+	"slices"
 	"strings"
 
+	"github.com/go-data-exporter/exporter/charset"
+	"github.com/go-data-exporter/exporter/highlight"
+	"github.com/go-data-exporter/exporter/internal/rowbuf"
+	"github.com/go-data-exporter/exporter/internal/rowcounter"
+	"github.com/go-data-exporter/exporter/internal/rowiter"
+	"github.com/go-data-exporter/exporter/internal/typecache"
+	"github.com/go-data-exporter/exporter/locale"
 	"github.com/go-data-exporter/exporter/scanner"
 	"github.com/go-data-exporter/exporter/tostring"
 )
 
 // htmlCodec implements the Codec interface to export tabular data as HTML.
 type htmlCodec struct {
-	customMapper      map[reflect.Type]func(any, scanner.Metadata) tostring.String
+	customMapper      map[reflect.Type]cellMapper
+	converter         *tostring.Converter
 	preProcessorFunc  func(rowID int, row []string) ([]string, bool)
 	writeHeader       bool
 	writeHeaderNoData bool
+	rowCountSummary   bool
+
+	columns       []string
+	hiddenColumns []string
+	columnOrder   []string
+
+	themes   []Theme
+	extraCSS string
+
+	typeAlignment bool
+	boolSymbols   bool
+	stickyColumn  bool
+	metadataBlock bool
 
 	nullValue string
 	limit     int
+	charset   *charset.Charset
+	fragment  bool
+	locale    *locale.Locale
+	rules     []highlight.Rule
+
+	sparklines bool
+	charts     []chartSpec
+
+	columnStats bool
+	statsSample int
 }
 
 // Option defines a functional configuration option for htmlCodec.
@@ -30,11 +65,13 @@ type Option func(*htmlCodec)
 // New creates a new HTML codec with the provided configuration options.
 func New(opts ...Option) *htmlCodec {
 	c := &htmlCodec{
-		customMapper:      make(map[reflect.Type]func(any, scanner.Metadata) tostring.String),
+		customMapper:      make(map[reflect.Type]cellMapper),
+		converter:         tostring.New(),
 		writeHeader:       true,
 		writeHeaderNoData: true,
-		nullValue:         `<span style="color:#aaaaaa;">[NULL]</span>`,
+		nullValue:         "[NULL]",
 		limit:             -1,
+		statsSample:       defaultStatsSample,
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -42,21 +79,50 @@ func New(opts ...Option) *htmlCodec {
 	return c
 }
 
+// cellMapper is a custom type mapper. The output of raw mappers is trusted markup
+// written without escaping.
+type cellMapper struct {
+	fn  func(any, scanner.Metadata) tostring.String
+	raw bool
+}
+
 // WithCustomType registers a custom string conversion function for a specific Go type.
+// The result is HTML-escaped like all other cells; see WithRawHTMLType for markup.
 func WithCustomType[T any](fn func(v T, metadata scanner.Metadata) tostring.String) Option {
 	return func(c *htmlCodec) {
 		var zero T
-		typ := reflect.TypeOf(zero)
-		if c.customMapper == nil {
-			c.customMapper = make(map[reflect.Type]func(any, scanner.Metadata) tostring.String)
-		}
-		c.customMapper[typ] = func(v any, metadata scanner.Metadata) tostring.String {
+		c.customMapper[reflect.TypeOf(zero)] = cellMapper{fn: func(v any, metadata scanner.Metadata) tostring.String {
 			return fn(v.(T), metadata)
+		}}
+	}
+}
+
+// WithRawHTMLType registers a conversion function for a specific Go type whose
+// result is written into the cell as it is, without escaping, e.g. to render links
+// or images. The function is responsible for escaping the values it embeds, e.g.
+// with html.EscapeString, since any markup it returns ends up in the document.
+// A preprocessor receives the markup and must keep it safe as well.
+func WithRawHTMLType[T any](fn func(v T, metadata scanner.Metadata) template.HTML) Option {
+	return func(c *htmlCodec) {
+		var zero T
+		c.customMapper[reflect.TypeOf(zero)] = cellMapper{raw: true, fn: func(v any, metadata scanner.Metadata) tostring.String {
+			return tostring.String{String: string(fn(v.(T), metadata))}
+		}}
+	}
+}
+
+// WithToString sets the converter used for values without a custom type mapping.
+// It controls time layouts, float formatting, bool literals and []byte encoding.
+func WithToString(converter *tostring.Converter) Option {
+	return func(c *htmlCodec) {
+		if converter != nil {
+			c.converter = converter
 		}
 	}
 }
 
 // WithPreProcessorFunc sets a function to preprocess or filter each row before writing.
+// Row IDs number the written rows starting from 1, so a skipped row passes its ID on to the next row.
 func WithPreProcessorFunc(fn func(rowID int, row []string) ([]string, bool)) Option {
 	return func(c *htmlCodec) {
 		c.preProcessorFunc = fn
@@ -70,7 +136,8 @@ func WithHeader(writeHeader bool) Option {
 	}
 }
 
-// WithCustomNULL sets the HTML string to be used for NULL values.
+// WithCustomNULL sets the text to be used for NULL values (default is "[NULL]").
+// The text is HTML-escaped and rendered with the NULL styling of the table.
 func WithCustomNULL(nullValue string) Option {
 	return func(c *htmlCodec) {
 		c.nullValue = nullValue
@@ -84,7 +151,118 @@ func WithWriteHeaderWhenNoData(writeHeaderNoData bool) Option {
 	}
 }
 
+// WithRowCountSummary adds a table footer with the number of rows written, e.g. "42 rows".
+// If the source implements scanner.Counter and has more rows than were written, the
+// footer shows both counts, e.g. "100 of 2500 rows".
+func WithRowCountSummary(rowCountSummary bool) Option {
+	return func(c *htmlCodec) {
+		c.rowCountSummary = rowCountSummary
+	}
+}
+
+// WithColumns restricts the table to the named columns, in the given order.
+// Naming a column that is not in the source fails the export.
+func WithColumns(columnNames ...string) Option {
+	return func(c *htmlCodec) {
+		c.columns = columnNames
+	}
+}
+
+// WithHiddenColumns removes the named columns from the table, e.g. internal IDs.
+// Names that are not in the source are ignored.
+func WithHiddenColumns(columnNames ...string) Option {
+	return func(c *htmlCodec) {
+		c.hiddenColumns = columnNames
+	}
+}
+
+// WithColumnOrder moves the named columns to the front of the table in the given
+// order; the other columns follow in source order. Naming a column that is not in
+// the source fails the export.
+func WithColumnOrder(columnNames ...string) Option {
+	return func(c *htmlCodec) {
+		c.columnOrder = columnNames
+	}
+}
+
+// Theme selects a built-in style of the HTML table.
+type Theme string
+
+const (
+	// ThemeStriped shades every other table row.
+	ThemeStriped Theme = "striped"
+	// ThemeDense reduces the cell padding and font size to fit more data on screen.
+	ThemeDense Theme = "dense"
+	// ThemeDark renders light text on a dark background.
+	ThemeDark Theme = "dark"
+)
+
+// themeCSS holds the style rules added by each theme.
+var themeCSS = map[Theme]string{
+	ThemeStriped: `tbody tr:nth-child(even) { background: rgba(127, 127, 127, 0.1); }`,
+	ThemeDense: `body { font-size: 12px; } th { padding: 4px 6px; } td { padding: 2px 6px; }
+		p.typ { margin-top: 1px; }`,
+	ThemeDark: `body, html { background: #1e1e1e; color: #dddddd; } thead { background: #2b2b2b !important; }
+		th, td { border-color: #444444; } p.typ, td.summary { color: #aaaaaa; } span.null { color: #777777; }
+		tbody td:first-child { background: #1e1e1e; } thead th:first-child { background: #2b2b2b; }
+		dl.metadata { color: #aaaaaa; }`,
+}
+
+// WithTheme applies built-in themes on top of the default style. Themes can be
+// combined, e.g. WithTheme(ThemeDark, ThemeStriped); unknown themes are ignored.
+// Styles are part of the document prefix written with the header.
+func WithTheme(themes ...Theme) Option {
+	return func(c *htmlCodec) {
+		c.themes = themes
+	}
+}
+
+// WithExtraCSS appends style rules after the default style and the themes, so
+// they can override any of them.
+func WithExtraCSS(css string) Option {
+	return func(c *htmlCodec) {
+		c.extraCSS = css
+	}
+}
+
+// WithTypeAlignment styles cells by the type of their column, as reported by
+// scanner.ColumnKind: numeric columns are right-aligned
+// with digits of equal width, and identifiers, i.e. UUID columns and columns named
+// "id" or ending with "_id", are set in a monospace font.
+func WithTypeAlignment(typeAlignment bool) Option {
+	return func(c *htmlCodec) {
+		c.typeAlignment = typeAlignment
+	}
+}
+
+// WithBoolSymbols renders boolean values as ✓ and ✗ instead of the literals of the converter.
+func WithBoolSymbols(boolSymbols bool) Option {
+	return func(c *htmlCodec) {
+		c.boolSymbols = boolSymbols
+	}
+}
+
+// WithStickyFirstColumn keeps the first column visible when the table is scrolled
+// horizontally, like the header is kept visible when scrolling vertically.
+func WithStickyFirstColumn(sticky bool) Option {
+	return func(c *htmlCodec) {
+		c.stickyColumn = sticky
+	}
+}
+
+// WithMetadataBlock writes the metadata describing the export above the table,
+// e.g. the query name, the driver, the generation time and the applied filters,
+// ordered by key. The metadata is obtained with scanner.Describe and is set by
+// exporter.WithMetadata; without it, only the driver is shown. The block is part
+// of the document prefix written with the header.
+func WithMetadataBlock(metadataBlock bool) Option {
+	return func(c *htmlCodec) {
+		c.metadataBlock = metadataBlock
+	}
+}
+
 // WithLimit sets a limit on the number of rows to write. Negative means unlimited.
+// Rows skipped by the preprocessor do not count towards the limit.
 func WithLimit(limit int) Option {
 	return func(c *htmlCodec) {
 		c.limit = limit
@@ -93,105 +271,532 @@ func WithLimit(limit int) Option {
 
 // Write writes the scanned rows as an HTML table to the provided writer.
 // It supports headers, NULL styling, row limits, and optional preprocessing.
+// Values are formatted with the configured tostring.Converter and HTML-escaped,
+// except for the results of WithRawHTMLType.
+// The preprocessor receives the cells of the visible columns in table order.
 func (c *htmlCodec) Write(rows scanner.Rows, writer io.Writer) error {
-	cols, err := rows.Columns()
+	writer = charset.NewWriter(writer, c.charset, charset.CharacterReference)
+	srcCols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	fields, err := c.visibleColumns(srcCols)
+	if err != nil {
+		return err
+	}
+	cols := make([]scanner.Column, len(fields))
+	for i, field := range fields {
+		cols[i] = srcCols[field]
+	}
+	rules, err := c.resolveRules(srcCols, fields)
+	if err != nil {
+		return err
+	}
+	charts, err := c.newChartData(srcCols, fields)
 	if err != nil {
 		return err
 	}
 
-	if c.writeHeader && c.writeHeaderNoData && len(cols) != 0 {
-		writer.Write([]byte(htmlPrefix))
-		writer.Write([]byte(`<thead style="position:sticky;top:0;z-index:99;background:#f9f9f9;">`))
-		for _, col := range cols {
-			writer.Write(fmt.Appendf(nil, "<th><p>%s</p><p class=typ>%s</p></th>",
-				col.Name(), strings.ToLower(col.DatabaseTypeName())))
+	src := rowiter.New(rows)
+	var it rowIterator = src
+	var header []string // The statistics under the header cells, if enabled.
+	if c.columnStats {
+		n := c.statsSample
+		if c.limit >= 0 {
+			n = min(n, c.limit)
 		}
-		writer.Write([]byte(`</thead>`))
+		sampled, stats, complete, err := sample(src, len(srcCols), n)
+		if err != nil {
+			return err
+		}
+		header = make([]string, len(cols))
+		for i, field := range fields {
+			header[i] = c.statsParagraph(&stats[field], len(sampled.rows), complete)
+		}
+		it = sampled
+	}
+
+	if c.writeHeader && c.writeHeaderNoData && len(cols) != 0 {
+		c.writeTableHeader(writer, rows, cols, header)
 	}
 
-	rowID := 1
+	counter := rowcounter.New(rows, c.limit)
 	defer func() {
-		if rowID != 1 {
+		if counter.Written() != 0 {
 			writer.Write([]byte(`</tbody>`))
-			writer.Write([]byte(`</table></body></html>`))
+			c.writeSummary(writer, rows, len(cols), counter.Written())
+			c.writeEnd(writer, charts)
 		} else if c.writeHeader && c.writeHeaderNoData && len(cols) != 0 {
-			writer.Write([]byte(`</table></body></html>`))
+			c.writeSummary(writer, rows, len(cols), 0)
+			c.writeEnd(writer, nil)
 		}
 	}()
 
-	if c.limit == 0 {
+	if counter.Done() {
 		return nil
 	}
 
-	for rows.Next() {
-		values, err := rows.ScanRow()
+	cellTags := make([]string, len(cols))
+	for i, col := range cols {
+		cellTags[i] = "<td" + c.classAttr(col) + ">"
+	}
+	driver := rows.Driver()
+	nullValue, styleNull := c.nullValue, true
+	if scanner.NullPolicyOf(rows) == scanner.NullEmpty {
+		nullValue, styleNull = "", false
+	}
+	mappers := typecache.New(c.customMapper, len(cols))
+	buf := rowbuf.Get()
+	defer rowbuf.Put(buf)
+	var row []string
+	var out []byte
+	var rowStyle highlight.Style
+	cellStyles := make([]highlight.Style, len(cols))
+	raw := make([]bool, len(cols)) // Whether the cells hold markup of WithRawHTMLType.
+	for it.Next() {
+		values, err := it.ScanRow()
 		if err != nil {
 			return err
 		}
-		row := make([]string, len(values))
-		for i := range values {
+		rowID := counter.Scan()
+		buf.Reset()
+		for i, field := range fields {
+			v := values[field]
+			if b, ok := v.(scanner.Blob); ok {
+				// Blobs cannot be streamed into HTML cells and are read fully.
+				if v, err = io.ReadAll(b); err != nil {
+					return err
+				}
+			}
 			meta := scanner.Metadata{
 				RowID:  rowID,
-				Driver: rows.Driver(),
+				Driver: driver,
 				Column: cols[i],
 			}
-			row[i] = c.toString(values[i], meta)
+			meta.Raw, _ = it.RawValue(field)
+			mapper, _ := mappers.Lookup(i, v)
+			raw[i] = v != nil && mapper.raw
+			c.appendCell(buf, v, mapper.fn, meta, nullValue)
+		}
+		if c.preProcessorFunc != nil {
+			// The preprocessor may retain the row, so it is not reused.
+			row = nil
+		}
+		row = buf.Strings(row)
+		if len(rules) != 0 {
+			// Rules test the cells before preprocessing.
+			rowStyle = c.matchRules(rules, values, row, buf, cellStyles)
 		}
 
 		writeRow := true
 		if c.preProcessorFunc != nil {
 			row, writeRow = c.preProcessorFunc(rowID, row)
 		}
-		if writeRow {
-			if c.writeHeader && rowID == 1 && !c.writeHeaderNoData {
-				writer.Write([]byte(htmlPrefix))
-				writer.Write([]byte(`<thead style="position:sticky;top:0;z-index:99;background:#f9f9f9;">`))
-				for _, col := range cols {
-					writer.Write(fmt.Appendf(nil, "<th><p>%s</p><p class=typ>%s</p></th>",
-						col.Name(), strings.ToLower(col.DatabaseTypeName())))
-				}
-				writer.Write([]byte(`</thead>`))
+		if !writeRow {
+			continue
+		}
+		if counter.Written() == 0 {
+			if c.writeHeader && !c.writeHeaderNoData {
+				c.writeTableHeader(writer, rows, cols, header)
+			}
+			writer.Write([]byte(`<tbody>`))
+		}
+		out = append(out[:0], `<tr>`...)
+		for i := range row {
+			tag := "<td>"
+			if i < len(cellTags) {
+				tag = cellTags[i]
+			}
+			if len(rules) != 0 {
+				tag = styledTag(tag, rowStyle, cellStyles, i)
+			}
+			out = append(out, tag...)
+			if styleNull && buf.IsNULL(i) && row[i] == nullValue {
+				out = append(out, `<span class="null">`...)
+				out = append(out, html.EscapeString(row[i])...)
+				out = append(out, `</span></td>`...)
+				continue
+			}
+			if i < len(raw) && raw[i] {
+				out = append(out, row[i]...)
+			} else {
+				out = append(out, html.EscapeString(row[i])...)
+			}
+			out = append(out, `</td>`...)
+		}
+		out = append(out, `</tr>`...)
+		writer.Write(out)
+		if charts != nil {
+			charts.add(values)
+		}
+		if counter.Write() {
+			return nil
+		}
+	}
+
+	return it.Err()
+}
+
+// WithRules highlights cells and rows with conditional formatting rules created
+// with highlight.WhenColumn. Rules are applied as inline styles, so they also work
+// in fragments. The conditions test the formatted cells before preprocessing and
+// may refer to hidden columns.
+func WithRules(rules ...highlight.Rule) Option {
+	return func(c *htmlCodec) {
+		c.rules = append(c.rules, rules...)
+	}
+}
+
+// cellRule is a rule resolved against the columns of the table.
+type cellRule struct {
+	highlight.Rule
+	field int // The index of the source column tested by the rule.
+	pos   int // The index of the column in the table, -1 if hidden.
+}
+
+// resolveRules resolves the columns of the rules. fields are the indexes of the
+// source columns shown in the table.
+func (c *htmlCodec) resolveRules(cols []scanner.Column, fields []int) ([]cellRule, error) {
+	if len(c.rules) == 0 {
+		return nil, nil
+	}
+	rules := make([]cellRule, len(c.rules))
+	for k, rule := range c.rules {
+		field := slices.IndexFunc(cols, func(col scanner.Column) bool { return col.Name() == rule.Column })
+		if field < 0 {
+			return nil, fmt.Errorf("htmlcodec: unknown column %q", rule.Column)
+		}
+		rules[k] = cellRule{Rule: rule, field: field, pos: slices.Index(fields, field)}
+	}
+	return rules, nil
+}
+
+// matchRules tests the rules against a row and sets the styles of its cells in
+// cellStyles. It returns the style of the row.
+func (c *htmlCodec) matchRules(rules []cellRule, values []any, row []string, buf *rowbuf.Row, cellStyles []highlight.Style) highlight.Style {
+	clear(cellStyles)
+	var rowStyle highlight.Style
+	for _, rule := range rules {
+		v := highlight.Value{Raw: values[rule.field]}
+		if rule.pos >= 0 {
+			v.Text, v.IsNULL = row[rule.pos], buf.IsNULL(rule.pos)
+		} else {
+			s := c.converter.ToString(v.Raw)
+			v.Text, v.IsNULL = s.String, s.IsNULL
+		}
+		switch {
+		case !rule.Match(v):
+		case rule.Row:
+			rowStyle = rowStyle.Merge(rule.Style)
+		case rule.pos >= 0:
+			cellStyles[rule.pos] = cellStyles[rule.pos].Merge(rule.Style)
+		}
+	}
+	return rowStyle
+}
+
+// styledTag adds the style of the i-th cell of a row to its opening tag.
+func styledTag(tag string, rowStyle highlight.Style, cellStyles []highlight.Style, i int) string {
+	style := rowStyle
+	if i < len(cellStyles) {
+		style = style.Merge(cellStyles[i])
+	}
+	if style.IsZero() {
+		return tag
+	}
+	return tag[:len(tag)-1] + ` style="` + html.EscapeString(style.CSS()) + `">`
+}
+
+// visibleColumns returns the indexes of the source columns shown in the table, in table order.
+func (c *htmlCodec) visibleColumns(cols []scanner.Column) ([]int, error) {
+	index := make(map[string]int, len(cols))
+	for i, col := range cols {
+		if _, ok := index[col.Name()]; !ok {
+			index[col.Name()] = i
+		}
+	}
+	hidden := make(map[string]bool, len(c.hiddenColumns))
+	for _, name := range c.hiddenColumns {
+		hidden[name] = true
+	}
+	var fields []int
+	if c.columns != nil {
+		for _, name := range c.columns {
+			i, ok := index[name]
+			if !ok {
+				return nil, fmt.Errorf("htmlcodec: unknown column %q", name)
 			}
-			if rowID == 1 {
-				writer.Write([]byte(`<tbody>`))
+			fields = append(fields, i)
+		}
+	} else {
+		for i := range cols {
+			fields = append(fields, i)
+		}
+	}
+	if len(c.columnOrder) != 0 {
+		first := make([]int, 0, len(fields))
+		moved := make(map[int]bool, len(c.columnOrder))
+		for _, name := range c.columnOrder {
+			i, ok := index[name]
+			if !ok {
+				return nil, fmt.Errorf("htmlcodec: unknown column %q", name)
 			}
-			writer.Write([]byte(`<tr>`))
-			for i := range row {
-				writer.Write(fmt.Appendf(nil, "<td>%s</td>", row[i]))
+			if !moved[i] && slices.Contains(fields, i) {
+				first = append(first, i)
+				moved[i] = true
 			}
-			writer.Write([]byte(`</tr>`))
-			if c.limit >= 0 && rowID >= c.limit {
-				return nil
+		}
+		for _, i := range fields {
+			if !moved[i] {
+				first = append(first, i)
 			}
-			rowID++
+		}
+		fields = first
+	}
+	return slices.DeleteFunc(fields, func(i int) bool { return hidden[cols[i].Name()] }), nil
+}
+
+// writeTableHeader writes the document prefix, the metadata block if enabled and
+// the table header with column names and types, followed by the statistics of
+// the columns if not nil.
+func (c *htmlCodec) writeTableHeader(writer io.Writer, rows scanner.Rows, cols []scanner.Column, stats []string) {
+	if !c.fragment {
+		writer.Write([]byte(c.documentPrefix()))
+	}
+	if c.metadataBlock {
+		c.writeMetadata(writer, rows)
+	}
+	writer.Write([]byte(htmlTable))
+	writer.Write([]byte(`<thead style="position:sticky;top:0;z-index:99;background:#f9f9f9;">`))
+	for i, col := range cols {
+		var colStats string
+		if i < len(stats) {
+			colStats = stats[i]
+		}
+		writer.Write(fmt.Appendf(nil, "<th%s%s><p>%s</p><p class=typ>%s</p>%s</th>", c.classAttr(col), titleAttr(col),
+			html.EscapeString(col.Name()), html.EscapeString(strings.ToLower(col.DatabaseTypeName())), colStats))
+	}
+	writer.Write([]byte(`</thead>`))
+}
+
+// titleAttr returns the title attribute showing the comment of col as a tooltip,
+// or an empty string if the column has no comment.
+func titleAttr(col scanner.Column) string {
+	info, ok := scanner.Info(col)
+	if !ok || info.Comment == "" {
+		return ""
+	}
+	return ` title="` + html.EscapeString(info.Comment) + `"`
+}
+
+// writeMetadata writes the metadata of rows as a description list.
+func (c *htmlCodec) writeMetadata(writer io.Writer, rows scanner.Rows) {
+	metadata := scanner.Describe(rows)
+	if metadata == nil {
+		metadata = map[string]string{"driver": rows.Driver()}
+	}
+	out := []byte(`<dl class="metadata">`)
+	for _, key := range slices.Sorted(maps.Keys(metadata)) {
+		out = fmt.Appendf(out, "<dt>%s</dt><dd>%s</dd>", html.EscapeString(key), html.EscapeString(metadata[key]))
+	}
+	out = append(out, `</dl>`...)
+	writer.Write(out)
+}
+
+// writeSummary writes the row count footer if enabled.
+func (c *htmlCodec) writeSummary(writer io.Writer, rows scanner.Rows, columns, written int) {
+	if !c.rowCountSummary {
+		return
+	}
+	var total int64
+	if estimate, ok := scanner.EstimateRows(rows); ok && estimate > int64(written) {
+		total = estimate
+	}
+	summary := fmt.Sprintf("%d rows", written)
+	switch {
+	case c.locale != nil:
+		summary = c.locale.RowCount(int64(written), total)
+	case total > 0:
+		summary = fmt.Sprintf("%d of %d rows", written, total)
+	}
+	writer.Write(fmt.Appendf(nil, `<tfoot><tr><td class="summary" colspan="%d">%s</td></tr></tfoot>`, max(columns, 1), html.EscapeString(summary)))
+}
+
+// appendCell converts a value and appends it to buf, using the custom mapper fn
+// if not nil, or falling back to the configured converter. NULL values are appended
+// as nullValue and flagged in buf, so that they can be styled when written.
+func (c *htmlCodec) appendCell(buf *rowbuf.Row, v any, fn func(any, scanner.Metadata) tostring.String, metadata scanner.Metadata, nullValue string) {
+	if v != nil && fn != nil {
+		buf.AppendString(fn(v, metadata), nullValue)
+		return
+	}
+	if b, ok := v.(bool); ok && c.boolSymbols {
+		buf.AppendString(tostring.String{String: boolSymbol[b]}, nullValue)
+		return
+	}
+	buf.AppendValue(c.converter, v, metadata.Driver, metadata.DatabaseTypeName(), nullValue)
+}
+
+// boolSymbol maps boolean values to the symbols rendered with WithBoolSymbols.
+var boolSymbol = map[bool]string{true: "✓", false: "✗"}
+
+// classAttr returns the class attribute of the header and the cells of col, or
+// an empty string if the column is not styled by type.
+func (c *htmlCodec) classAttr(col scanner.Column) string {
+	if !c.typeAlignment {
+		return ""
+	}
+	switch {
+	case scanner.ColumnKind(col).IsNumeric():
+		return ` class="num"`
+	case isIdentifier(col):
+		return ` class="id"`
+	}
+	return ""
+}
+
+// isIdentifier reports whether col holds identifiers.
+func isIdentifier(col scanner.Column) bool {
+	if scanner.ColumnKind(col) == scanner.KindUUID {
+		return true
+	}
+	name := strings.ToLower(col.Name())
+	return name == "id" || strings.HasSuffix(name, "_id")
+}
+
+// typeCSS holds the style rules of the classes set by WithTypeAlignment.
+const typeCSS = `.num { text-align: right; font-variant-numeric: tabular-nums; } .id { font-family: monospace; }`
+
+// stickyCSS holds the style rules set by WithStickyFirstColumn. The cells get an
+// opaque background so that scrolled content does not show through.
+const stickyCSS = `tbody td:first-child, thead th:first-child { position: sticky; left: 0; z-index: 1; } ` +
+	`tbody td:first-child { background: #ffffff; } thead th:first-child { background: #f9f9f9; }`
+
+// metadataCSS holds the style rules of the block written by WithMetadataBlock.
+const metadataCSS = `dl.metadata { display: grid; grid-template-columns: max-content auto; gap: 4px 15px; ` +
+	`padding: 15px; color: #333; } dl.metadata dt { font-weight: bold; }`
+
+// WithCharset writes the document in a legacy charset such as charset.Windows1252
+// or charset.ShiftJIS instead of UTF-8 (default) and declares it in the meta tag.
+// Characters the charset cannot encode are written as character references.
+func WithCharset(cs *charset.Charset) Option {
+	return func(c *htmlCodec) {
+		c.charset = cs
+	}
+}
+
+// WithLocale renders the table for readers of the language of l, e.g.
+// locale.German: booleans as its words, NULL values as its NULL text, numbers with
+// its separators, the row count of WithRowCountSummary in its words, and the
+// language declared on the document. It replaces the converter and NULL text set
+// by earlier options; pass options such as WithCustomNULL after it to override
+// single words, and use l.ConverterOptions to build a converter with further options.
+func WithLocale(l *locale.Locale) Option {
+	return func(c *htmlCodec) {
+		c.locale = l
+		if l != nil {
+			c.converter = tostring.New(l.ConverterOptions()...)
+			c.nullValue = l.NULLText()
 		}
 	}
+}
 
-	return rows.Err()
+// TrailerLine implements codec.Trailer with an HTML comment.
+func (c *htmlCodec) TrailerLine(text string) (string, bool) {
+	return "<!-- " + text + " -->\n", true
 }
 
-// toString converts a value to a string using a custom mapper if available,
-// or falls back to default conversion logic. Returns nullValue if the value is considered NULL.
-func (c *htmlCodec) toString(v any, metadata scanner.Metadata) string {
-	if v == nil {
-		return c.nullValue
+// WithFragment writes only the table, without the document around it, so that it
+// can be embedded into a larger page such as a report. The page must include the
+// style returned by Stylesheet for the same options.
+func WithFragment(fragment bool) Option {
+	return func(c *htmlCodec) {
+		c.fragment = fragment
+	}
+}
+
+// Stylesheet returns the CSS of the documents written by a codec with opts: the
+// default style, the optional styles, the selected themes and the extra CSS.
+func Stylesheet(opts ...Option) string {
+	return New(opts...).style()
+}
+
+// documentPrefix returns the beginning of the HTML document up to the body, with
+// the charset and the style.
+func (c *htmlCodec) documentPrefix() string {
+	if len(c.themes) == 0 && c.extraCSS == "" && !c.typeAlignment && !c.stickyColumn && !c.metadataBlock && !c.columnStats &&
+		c.charset.IsUTF8() && c.locale == nil {
+		return htmlDocument
+	}
+	head := htmlHead
+	if !c.charset.IsUTF8() {
+		head = strings.Replace(head, `charset="utf-8"`, `charset="`+c.charset.Name()+`"`, 1)
+	}
+	if c.locale != nil && c.locale.Tag != "" {
+		head = strings.Replace(head, `<html>`, `<html lang="`+html.EscapeString(c.locale.Tag)+`">`, 1)
+	}
+	return head + c.style() + htmlBody
+}
+
+// writeEnd writes the end of the table, the charts if any and, unless writing a
+// fragment, the end of the document.
+func (c *htmlCodec) writeEnd(writer io.Writer, charts *chartData) {
+	writer.Write([]byte(`</table>`))
+	if charts != nil {
+		charts.write(writer)
+	}
+	if !c.fragment {
+		writer.Write([]byte(`</body></html>`))
 	}
-	if fn, ok := c.customMapper[reflect.TypeOf(v)]; ok {
-		s := fn(v, metadata)
-		if s.IsNULL {
-			return c.nullValue
+}
+
+// style returns the default style followed by the optional styles, the selected
+// themes and the extra CSS.
+func (c *htmlCodec) style() string {
+	var b strings.Builder
+	b.WriteString(htmlStyle)
+	if c.typeAlignment {
+		b.WriteString(" ")
+		b.WriteString(typeCSS)
+	}
+	if c.stickyColumn {
+		b.WriteString(" ")
+		b.WriteString(stickyCSS)
+	}
+	if c.metadataBlock {
+		b.WriteString(" ")
+		b.WriteString(metadataCSS)
+	}
+	if c.columnStats {
+		b.WriteString(" ")
+		b.WriteString(statsCSS)
+	}
+	for _, theme := range c.themes {
+		if css, ok := themeCSS[theme]; ok {
+			b.WriteString(" ")
+			b.WriteString(strings.Join(strings.Fields(css), " "))
 		}
-		return s.String
 	}
-	s := tostring.ToString(v)
-	if s.IsNULL {
-		return c.nullValue
+	if c.extraCSS != "" {
+		// The CSS must not close the style element.
+		b.WriteString(" ")
+		b.WriteString(strings.ReplaceAll(c.extraCSS, "</", `<\/`))
 	}
-	return s.String
+	return b.String()
 }
 
-// htmlPrefix defines the beginning of the HTML document including styles and table structure.
-var htmlPrefix = strings.Join(strings.Fields(`<!DOCTYPE html><html><head><meta charset="utf-8"><title>Go Export</title><style>
+// htmlHead and htmlBody enclose the styles of the document; htmlTable starts the table.
+const (
+	htmlHead  = `<!DOCTYPE html><html><head><meta charset="utf-8"><title>Go Export</title><style> `
+	htmlBody  = ` </style> </head><body>`
+	htmlTable = `<table style="width:100%;border-spacing:0px;">`
+)
+
+// htmlDocument defines the beginning of the HTML document with the default style.
+var htmlDocument = htmlHead + htmlStyle + htmlBody
+
+// htmlStyle is the default style of the document.
+var htmlStyle = strings.Join(strings.Fields(`
 	body, html {
 	  margin: 0;
 	  padding: 0;
@@ -224,4 +829,11 @@ var htmlPrefix = strings.Join(strings.Fields(`<!DOCTYPE html><html><head><meta c
 	  margin-top: 5px;
 	  color: #333;
 	}
-	</style> </head><body><table style="width:100%;border-spacing:0px;">`), " ")
+	span.null {
+	  color: #aaaaaa;
+	}
+	td.summary {
+	  color: #333;
+	  border-right: 0px solid red;
+	}
+	`), " ")
@@ -0,0 +1,351 @@
+package htmlcodec
+
+import (
+	"bytes"
+	"html"
+	"html/template"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-data-exporter/exporter/charset"
+	"github.com/go-data-exporter/exporter/highlight"
+	"github.com/go-data-exporter/exporter/locale"
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+func TestWriteEscapesValues(t *testing.T) {
+	data := [][]any{{1, "<b>x</b>", nil}}
+	var buf bytes.Buffer
+	if err := New().Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	if !strings.Contains(output, "<td>&lt;b&gt;x&lt;/b&gt;</td>") {
+		t.Errorf("value not escaped: %s", output)
+	}
+	if !strings.Contains(output, `<td><span class="null">[NULL]</span></td>`) {
+		t.Errorf("NULL not styled: %s", output)
+	}
+}
+
+// link is a value rendered as a link by a raw HTML mapper.
+type link struct {
+	URL, Text string
+}
+
+func TestWithRawHTMLType(t *testing.T) {
+	data := [][]any{{link{URL: "https://example.com/?a=1&b=2", Text: "<home>"}, "<i>x</i>"}}
+	toLink := func(l link, _ scanner.Metadata) template.HTML {
+		return template.HTML(`<a href="` + html.EscapeString(l.URL) + `">` + html.EscapeString(l.Text) + `</a>`)
+	}
+	toTag := func(s string, _ scanner.Metadata) tostring.String {
+		return tostring.String{String: "<" + s + ">"}
+	}
+	var buf bytes.Buffer
+	if err := New(WithRawHTMLType(toLink), WithCustomType(toTag)).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	if !strings.Contains(output, `<td><a href="https://example.com/?a=1&amp;b=2">&lt;home&gt;</a></td>`) {
+		t.Errorf("raw HTML not written as markup: %s", output)
+	}
+	if !strings.Contains(output, `<td>&lt;&lt;i&gt;x&lt;/i&gt;&gt;</td>`) {
+		t.Errorf("custom type output not escaped: %s", output)
+	}
+}
+
+func TestWithCustomNULL(t *testing.T) {
+	data := [][]any{{nil, "[NULL]"}}
+	var buf bytes.Buffer
+	if err := New(WithCustomNULL("<none>")).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	if !strings.Contains(output, `<span class="null">&lt;none&gt;</span>`) {
+		t.Errorf("custom NULL not rendered: %s", output)
+	}
+	if !strings.Contains(output, "<td>[NULL]</td>") {
+		t.Errorf("string value rendered as NULL: %s", output)
+	}
+}
+
+func TestWithToString(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	data := [][]any{{ts, true}}
+	var buf bytes.Buffer
+	c := New(WithToString(tostring.New(tostring.WithTimeLayout(time.DateTime), tostring.WithBoolLiterals("yes", "no"))))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	if !strings.Contains(output, "<td>2024-01-02 03:04:05</td><td>yes</td>") {
+		t.Errorf("converter not applied: %s", output)
+	}
+}
+
+func TestWithRowCountSummary(t *testing.T) {
+	data := [][]any{{1, "a"}, {2, "b"}, {3, "c"}}
+	var buf bytes.Buffer
+	if err := New(WithRowCountSummary(true), WithLimit(2)).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `<tfoot><tr><td class="summary" colspan="2">2 of 3 rows</td></tr></tfoot></table>`) {
+		t.Errorf("summary not rendered: %s", buf.String())
+	}
+}
+
+func TestColumnSelection(t *testing.T) {
+	data := [][]any{{1, "alice", "a@example.com", 30}}
+	for _, tc := range []struct {
+		opts []Option
+		want string
+	}{
+		{[]Option{WithHiddenColumns("column_0")}, "<td>alice</td><td>a@example.com</td><td>30</td>"},
+		{[]Option{WithColumns("column_2", "column_1")}, "<td>a@example.com</td><td>alice</td>"},
+		{[]Option{WithColumnOrder("column_3", "column_1")}, "<td>30</td><td>alice</td><td>1</td><td>a@example.com</td>"},
+		{[]Option{WithColumnOrder("column_3"), WithHiddenColumns("column_0", "missing")}, "<td>30</td><td>alice</td><td>a@example.com</td>"},
+	} {
+		var buf bytes.Buffer
+		if err := New(tc.opts...).Write(scanner.FromData(data), &buf); err != nil {
+			t.Fatal(err)
+		}
+		output := buf.String()
+		if !strings.Contains(output, "<tr>"+tc.want+"</tr>") {
+			t.Errorf("expected row %s: %s", tc.want, output)
+		}
+		if got, want := strings.Count(output, "<th>"), strings.Count(tc.want, "<td>"); got != want {
+			t.Errorf("got %d header cells, want %d", got, want)
+		}
+	}
+	if err := New(WithColumns("missing")).Write(scanner.FromData(data), io.Discard); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+}
+
+func TestWithTheme(t *testing.T) {
+	data := [][]any{{1}}
+	var buf bytes.Buffer
+	c := New(WithTheme(ThemeDark, ThemeStriped), WithExtraCSS("td { color: red; } </style><script>"))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	dark := strings.Index(output, "background: #1e1e1e;")
+	striped := strings.Index(output, "tbody tr:nth-child(even)")
+	extra := strings.Index(output, "td { color: red; }")
+	if dark < 0 || striped < dark || extra < striped || extra > strings.Index(output, "</style>") {
+		t.Errorf("themes not applied in order: %s", output)
+	}
+	if strings.Count(output, "</style>") != 1 {
+		t.Errorf("extra CSS closes the style element: %s", output)
+	}
+}
+
+func TestWithTypeAlignment(t *testing.T) {
+	data := [][]any{{"a1b2", 1.5, true, nil}}
+	var buf bytes.Buffer
+	c := New(WithTypeAlignment(true), WithBoolSymbols(true))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	if !strings.Contains(output, `<tr><td>a1b2</td><td class="num">1.5</td><td>✓</td><td><span class="null">[NULL]</span></td></tr>`) {
+		t.Errorf("cells not styled: %s", output)
+	}
+	if !strings.Contains(output, `<th class="num"><p>column_1</p>`) || !strings.Contains(output, ".num { text-align: right;") {
+		t.Errorf("header not styled: %s", output)
+	}
+
+	buf.Reset()
+	if err := c.Write(scanner.FromCSV(strings.NewReader("user_id,name\n42,alice\n")), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `<tr><td class="id">42</td><td>alice</td></tr>`) {
+		t.Errorf("identifier not styled: %s", buf.String())
+	}
+}
+
+func TestWithStickyFirstColumn(t *testing.T) {
+	data := [][]any{{1, "a"}}
+	var buf bytes.Buffer
+	if err := New(WithStickyFirstColumn(true), WithTheme(ThemeDark)).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	sticky := strings.Index(output, "position: sticky; left: 0;")
+	dark := strings.Index(output, "tbody td:first-child { background: #1e1e1e; }")
+	if sticky < 0 || dark < sticky {
+		t.Errorf("sticky column style missing or not overridden by theme: %s", output)
+	}
+}
+
+func TestWithMetadataBlock(t *testing.T) {
+	data := [][]any{{1}}
+	var buf bytes.Buffer
+	if err := New(WithMetadataBlock(true)).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `<body><dl class="metadata"><dt>driver</dt><dd>go-slice</dd></dl><table`) {
+		t.Errorf("metadata block not rendered: %s", buf.String())
+	}
+}
+
+func TestColumnCommentTooltip(t *testing.T) {
+	rows := scanner.WithColumnInfo(scanner.FromData([][]any{{1, "a"}}),
+		map[string]scanner.ColumnInfo{"column_1": {Comment: `"Name" & title`}})
+	var buf bytes.Buffer
+	if err := New().Write(rows, &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	if !strings.Contains(output, `<th title="&#34;Name&#34; &amp; title"><p>column_1</p>`) {
+		t.Errorf("comment tooltip not rendered: %s", output)
+	}
+	if !strings.Contains(output, `<th><p>column_0</p>`) {
+		t.Errorf("tooltip rendered without a comment: %s", output)
+	}
+}
+
+func FuzzWrite(f *testing.F) {
+	for seed := range uint64(8) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed uint64) {
+		var buf bytes.Buffer
+		if err := New().Write(scanner.Fuzz(seed), &buf); err != nil {
+			t.Fatal(err)
+		}
+		if rows := strings.Count(buf.String(), "<tr>"); rows != 64 {
+			t.Fatalf("got %d table rows, want 64", rows)
+		}
+	})
+}
+
+func TestWithCharset(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New(WithCharset(charset.Windows1252)).Write(scanner.FromData([][]any{{"café 日"}}), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	if !strings.Contains(output, `<meta charset="windows-1252">`) {
+		t.Errorf("charset not declared: %s", output)
+	}
+	if !strings.Contains(output, "<td>caf\xe9 &#26085;</td>") {
+		t.Errorf("value not encoded: %q", output)
+	}
+}
+
+func TestWithFragment(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New(WithFragment(true)).Write(scanner.FromData([][]any{{"a"}}), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	if !strings.HasPrefix(output, "<table") || !strings.HasSuffix(output, "</table>") {
+		t.Errorf("expected a bare table, got %s", output)
+	}
+	if css := Stylesheet(WithTheme(ThemeDark)); !strings.HasPrefix(css, htmlStyle) || css == htmlStyle {
+		t.Errorf("stylesheet should extend the default style with the theme, got %s", css)
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	var buf bytes.Buffer
+	c := New(WithLocale(locale.German), WithRowCountSummary(true))
+	if err := c.Write(scanner.FromData([][]any{{1234.5, true, nil}}), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	for _, want := range []string{`<html lang="de">`, `<td>1.234,5</td>`, `<td>wahr</td>`, `[kein Wert]`, `1 Zeile`} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output does not contain %s: %s", want, output)
+		}
+	}
+}
+
+func TestWithRules(t *testing.T) {
+	data := [][]any{{1, "OK", 10}, {2, "FAILED", 250}}
+	var buf bytes.Buffer
+	c := New(WithHiddenColumns("column_2"), WithRules(
+		highlight.WhenColumn("column_1").Equals("FAILED").StyleRow(highlight.Red),
+		highlight.WhenColumn("column_2").GreaterThan(100).StyleRow(highlight.Style{Bold: true}),
+		highlight.WhenColumn("column_0").Equals("1").Style(highlight.Green),
+	))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	for _, want := range []string{
+		`<tr><td style="color:#006100;background-color:#c6efce">1</td><td>OK</td></tr>`,
+		`<tr><td style="color:#9c0006;background-color:#ffc7ce;font-weight:bold">2</td>`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output does not contain %s: %s", want, output)
+		}
+	}
+
+	c = New(WithRules(highlight.WhenColumn("missing").IsNULL().Style(highlight.Red)))
+	if err := c.Write(scanner.FromData(data), io.Discard); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+}
+
+func TestCharts(t *testing.T) {
+	data := [][]any{{"a", 1, 2.5}, {"b", 3, nil}, {"c", 2, -1.0}}
+	var buf bytes.Buffer
+	c := New(WithSparklines(true), WithChart(ChartBar, "column_1", "column_2"), WithChart(ChartLine, "column_1"))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	if !strings.Contains(output, `</table><section class="charts"`) || !strings.HasSuffix(output, `</section></body></html>`) {
+		t.Fatalf("charts section not after the table: %s", output)
+	}
+	if n := strings.Count(output, "<svg "); n != 4 {
+		t.Errorf("got %d charts, want 2 sparklines and 2 charts", n)
+	}
+	if n := strings.Count(output, "<rect "); n != 5 {
+		t.Errorf("got %d bars, want 5 for the non-NULL values", n)
+	}
+	if !strings.Contains(output, `<polyline fill="none" stroke="#4e79a7" stroke-width="1.5" points="0.00,24.00 60.00,0.00 120.00,12.00"/>`) {
+		t.Errorf("unexpected sparkline: %s", output)
+	}
+
+	if err := New(WithChart(ChartLine, "missing")).Write(scanner.FromData(data), io.Discard); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+}
+
+func TestWithColumnStats(t *testing.T) {
+	data := [][]any{{1, "b"}, {5, nil}, {3, "a"}, {3, "c"}}
+	var buf bytes.Buffer
+	if err := New(WithColumnStats(true)).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	for _, want := range []string{
+		`<p class=stats>min 1 · max 5 · 0% null · 3 distinct</p></th>`,
+		`<p class=stats>min a · max c · 25% null · 3 distinct</p></th>`,
+		`<tr><td>1</td><td>b</td></tr>`,
+		`<tr><td>3</td><td>c</td></tr>`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output does not contain %s: %s", want, output)
+		}
+	}
+
+	buf.Reset()
+	if err := New(WithColumnStats(true), WithColumnStatsSample(2)).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output = buf.String()
+	if !strings.Contains(output, `<p class=stats title="Statistics of the first 2 rows">min 1 · max 5 · 0% null · 2 distinct</p>`) {
+		t.Errorf("unexpected sampled statistics: %s", output)
+	}
+	if n := strings.Count(output, "<tr>"); n != 4 {
+		t.Errorf("got %d rows, want 4", n)
+	}
+}
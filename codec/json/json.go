@@ -5,11 +5,21 @@
 package jsoncodec
 
 import (
+	"bytes"
+	"errors"
 	"io"
+	"maps"
+	"math"
 	"reflect"
+	"slices"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 
+	"github.com/go-data-exporter/exporter/internal/blob"
+	"github.com/go-data-exporter/exporter/internal/rowcounter"
+	"github.com/go-data-exporter/exporter/internal/rowiter"
+	"github.com/go-data-exporter/exporter/internal/typecache"
 	"github.com/go-data-exporter/exporter/scanner"
 )
 
@@ -24,7 +34,12 @@ type jsonCodec struct {
 	customMapper     map[reflect.Type]func(any, scanner.Metadata) any
 	preProcessorFunc func(rowID int, row map[string]any) (map[string]any, bool)
 	newlineDelimited bool
+	schemaRecord     bool
+	headerRecord     bool
+	rowsAsArrays     bool
+	batchSize        int
 	limit            int
+	appendMode       bool
 }
 
 // New creates a new JSON codec with the provided configuration options.
@@ -41,6 +56,8 @@ func New(opts ...Option) *jsonCodec {
 
 // WithPreProcessorFunc sets a function to transform or filter each row before writing.
 // The function can modify the row contents or skip the row entirely.
+// Row IDs number the written rows starting from 1, so a skipped row passes its ID on to the next row.
+// Streamed scanner.Blob values appear as opaque placeholders that are replaced when written.
 func WithPreProcessorFunc(fn func(rowID int, row map[string]any) (map[string]any, bool)) Option {
 	return func(c *jsonCodec) {
 		c.preProcessorFunc = fn
@@ -54,6 +71,46 @@ func WithNewlineDelimited(isNewlineDelimited bool) Option {
 	}
 }
 
+// WithSchemaRecord writes a first line describing the columns, e.g.
+// {"columns":[{"name":"id","type":"INT"}]}, before the rows.
+// It only applies to newline-delimited JSON.
+func WithSchemaRecord(schemaRecord bool) Option {
+	return func(c *jsonCodec) {
+		c.schemaRecord = schemaRecord
+	}
+}
+
+// WithHeaderRecord writes a first line holding the column names, e.g.
+// {"_type":"header","columns":["id","name"]}, so that consumers can route and
+// validate the stream before reading the rows. It is written before the schema
+// record if both are enabled. It only applies to newline-delimited JSON.
+func WithHeaderRecord(headerRecord bool) Option {
+	return func(c *jsonCodec) {
+		c.headerRecord = headerRecord
+	}
+}
+
+// WithBatchSize groups rows into JSON arrays of up to batchSize rows, one array per line,
+// as required by bulk ingestion endpoints. A non-positive value writes one row per line
+// (default). It only applies to newline-delimited JSON.
+func WithBatchSize(batchSize int) Option {
+	return func(c *jsonCodec) {
+		c.batchSize = batchSize
+	}
+}
+
+// WithRowsAsArrays writes every row as a JSON array of values in column order
+// instead of an object, which considerably reduces the output size of wide tables.
+// The column names are written once: a standard JSON document becomes an object
+// {"columns":["id","name"],"rows":[[1,"a"],[2,"b"]]}, while newline-delimited JSON
+// starts with a line holding the array of column names, or with the header or schema
+// record if enabled by WithHeaderRecord or WithSchemaRecord. Keys added by a preprocessor are ignored.
+func WithRowsAsArrays(rowsAsArrays bool) Option {
+	return func(c *jsonCodec) {
+		c.rowsAsArrays = rowsAsArrays
+	}
+}
+
 // WithCustomType registers a custom mapping function to convert a specific Go type
 // to its JSON representation, using optional metadata.
 func WithCustomType[T any](fn func(v T, metadata scanner.Metadata) any) Option {
@@ -69,8 +126,45 @@ func WithCustomType[T any](fn func(v T, metadata scanner.Metadata) any) Option {
 	}
 }
 
+// WithAppendMode writes the output to continue a document of the same codec, as
+// used by Exporter.AppendFile: JSON arrays are written without the opening
+// bracket, and newline-delimited JSON without the header and schema records.
+// Rows written as arrays in an object (WithRowsAsArrays without
+// WithNewlineDelimited) cannot be appended.
+func WithAppendMode(appendMode bool) Option {
+	return func(c *jsonCodec) {
+		c.appendMode = appendMode
+	}
+}
+
+// AppendSyntax implements codec.Appender.
+func (c *jsonCodec) AppendSyntax() (open, separator, close string, ok bool) {
+	switch {
+	case !c.appendMode || (c.rowsAsArrays && !c.newlineDelimited):
+		return "", "", "", false
+	case c.newlineDelimited:
+		return "", "", "", true
+	}
+	return "[", ",", "]", true
+}
+
+// TrailerLine implements codec.Trailer with a record like the header record, e.g.
+// {"_type":"integrity","integrity":"..."}, for newline-delimited JSON. JSON arrays
+// cannot be followed by other values.
+func (c *jsonCodec) TrailerLine(text string) (string, bool) {
+	if !c.newlineDelimited {
+		return "", false
+	}
+	data, err := json.Marshal(map[string]string{"_type": "integrity", "integrity": text})
+	if err != nil {
+		return "", false
+	}
+	return string(data) + "\n", true
+}
+
 // WithLimit sets a limit on the number of rows to export.
-// A negative value disables the limit.
+// A negative value disables the limit. Rows skipped by the preprocessor
+// do not count towards the limit.
 func WithLimit(limit int) Option {
 	return func(c *jsonCodec) {
 		c.limit = limit
@@ -80,6 +174,10 @@ func WithLimit(limit int) Option {
 // Write exports the given rows to the writer in JSON format.
 // The output can be either a JSON array or newline-delimited JSON.
 // Supports per-row preprocessing, type conversion, and row limits.
+// scanner.Blob values are streamed as base64 strings without being loaded into memory,
+// except in batches, which are buffered. Values JSON cannot represent are written as
+// strings: NaN and infinite floats as "NaN", "Infinity" and "-Infinity", and times
+// with a year outside 0-9999 in RFC 3339 format with the extended year.
 func (c *jsonCodec) Write(rows scanner.Rows, writer io.Writer) error {
 	cols, err := rows.Columns()
 	if err != nil {
@@ -90,67 +188,310 @@ func (c *jsonCodec) Write(rows scanner.Rows, writer io.Writer) error {
 		columnNames = append(columnNames, col.Name())
 	}
 
-	rowID := 1
+	if c.appendMode && c.rowsAsArrays && !c.newlineDelimited {
+		return errors.New("rows as arrays cannot be appended")
+	}
+	// In append mode, the records describing the columns were written by the first export.
+	if c.newlineDelimited && !c.appendMode {
+		if err := c.writePreamble(writer, cols, columnNames); err != nil {
+			return err
+		}
+	}
+
+	counter := rowcounter.New(rows, c.limit)
 	defer func() {
-		if !c.newlineDelimited && rowID != 1 {
-			writer.Write([]byte("\n]\n"))
+		if !c.newlineDelimited && counter.Written() != 0 {
+			writer.Write([]byte("\n]"))
+			if c.rowsAsArrays {
+				writer.Write([]byte("}"))
+			}
+			writer.Write([]byte("\n"))
 		}
 	}()
-	if c.limit == 0 {
+	if counter.Done() {
 		return nil
 	}
 
-	for rows.Next() {
-		values, err := rows.ScanRow()
+	var batch [][]byte
+	writeBatch := func() {
+		if len(batch) == 0 {
+			return
+		}
+		writer.Write([]byte("["))
+		for i, data := range batch {
+			if i != 0 {
+				writer.Write([]byte(","))
+			}
+			writer.Write(data)
+		}
+		writer.Write([]byte("]\n"))
+		batch = batch[:0]
+	}
+
+	// Rows are encoded directly from their values, except for the objects returned
+	// by a preprocessor, which are marshaled.
+	enc := newRowEncoder(columnNames, c.rowsAsArrays)
+	driver := rows.Driver()
+	mappers := typecache.New(c.customMapper, len(cols))
+	blobs := blob.New()
+	converted := make([]any, len(cols))
+	it := rowiter.New(rows)
+	for it.Next() {
+		values, err := it.ScanRow()
 		if err != nil {
 			return err
 		}
-		row := make(map[string]any, len(values))
-		for i, col := range columnNames {
-			row[col] = values[i]
-			fn, ok := c.customMapper[reflect.TypeOf(values[i])]
-			if ok {
+		rowID := counter.Scan()
+		blobs.Reset()
+		for i := range converted {
+			if b, ok := values[i].(scanner.Blob); ok {
+				converted[i] = blobs.Placeholder(b)
+				continue
+			}
+			v := values[i]
+			if fn, ok := mappers.Lookup(i, v); ok {
 				meta := scanner.Metadata{
 					RowID:  rowID,
-					Driver: rows.Driver(),
+					Driver: driver,
 					Column: cols[i],
 				}
-				row[col] = fn(row[col], meta)
+				meta.Raw, _ = it.RawValue(i)
+				v = fn(v, meta)
 			}
+			converted[i] = representable(v)
 		}
 
-		writeRow := true
+		var data []byte
 		if c.preProcessorFunc != nil {
-			row, writeRow = c.preProcessorFunc(rowID, row)
+			row := make(map[string]any, len(converted))
+			for i, col := range columnNames {
+				row[col] = converted[i]
+			}
+			row, writeRow := c.preProcessorFunc(rowID, row)
+			if !writeRow {
+				continue
+			}
+			if !c.rowsAsArrays {
+				if data, err = json.Marshal(row); err != nil {
+					return err
+				}
+			}
+			for i, col := range columnNames {
+				converted[i] = row[col]
+			}
 		}
-		if !writeRow {
-			continue
+		if data == nil {
+			if data, err = enc.encode(converted); err != nil {
+				return err
+			}
 		}
-
-		data, err := json.Marshal(row)
-		if err != nil {
-			return err
+		if blobs.Len() != 0 && c.newlineDelimited && c.batchSize > 0 {
+			// Batched rows are buffered, so their blobs are read into memory.
+			var spliced bytes.Buffer
+			if err := blobs.Write(&spliced, data); err != nil {
+				return err
+			}
+			data = spliced.Bytes()
 		}
 
-		if writeRow && !c.newlineDelimited && rowID == 1 {
-			writer.Write([]byte("["))
+		if !c.newlineDelimited && counter.Written() == 0 {
+			if c.rowsAsArrays {
+				header, err := json.Marshal(columnNames)
+				if err != nil {
+					return err
+				}
+				writer.Write([]byte(`{"columns":`))
+				writer.Write(header)
+				writer.Write([]byte(`,"rows":`))
+			}
+			if !c.appendMode {
+				writer.Write([]byte("["))
+			}
 		}
 		if !c.newlineDelimited {
-			if rowID != 1 {
+			if counter.Written() != 0 {
 				writer.Write([]byte(","))
 			}
 			writer.Write([]byte("\n"))
-			writer.Write(data)
+			if err := blobs.Write(writer, data); err != nil {
+				return err
+			}
+		} else if c.batchSize > 0 {
+			// The encoder reuses its buffer, so batched rows are copied.
+			batch = append(batch, bytes.Clone(data))
+			if len(batch) >= c.batchSize {
+				writeBatch()
+			}
 		} else {
-			writer.Write(data)
+			if err := blobs.Write(writer, data); err != nil {
+				return err
+			}
 			writer.Write([]byte("\n"))
 		}
 
-		if c.limit >= 0 && rowID >= c.limit {
+		if counter.Write() {
+			writeBatch()
 			return nil
 		}
-		rowID++
 	}
+	writeBatch()
+
+	return it.Err()
+}
+
+// rowEncoder encodes rows as JSON objects or arrays without building a map per
+// row. The keys of objects are written in sorted order and a duplicate column
+// name keeps its last value, as when a map of the row is marshaled.
+type rowEncoder struct {
+	stream *jsoniter.Stream
+	keys   [][]byte // The encoded keys followed by ':', in output order, or nil for arrays.
+	fields []int    // The index of the value of each key.
+}
+
+// newRowEncoder returns a rowEncoder for rows with the given column names.
+func newRowEncoder(columnNames []string, arrays bool) *rowEncoder {
+	if arrays {
+		return &rowEncoder{stream: jsoniter.NewStream(json, nil, 512)}
+	}
+	last := make(map[string]int, len(columnNames))
+	for i, name := range columnNames {
+		last[name] = i
+	}
+	names := slices.Sorted(maps.Keys(last))
+	e := &rowEncoder{
+		stream: jsoniter.NewStream(json, nil, 512),
+		keys:   make([][]byte, len(names)),
+		fields: make([]int, len(names)),
+	}
+	for i, name := range names {
+		key, _ := json.Marshal(name)
+		e.keys[i] = append(key, ':')
+		e.fields[i] = last[name]
+	}
+	return e
+}
+
+// encode returns the JSON object of values. The returned slice is only valid
+// until the next call.
+func (e *rowEncoder) encode(values []any) ([]byte, error) {
+	s := e.stream
+	s.SetBuffer(s.Buffer()[:0])
+	if e.keys == nil {
+		s.WriteArrayStart()
+		for i, v := range values {
+			if i != 0 {
+				s.WriteMore()
+			}
+			s.WriteVal(v)
+		}
+		s.WriteArrayEnd()
+	} else {
+		s.WriteObjectStart()
+		for i, key := range e.keys {
+			if i != 0 {
+				s.WriteMore()
+			}
+			s.Write(key)
+			s.WriteVal(values[e.fields[i]])
+		}
+		s.WriteObjectEnd()
+	}
+	if s.Error != nil {
+		err := s.Error
+		s.Error = nil
+		return nil, err
+	}
+	return s.Buffer(), nil
+}
 
+// representable replaces float and time values that JSON cannot represent with strings.
+func representable(v any) any {
+	switch f := v.(type) {
+	case float64:
+		if name, ok := nonFinite(f); ok {
+			return name
+		}
+	case float32:
+		if name, ok := nonFinite(float64(f)); ok {
+			return name
+		}
+	case time.Time:
+		if year := f.Year(); year < 0 || year > 9999 {
+			return f.Format(time.RFC3339Nano)
+		}
+	}
+	return v
+}
+
+// nonFinite returns the name of f if it is NaN or infinite.
+func nonFinite(f float64) (string, bool) {
+	switch {
+	case math.IsNaN(f):
+		return "NaN", true
+	case math.IsInf(f, 1):
+		return "Infinity", true
+	case math.IsInf(f, -1):
+		return "-Infinity", true
+	}
+	return "", false
+}
+
+// schemaColumn describes a column in the schema record.
+type schemaColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// writePreamble writes the records of newline-delimited output that precede the
+// rows: the header record, and the schema record or the column names of rows
+// written as arrays.
+func (c *jsonCodec) writePreamble(writer io.Writer, cols []scanner.Column, columnNames []string) error {
+	if c.headerRecord {
+		if err := c.writeHeaderRecord(writer, columnNames); err != nil {
+			return err
+		}
+	}
+	if c.schemaRecord {
+		return c.writeSchemaRecord(writer, cols)
+	}
+	if c.rowsAsArrays && !c.headerRecord {
+		data, err := json.Marshal(columnNames)
+		if err != nil {
+			return err
+		}
+		writer.Write(data)
+		writer.Write([]byte("\n"))
+	}
+	return nil
+}
+
+// writeHeaderRecord writes a single line holding the column names.
+func (c *jsonCodec) writeHeaderRecord(writer io.Writer, columnNames []string) error {
+	data, err := json.Marshal(struct {
+		Type    string   `json:"_type"`
+		Columns []string `json:"columns"`
+	}{Type: "header", Columns: columnNames})
+	if err != nil {
+		return err
+	}
+	writer.Write(data)
+	writer.Write([]byte("\n"))
+	return nil
+}
+
+// writeSchemaRecord writes a single line describing the columns.
+func (c *jsonCodec) writeSchemaRecord(writer io.Writer, cols []scanner.Column) error {
+	schema := struct {
+		Columns []schemaColumn `json:"columns"`
+	}{Columns: make([]schemaColumn, len(cols))}
+	for i, col := range cols {
+		schema.Columns[i] = schemaColumn{Name: col.Name(), Type: col.DatabaseTypeName()}
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	writer.Write(data)
+	writer.Write([]byte("\n"))
 	return nil
 }
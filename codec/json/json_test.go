@@ -0,0 +1,196 @@
+package jsoncodec
+
+import (
+	"bytes"
+	stdjson "encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+func TestNewlineDelimitedSchemaAndBatches(t *testing.T) {
+	data := [][]any{{1, "a"}, {2, "b"}, {3, "c"}}
+	var buf bytes.Buffer
+	c := New(WithNewlineDelimited(true), WithSchemaRecord(true), WithBatchSize(2))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"columns":[{"name":"column_0","type":"int"},{"name":"column_1","type":"string"}]}
+[{"column_0":1,"column_1":"a"},{"column_0":2,"column_1":"b"}]
+[{"column_0":3,"column_1":"c"}]
+`
+	if buf.String() != want {
+		t.Errorf("got %s, want %s", buf.String(), want)
+	}
+}
+
+func TestBatchesWithLimit(t *testing.T) {
+	data := [][]any{{1}, {2}, {3}}
+	var buf bytes.Buffer
+	c := New(WithNewlineDelimited(true), WithBatchSize(5), WithLimit(2))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"column_0":1},{"column_0":2}]` + "\n"
+	if buf.String() != want {
+		t.Errorf("got %s, want %s", buf.String(), want)
+	}
+}
+
+func TestLimitCountsWrittenRows(t *testing.T) {
+	data := [][]any{{1}, {2}, {3}, {4}}
+	skipSecond := func(_ int, row map[string]any) (map[string]any, bool) {
+		return row, row["column_0"] != 2
+	}
+	var buf bytes.Buffer
+	c := New(WithLimit(2), WithPreProcessorFunc(skipSecond))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "[\n{\"column_0\":1},\n{\"column_0\":3}\n]\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestBlobStreaming(t *testing.T) {
+	data := [][]any{{1, scanner.Blob{Reader: strings.NewReader("hello")}}}
+	var buf bytes.Buffer
+	if err := New(WithNewlineDelimited(true)).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"column_0":1,"column_1":"aGVsbG8="}` + "\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestRowEncoderMatchesMarshal(t *testing.T) {
+	input := "b,a,<b>,b\n1,2,3,4\n5,,7,8\n"
+	identity := WithPreProcessorFunc(func(_ int, row map[string]any) (map[string]any, bool) {
+		return row, true
+	})
+	var streamed, marshaled bytes.Buffer
+	if err := New().Write(scanner.FromCSV(strings.NewReader(input)), &streamed); err != nil {
+		t.Fatal(err)
+	}
+	if err := New(identity).Write(scanner.FromCSV(strings.NewReader(input)), &marshaled); err != nil {
+		t.Fatal(err)
+	}
+	want := "[\n{\"\\u003cb\\u003e\":\"3\",\"a\":\"2\",\"b\":\"4\"},\n{\"\\u003cb\\u003e\":\"7\",\"a\":\"\",\"b\":\"8\"}\n]\n"
+	if streamed.String() != want || marshaled.String() != want {
+		t.Errorf("got %q and %q, want %q", streamed.String(), marshaled.String(), want)
+	}
+}
+
+func TestWithRowsAsArrays(t *testing.T) {
+	data := [][]any{{1, "a"}, {2, nil}}
+	for _, tc := range []struct {
+		opts []Option
+		want string
+	}{
+		{nil, "{\"columns\":[\"column_0\",\"column_1\"],\"rows\":[\n[1,\"a\"],\n[2,null]\n]}\n"},
+		{[]Option{WithNewlineDelimited(true)}, "[\"column_0\",\"column_1\"]\n[1,\"a\"]\n[2,null]\n"},
+		{[]Option{WithNewlineDelimited(true), WithSchemaRecord(true), WithBatchSize(2)},
+			`{"columns":[{"name":"column_0","type":"int"},{"name":"column_1","type":"string"}]}` + "\n" + `[[1,"a"],[2,null]]` + "\n"},
+		{[]Option{WithNewlineDelimited(true), WithPreProcessorFunc(func(_ int, row map[string]any) (map[string]any, bool) {
+			row["column_1"] = "x"
+			return row, true
+		})}, "[\"column_0\",\"column_1\"]\n[1,\"x\"]\n[2,\"x\"]\n"},
+	} {
+		var buf bytes.Buffer
+		if err := New(append(tc.opts, WithRowsAsArrays(true))...).Write(scanner.FromData(data), &buf); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != tc.want {
+			t.Errorf("got %q, want %q", buf.String(), tc.want)
+		}
+	}
+}
+
+func TestWithHeaderRecord(t *testing.T) {
+	data := [][]any{{1, "a"}}
+	for _, tc := range []struct {
+		opts []Option
+		want string
+	}{
+		{[]Option{WithNewlineDelimited(true)},
+			`{"_type":"header","columns":["column_0","column_1"]}` + "\n" + `{"column_0":1,"column_1":"a"}` + "\n"},
+		{[]Option{WithNewlineDelimited(true), WithRowsAsArrays(true)},
+			`{"_type":"header","columns":["column_0","column_1"]}` + "\n" + `[1,"a"]` + "\n"},
+		{[]Option{WithNewlineDelimited(true), WithSchemaRecord(true)},
+			`{"_type":"header","columns":["column_0","column_1"]}` + "\n" +
+				`{"columns":[{"name":"column_0","type":"int"},{"name":"column_1","type":"string"}]}` + "\n" +
+				`{"column_0":1,"column_1":"a"}` + "\n"},
+		{nil, "[\n{\"column_0\":1,\"column_1\":\"a\"}\n]\n"},
+	} {
+		var buf bytes.Buffer
+		if err := New(append(tc.opts, WithHeaderRecord(true))...).Write(scanner.FromData(data), &buf); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != tc.want {
+			t.Errorf("got %q, want %q", buf.String(), tc.want)
+		}
+	}
+}
+
+func FuzzWrite(f *testing.F) {
+	for seed := range uint64(8) {
+		f.Add(seed, false)
+		f.Add(seed, true)
+	}
+	f.Fuzz(func(t *testing.T, seed uint64, newlineDelimited bool) {
+		var buf bytes.Buffer
+		if err := New(WithNewlineDelimited(newlineDelimited)).Write(scanner.Fuzz(seed), &buf); err != nil {
+			t.Fatal(err)
+		}
+		docs := [][]byte{buf.Bytes()}
+		if newlineDelimited {
+			docs = bytes.Split(bytes.TrimSuffix(buf.Bytes(), []byte("\n")), []byte("\n"))
+		}
+		for _, doc := range docs {
+			if !stdjson.Valid(doc) {
+				t.Fatalf("invalid JSON: %.200s", doc)
+			}
+		}
+		var marshaled bytes.Buffer
+		identity := WithPreProcessorFunc(func(_ int, row map[string]any) (map[string]any, bool) {
+			return row, true
+		})
+		if err := New(WithNewlineDelimited(newlineDelimited), identity).Write(scanner.Fuzz(seed), &marshaled); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf.Bytes(), marshaled.Bytes()) {
+			t.Fatalf("streamed output differs from marshaled maps:\n%.200s\n%.200s", buf.Bytes(), marshaled.Bytes())
+		}
+	})
+}
+
+func TestWithAppendMode(t *testing.T) {
+	data := [][]any{{1}, {2}}
+	for _, tc := range []struct {
+		opts []Option
+		want string
+	}{
+		{nil, "\n{\"column_0\":1},\n{\"column_0\":2}\n]\n"},
+		{[]Option{WithNewlineDelimited(true), WithHeaderRecord(true), WithSchemaRecord(true)},
+			`{"column_0":1}` + "\n" + `{"column_0":2}` + "\n"},
+	} {
+		var buf bytes.Buffer
+		if err := New(append(tc.opts, WithAppendMode(true))...).Write(scanner.FromData(data), &buf); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != tc.want {
+			t.Errorf("got %q, want %q", buf.String(), tc.want)
+		}
+	}
+	c := New(WithAppendMode(true), WithRowsAsArrays(true))
+	if _, _, _, ok := c.AppendSyntax(); ok {
+		t.Error("rows as arrays reported as appendable")
+	}
+	if err := c.Write(scanner.FromData(data), io.Discard); err == nil {
+		t.Error("expected an error for rows as arrays")
+	}
+}
@@ -0,0 +1,99 @@
+// Package parquetcodec provides an Apache Parquet implementation of the Codec interface.
+// This file implements the split block bloom filters of column chunks.
+package parquetcodec
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+// Bounds of the size in bytes of a bloom filter, and its false positive rate.
+const (
+	minBloomFilterSize = 32
+	maxBloomFilterSize = 128 << 20
+	bloomFilterFPP     = 0.01
+)
+
+// bloomSalt holds the constants of the split block bloom filter algorithm.
+var bloomSalt = [8]uint32{
+	0x47b6137b, 0x44974d91, 0x8824ad5b, 0xa2b7289d,
+	0x705495c7, 0x2df1424b, 0x9efc4947, 0x5c6bfb31,
+}
+
+// bloomFilter returns the bitset of a split block bloom filter of the hashes,
+// sized for their number at the false positive rate bloomFilterFPP.
+func bloomFilter(hashes map[uint64]struct{}) []byte {
+	// The number of bits per value for 8 hash functions, see the Parquet spec.
+	size := -8 * float64(len(hashes)) / math.Log(1-math.Pow(bloomFilterFPP, 1.0/8)) / 8
+	n := minBloomFilterSize
+	for n < maxBloomFilterSize && float64(n) < size {
+		n <<= 1
+	}
+	bitset := make([]byte, n)
+	blocks := uint64(n / 32)
+	for h := range hashes {
+		i := ((h >> 32) * blocks) >> 32
+		block := bitset[i*32:]
+		key := uint32(h)
+		for j, salt := range bloomSalt {
+			word := binary.LittleEndian.Uint32(block[j*4:])
+			binary.LittleEndian.PutUint32(block[j*4:], word|1<<((key*salt)>>27))
+		}
+	}
+	return bitset
+}
+
+// Primes of the xxHash64 algorithm.
+const (
+	xxPrime1 uint64 = 11400714785074694791
+	xxPrime2 uint64 = 14029467366897019727
+	xxPrime3 uint64 = 1609587929392839161
+	xxPrime4 uint64 = 9650029242287828579
+	xxPrime5 uint64 = 2870177450012600261
+)
+
+// xxhash64 returns the xxHash64 hash of b with seed 0, the hash function of
+// Parquet bloom filters.
+func xxhash64(b []byte) uint64 {
+	n := len(b)
+	var h uint64
+	if n >= 32 {
+		p1 := xxPrime1
+		v1, v2, v3, v4 := p1+xxPrime2, xxPrime2, uint64(0), -p1
+		for ; len(b) >= 32; b = b[32:] {
+			v1 = xxRound(v1, binary.LittleEndian.Uint64(b))
+			v2 = xxRound(v2, binary.LittleEndian.Uint64(b[8:]))
+			v3 = xxRound(v3, binary.LittleEndian.Uint64(b[16:]))
+			v4 = xxRound(v4, binary.LittleEndian.Uint64(b[24:]))
+		}
+		h = bits.RotateLeft64(v1, 1) + bits.RotateLeft64(v2, 7) + bits.RotateLeft64(v3, 12) + bits.RotateLeft64(v4, 18)
+		for _, v := range [4]uint64{v1, v2, v3, v4} {
+			h = (h^xxRound(0, v))*xxPrime1 + xxPrime4
+		}
+	} else {
+		h = xxPrime5
+	}
+	h += uint64(n)
+	for ; len(b) >= 8; b = b[8:] {
+		h = bits.RotateLeft64(h^xxRound(0, binary.LittleEndian.Uint64(b)), 27)*xxPrime1 + xxPrime4
+	}
+	if len(b) >= 4 {
+		h = bits.RotateLeft64(h^uint64(binary.LittleEndian.Uint32(b))*xxPrime1, 23)*xxPrime2 + xxPrime3
+		b = b[4:]
+	}
+	for _, c := range b {
+		h = bits.RotateLeft64(h^uint64(c)*xxPrime5, 11) * xxPrime1
+	}
+	h ^= h >> 33
+	h *= xxPrime2
+	h ^= h >> 29
+	h *= xxPrime3
+	h ^= h >> 32
+	return h
+}
+
+// xxRound mixes an 8-byte lane into an accumulator.
+func xxRound(acc, lane uint64) uint64 {
+	return bits.RotateLeft64(acc+lane*xxPrime2, 31) * xxPrime1
+}
@@ -0,0 +1,70 @@
+// Package parquetcodec provides an Apache Parquet implementation of the Codec interface.
+// This file implements the dictionary encoding of column chunks.
+package parquetcodec
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// dictionary holds the distinct values of a column chunk in the PLAIN encoding.
+// The data pages of a dictionary encoded chunk hold the indices of their values.
+type dictionary struct {
+	index  map[string]int32
+	values []byte // The distinct values in order of their indices.
+	count  int
+}
+
+// newDictionary returns an empty dictionary.
+func newDictionary() *dictionary {
+	return &dictionary{index: make(map[string]int32)}
+}
+
+// add returns the index of the PLAIN encoded value, adding it if it is new.
+func (d *dictionary) add(value []byte) int32 {
+	if i, ok := d.index[string(value)]; ok {
+		return i
+	}
+	i := int32(d.count)
+	d.index[string(value)] = i
+	d.values = append(d.values, value...)
+	d.count++
+	return i
+}
+
+// bitWidth returns the number of bits of the indices into the dictionary, at
+// least 1.
+func (d *dictionary) bitWidth() int {
+	if d.count <= 1 {
+		return 1
+	}
+	return bits.Len(uint(d.count - 1))
+}
+
+// appendIndices appends the data of a dictionary encoded page to dst: the bit
+// width followed by the indices in the RLE/bit-packing hybrid encoding, as a
+// single bit-packed run padded to a multiple of eight values. A page of NULLs
+// has only the bit width.
+func appendIndices(dst []byte, indices []int32, width int) []byte {
+	dst = append(dst, byte(width))
+	if len(indices) == 0 {
+		return dst
+	}
+	groups := (len(indices) + 7) / 8
+	dst = binary.AppendUvarint(dst, uint64(groups)<<1|1)
+	var acc uint64
+	n := 0
+	for i := range groups * 8 {
+		var v int32
+		if i < len(indices) {
+			v = indices[i]
+		}
+		acc |= uint64(v) << n
+		for n += width; n >= 8; n -= 8 {
+			dst = append(dst, byte(acc))
+			acc >>= 8
+		}
+	}
+	// A group of eight values always ends on a byte boundary.
+	return dst
+}
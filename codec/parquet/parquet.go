@@ -0,0 +1,506 @@
+// Package parquetcodec provides an Apache Parquet implementation of the Codec
+// interface, so that query results can be loaded into data lakes without an
+// intermediate CSV conversion. The schema of the file is inferred from the column
+// metadata of the source, and rows are streamed to the writer in row groups, so
+// that only the current row group is held in memory.
+package parquetcodec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-data-exporter/exporter/internal/rowcounter"
+	"github.com/go-data-exporter/exporter/internal/rowiter"
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// Compression selects the compression codec of the column chunks.
+type Compression int
+
+const (
+	Uncompressed Compression = iota // Pages are written as they are (default).
+	Gzip                            // Pages are compressed with gzip.
+)
+
+// Default sizes of row groups and data pages in bytes.
+const (
+	defaultRowGroupSize = 64 << 20
+	defaultPageSize     = 1 << 20
+)
+
+// magic starts and ends every Parquet file.
+const magic = "PAR1"
+
+// parquetCodec implements the Codec interface to export tabular data as Parquet.
+type parquetCodec struct {
+	converter        *tostring.Converter
+	preProcessorFunc func(rowID int, row []any) ([]any, bool)
+	limit            int
+	rowGroupSize     int
+	pageSize         int
+	compression      Compression
+	dictionary       bool
+	statistics       bool
+	bloomFilters     bool
+}
+
+// Option defines a functional configuration option for parquetCodec.
+type Option func(*parquetCodec)
+
+// New creates a new Parquet codec with the provided configuration options.
+func New(opts ...Option) *parquetCodec {
+	c := &parquetCodec{
+		converter:    tostring.New(),
+		limit:        -1,
+		rowGroupSize: defaultRowGroupSize,
+		pageSize:     defaultPageSize,
+		statistics:   true,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithToString sets the converter used for values written to string columns
+// that are not strings already, such as the values of columns of unknown type.
+func WithToString(converter *tostring.Converter) Option {
+	return func(c *parquetCodec) {
+		if converter != nil {
+			c.converter = converter
+		}
+	}
+}
+
+// WithPreProcessorFunc sets a function to preprocess or filter each row before
+// writing. Unlike the text codecs, the row holds the scanned values, which are
+// converted to the column types after preprocessing. Row IDs number the written
+// rows starting from 1, so a skipped row passes its ID on to the next row.
+func WithPreProcessorFunc(fn func(rowID int, row []any) ([]any, bool)) Option {
+	return func(c *parquetCodec) {
+		c.preProcessorFunc = fn
+	}
+}
+
+// WithLimit sets a limit on the number of rows to write. Negative means unlimited.
+// Rows skipped by the preprocessor do not count towards the limit.
+func WithLimit(limit int) Option {
+	return func(c *parquetCodec) {
+		c.limit = limit
+	}
+}
+
+// WithRowGroupSize sets the approximate size in bytes of the row groups (default
+// 64 MiB). A row group is held in memory until it is complete, so the size bounds
+// the memory used by the codec, while larger row groups are read more efficiently.
+func WithRowGroupSize(bytes int) Option {
+	return func(c *parquetCodec) {
+		if bytes > 0 {
+			c.rowGroupSize = bytes
+		}
+	}
+}
+
+// WithPageSize sets the approximate size in bytes of the data pages of a column
+// chunk (default 1 MiB).
+func WithPageSize(bytes int) Option {
+	return func(c *parquetCodec) {
+		if bytes > 0 {
+			c.pageSize = bytes
+		}
+	}
+}
+
+// WithCompression sets the compression codec of all columns (default
+// Uncompressed). The scanner.HintCompression hint of a column overrides it with
+// "gzip" or "none"; other codecs are not supported and ignored.
+func WithCompression(compression Compression) Option {
+	return func(c *parquetCodec) {
+		c.compression = compression
+	}
+}
+
+// WithDictionary enables the dictionary encoding of all columns except booleans
+// (default disabled). The scanner.HintEncoding hint of a column overrides it with
+// "dictionary" or "plain". Columns with few distinct values, such as status codes
+// or country names, are smaller and faster to read with a dictionary. The
+// dictionary of a column chunk is limited to the page size; when it grows beyond
+// it, the remaining pages of the chunk fall back to the PLAIN encoding.
+func WithDictionary(enabled bool) Option {
+	return func(c *parquetCodec) {
+		c.dictionary = enabled
+	}
+}
+
+// WithStatistics enables the minimum and maximum values of the column chunks
+// (default enabled), which query engines use to skip row groups. Values larger
+// than 4 KiB and NaN are not tracked, and decimals stored as BYTE_ARRAY have no
+// statistics. NULL counts are written regardless of the option.
+func WithStatistics(enabled bool) Option {
+	return func(c *parquetCodec) {
+		c.statistics = enabled
+	}
+}
+
+// WithBloomFilters enables a bloom filter for the column chunks of all columns
+// except booleans (default disabled). The scanner.HintBloomFilter hint of a
+// column overrides it with "true" or "false". A bloom filter lets query engines
+// skip row groups in equality lookups of values such as IDs, at the cost of about
+// 1.2 bytes per distinct value and row group, with a false positive rate of 1%.
+func WithBloomFilters(enabled bool) Option {
+	return func(c *parquetCodec) {
+		c.bloomFilters = enabled
+	}
+}
+
+// Write writes the scanned rows as a Parquet file to the provided writer. The
+// schema is inferred from the canonical type of every column, see
+// scanner.ColumnKind: booleans, integers, floats, dates, times and timestamps map
+// to the Parquet types of the same meaning with microsecond precision, decimals
+// with a known precision and scale to DECIMAL, binary columns to BYTE_ARRAY and
+// all other columns, including columns of unknown type, to UTF-8 strings. Columns
+// that report that they are not nullable are REQUIRED, all others OPTIONAL. Values
+// are converted to the column type, e.g. strings are parsed as numbers, and a
+// value that cannot be converted fails the export. A file is written even if
+// there are no rows.
+func (c *parquetCodec) Write(rows scanner.Rows, writer io.Writer) error {
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	driver := rows.Driver()
+	f := &fileWriter{w: writer}
+	f.write([]byte(magic))
+	columns := make([]*column, len(cols))
+	meta := fileMetaData{schema: make([]schemaElement, len(cols))}
+	for i, col := range cols {
+		columns[i] = c.newColumn(col, driver)
+		meta.schema[i] = columns[i].schemaElement
+	}
+
+	var groupRows int64
+	flush := func() {
+		g := rowGroup{rows: groupRows, columns: make([]columnChunk, len(columns))}
+		for i, col := range columns {
+			g.columns[i] = col.flushChunk(f)
+		}
+		// Bloom filters follow the column chunks, which stay contiguous.
+		for i, col := range columns {
+			col.flushBloomFilter(f, &g.columns[i])
+		}
+		meta.rowGroups = append(meta.rowGroups, g)
+		meta.rows += groupRows
+		groupRows = 0
+	}
+	counter := rowcounter.New(rows, c.limit)
+	it := rowiter.New(rows)
+	for !counter.Done() && it.Next() {
+		values, err := it.ScanRow()
+		if err != nil {
+			return err
+		}
+		rowID := counter.Scan()
+		if c.preProcessorFunc != nil {
+			var writeRow bool
+			if values, writeRow = c.preProcessorFunc(rowID, values); !writeRow {
+				continue
+			}
+		}
+		buffered := 0
+		for i, col := range columns {
+			var v any
+			if i < len(values) {
+				v = values[i]
+			}
+			if err := col.add(v); err != nil {
+				return fmt.Errorf("parquetcodec: row %d, column %q: %w", rowID, col.name, err)
+			}
+			if col.pageSize() >= c.pageSize {
+				col.flushPage()
+			}
+			buffered += col.chunk.Len() + col.pageSize()
+			if col.dict != nil {
+				buffered += len(col.dict.values)
+			}
+		}
+		counter.Write()
+		groupRows++
+		if buffered >= c.rowGroupSize {
+			flush()
+		}
+		if f.err != nil {
+			return f.err
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	if groupRows > 0 {
+		flush()
+	}
+	footer := meta.encode()
+	f.write(footer)
+	f.write(binary.LittleEndian.AppendUint32(nil, uint32(len(footer))))
+	f.write([]byte(magic))
+	return f.err
+}
+
+// fileWriter writes to the destination and tracks the file offset. After an
+// error, writes are skipped and the error is kept.
+type fileWriter struct {
+	w      io.Writer
+	offset int64
+	err    error
+}
+
+// write writes p unless an earlier write failed.
+func (f *fileWriter) write(p []byte) {
+	if f.err != nil {
+		return
+	}
+	n, err := f.w.Write(p)
+	f.offset += int64(n)
+	f.err = err
+}
+
+// column is a column of the file with its row group data being written.
+type column struct {
+	schemaElement
+	encode func(dst []byte, v any) ([]byte, error) // Appends a value in the PLAIN encoding.
+
+	// The data page being filled.
+	levels  []byte // The definition level of every value of an optional column.
+	values  []byte
+	count   int     // The number of values including NULLs.
+	bits    int     // The number of booleans packed into values.
+	indices []int32 // The dictionary indices of the values of a dictionary encoded page.
+
+	// The column chunk of the current row group.
+	chunk     bytes.Buffer
+	meta      columnChunk
+	dict      *dictionary         // The dictionary of the chunk, nil if the column is PLAIN encoded.
+	dictLimit int                 // The size in bytes beyond which the chunk falls back to PLAIN.
+	plain     bool                // Whether the chunk fell back to PLAIN.
+	stats     *statistics         // The minimum and maximum of the chunk, nil without statistics.
+	hashes    map[uint64]struct{} // The hashes of the values of the chunk, nil without a bloom filter.
+}
+
+// add adds a value to the current page.
+func (c *column) add(v any) error {
+	v, err := value(v)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		if c.repetition == repetitionRequired {
+			return fmt.Errorf("NULL value in a column reported as not nullable")
+		}
+		c.levels = append(c.levels, 0)
+		c.meta.nulls++
+		c.count++
+		return nil
+	}
+	if c.typ == typeBoolean {
+		b, err := toBool(v)
+		if err != nil {
+			return err
+		}
+		if c.bits%8 == 0 {
+			c.values = append(c.values, 0)
+		}
+		var bit byte
+		if b {
+			bit = 1
+			c.values[len(c.values)-1] |= 1 << (c.bits % 8)
+		}
+		c.bits++
+		c.observe([]byte{bit})
+	} else {
+		start := len(c.values)
+		if c.values, err = c.encode(c.values, v); err != nil {
+			return err
+		}
+		c.observe(c.values[start:])
+		if c.dict != nil && !c.plain {
+			c.indices = append(c.indices, c.dict.add(c.values[start:]))
+			c.values = c.values[:start]
+		}
+	}
+	if c.repetition == repetitionOptional {
+		c.levels = append(c.levels, 1)
+	}
+	c.count++
+	if c.dict != nil && !c.plain && len(c.dict.values) > c.dictLimit {
+		// The page ends with the dictionary, and the rest of the chunk is PLAIN.
+		c.flushPage()
+		c.plain = true
+	}
+	return nil
+}
+
+// observe records a PLAIN encoded value in the statistics and the bloom filter
+// of the chunk.
+func (c *column) observe(value []byte) {
+	if c.typ == typeByteArray {
+		value = value[4:]
+	}
+	if c.stats != nil {
+		c.stats.add(value)
+	}
+	if c.hashes != nil {
+		c.hashes[xxhash64(value)] = struct{}{}
+	}
+}
+
+// pageSize returns the approximate size in bytes of the current page.
+func (c *column) pageSize() int {
+	size := len(c.values)
+	if c.dict != nil {
+		size += len(c.indices) * c.dict.bitWidth() / 8
+	}
+	return size
+}
+
+// flushPage appends the current page, if it has values, to the column chunk.
+// The values of the page are dictionary encoded if the chunk has a non-empty
+// dictionary and did not fall back to PLAIN.
+func (c *column) flushPage() {
+	if c.count == 0 {
+		return
+	}
+	var page []byte
+	if c.repetition == repetitionOptional {
+		page = appendLevels(make([]byte, 4, 4+len(c.levels)+len(c.values)), c.levels)
+		binary.LittleEndian.PutUint32(page, uint32(len(page)-4))
+	}
+	encoding := encodingPlain
+	if c.dict != nil && !c.plain && c.dict.count > 0 {
+		encoding = encodingRLEDictionary
+		page = appendIndices(page, c.indices, c.dict.bitWidth())
+	}
+	page = append(page, c.values...)
+	data := c.compress(page)
+	header := pageHeader(encoding, c.count, len(page), len(data))
+	c.chunk.Write(header)
+	c.chunk.Write(data)
+	c.meta.values += int64(c.count)
+	c.meta.uncompressedSize += int64(len(header) + len(page))
+	c.meta.compressedSize += int64(len(header) + len(data))
+	c.levels, c.values, c.indices = c.levels[:0], c.values[:0], c.indices[:0]
+	c.count, c.bits = 0, 0
+}
+
+// compress returns a page compressed with the codec of the column.
+func (c *column) compress(page []byte) []byte {
+	if c.meta.codec != codecGzip {
+		return page
+	}
+	var b bytes.Buffer
+	zw := gzip.NewWriter(&b)
+	zw.Write(page)
+	zw.Close()
+	return b.Bytes()
+}
+
+// flushChunk writes the column chunk of the current row group to f, preceded
+// by its dictionary page, and returns its metadata.
+func (c *column) flushChunk(f *fileWriter) columnChunk {
+	c.flushPage()
+	meta := c.meta
+	meta.offset = f.offset
+	if c.dict != nil && c.dict.count > 0 {
+		data := c.compress(c.dict.values)
+		header := dictionaryPageHeader(c.dict.count, len(c.dict.values), len(data))
+		f.write(header)
+		f.write(data)
+		meta.dictionaryOffset = meta.offset
+		meta.offset += int64(len(header) + len(data))
+		meta.uncompressedSize += int64(len(header) + len(c.dict.values))
+		meta.compressedSize += int64(len(header) + len(data))
+		c.dict = newDictionary()
+	}
+	c.plain = false
+	meta.min, meta.max, meta.hasStatistics = c.stats.result()
+	f.write(c.chunk.Bytes())
+	c.chunk.Reset()
+	c.meta = columnChunk{path: meta.path, typ: meta.typ, codec: meta.codec}
+	return meta
+}
+
+// flushBloomFilter writes the bloom filter of the column chunk of the current
+// row group to f, if the column has one, and records it in meta.
+func (c *column) flushBloomFilter(f *fileWriter, meta *columnChunk) {
+	if c.hashes == nil {
+		return
+	}
+	bitset := bloomFilter(c.hashes)
+	header := bloomFilterHeader(len(bitset))
+	meta.bloomFilterOffset = f.offset
+	meta.bloomFilterLength = int32(len(header) + len(bitset))
+	f.write(header)
+	f.write(bitset)
+	clear(c.hashes)
+}
+
+// appendLevels appends definition levels to dst in the RLE encoding with a bit
+// width of 1, as a run per sequence of equal levels.
+func appendLevels(dst []byte, levels []byte) []byte {
+	for i := 0; i < len(levels); {
+		j := i + 1
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+		dst = append(binary.AppendUvarint(dst, uint64(j-i)<<1), levels[i])
+		i = j
+	}
+	return dst
+}
+
+// compressionOf returns the compression codec of col, which its
+// scanner.HintCompression hint may override.
+func (c *parquetCodec) compressionOf(col scanner.Column) int32 {
+	compression := c.compression
+	if hint, ok := scanner.Hint(col, scanner.HintCompression); ok {
+		switch strings.ToLower(hint) {
+		case "gzip":
+			compression = Gzip
+		case "none", "uncompressed":
+			compression = Uncompressed
+		}
+	}
+	if compression == Gzip {
+		return codecGzip
+	}
+	return codecUncompressed
+}
+
+// dictionaryOf reports whether col is dictionary encoded, which its
+// scanner.HintEncoding hint may override.
+func (c *parquetCodec) dictionaryOf(col scanner.Column) bool {
+	if hint, ok := scanner.Hint(col, scanner.HintEncoding); ok {
+		switch strings.ToLower(hint) {
+		case "dictionary":
+			return true
+		case "plain":
+			return false
+		}
+	}
+	return c.dictionary
+}
+
+// bloomFilterOf reports whether col has a bloom filter, which its
+// scanner.HintBloomFilter hint may override.
+func (c *parquetCodec) bloomFilterOf(col scanner.Column) bool {
+	if hint, ok := scanner.Hint(col, scanner.HintBloomFilter); ok {
+		if enabled, err := strconv.ParseBool(hint); err == nil {
+			return enabled
+		}
+	}
+	return c.bloomFilters
+}
@@ -0,0 +1,587 @@
+package parquetcodec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// parquetFile is a file read back by readFile.
+type parquetFile struct {
+	names     []string
+	types     []int64
+	required  []bool
+	rows      int64
+	rowGroups int
+	values    [][]any // By column.
+	leaves    []map[int16]any
+	chunks    []map[int16]any // The ColumnMetaData of every column chunk by row group.
+}
+
+// readFile decodes the footer and the data pages of a file written by the codec.
+func readFile(t *testing.T, data []byte) *parquetFile {
+	t.Helper()
+	if len(data) < 12 || string(data[:4]) != magic || string(data[len(data)-4:]) != magic {
+		t.Fatalf("missing magic bytes: %q", data)
+	}
+	size := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	meta, _ := readStruct(t, data[len(data)-8-size:len(data)-8])
+	f := &parquetFile{rows: meta[3].(int64)}
+	schema := meta[2].([]any)
+	if schema[0].(map[int16]any)[5].(int64) != int64(len(schema)-1) {
+		t.Fatalf("root has %v children, want %d", schema[0], len(schema)-1)
+	}
+	for _, el := range schema[1:] {
+		el := el.(map[int16]any)
+		f.leaves = append(f.leaves, el)
+		f.names = append(f.names, string(el[4].([]byte)))
+		f.types = append(f.types, el[1].(int64))
+		f.required = append(f.required, el[3].(int64) == 0)
+	}
+	f.values = make([][]any, len(f.names))
+	for _, g := range meta[4].([]any) {
+		f.rowGroups++
+		for i, chunk := range g.(map[int16]any)[1].([]any) {
+			cm := chunk.(map[int16]any)[3].(map[int16]any)
+			f.chunks = append(f.chunks, cm)
+			nextPage := func(pos int64) (map[int16]any, []byte, int64) {
+				header, n := readStruct(t, data[pos:])
+				pos += int64(n)
+				page := data[pos : pos+header[3].(int64)]
+				pos += header[3].(int64)
+				if cm[4].(int64) == int64(codecGzip) {
+					zr, err := gzip.NewReader(bytes.NewReader(page))
+					if err != nil {
+						t.Fatal(err)
+					}
+					if page, err = io.ReadAll(zr); err != nil {
+						t.Fatal(err)
+					}
+				}
+				return header, page, pos
+			}
+			pos, remaining := cm[9].(int64), cm[5].(int64)
+			var dict []any
+			if offset, ok := cm[11].(int64); ok {
+				header, page, next := nextPage(offset)
+				if header[1] != int64(pageDictionary) || next != pos {
+					t.Fatalf("unexpected dictionary page %v ending at %d, want %d", header, next, pos)
+				}
+				dict = readPage(t, page, int(header[7].(map[int16]any)[1].(int64)), f.types[i], true, nil)
+			}
+			for remaining > 0 {
+				var header map[int16]any
+				var page []byte
+				header, page, pos = nextPage(pos)
+				dph := header[5].(map[int16]any)
+				var pageDict []any
+				if dph[2] == int64(encodingRLEDictionary) {
+					pageDict = dict
+				}
+				count := dph[1].(int64)
+				f.values[i] = append(f.values[i], readPage(t, page, int(count), f.types[i], f.required[i], pageDict)...)
+				remaining -= count
+			}
+		}
+	}
+	return f
+}
+
+// readPage decodes the values of a data page, which are indices into dict if
+// dict is not nil.
+func readPage(t *testing.T, page []byte, count int, typ int64, required bool, dict []any) []any {
+	t.Helper()
+	levels := make([]byte, count)
+	if required {
+		for i := range levels {
+			levels[i] = 1
+		}
+	} else {
+		n := int(binary.LittleEndian.Uint32(page))
+		runs := page[4 : 4+n]
+		page = page[4+n:]
+		for i := 0; len(runs) > 0; {
+			header, m := binary.Uvarint(runs)
+			if header&1 != 0 {
+				t.Fatal("unexpected bit-packed run")
+			}
+			for range header >> 1 {
+				levels[i] = runs[m]
+				i++
+			}
+			runs = runs[m+1:]
+		}
+	}
+	values := make([]any, count)
+	if dict != nil {
+		width := int(page[0])
+		if len(page) > 1 {
+			header, m := binary.Uvarint(page[1:])
+			if header&1 == 0 {
+				t.Fatal("unexpected RLE run")
+			}
+			page = page[1+m:]
+		}
+		bit := 0
+		for i := range values {
+			if levels[i] == 0 {
+				continue
+			}
+			index := 0
+			for j := range width {
+				if page[(bit+j)/8]&(1<<((bit+j)%8)) != 0 {
+					index |= 1 << j
+				}
+			}
+			bit += width
+			values[i] = dict[index]
+		}
+		return values
+	}
+	bit := 0
+	for i := range values {
+		if levels[i] == 0 {
+			continue
+		}
+		switch int32(typ) {
+		case typeBoolean:
+			values[i] = page[bit/8]&(1<<(bit%8)) != 0
+			bit++
+		case typeInt32:
+			values[i] = int32(binary.LittleEndian.Uint32(page))
+			page = page[4:]
+		case typeInt64:
+			values[i] = int64(binary.LittleEndian.Uint64(page))
+			page = page[8:]
+		case typeFloat:
+			values[i] = math.Float32frombits(binary.LittleEndian.Uint32(page))
+			page = page[4:]
+		case typeDouble:
+			values[i] = math.Float64frombits(binary.LittleEndian.Uint64(page))
+			page = page[8:]
+		case typeByteArray:
+			n := binary.LittleEndian.Uint32(page)
+			values[i] = string(page[4 : 4+n])
+			page = page[4+n:]
+		}
+	}
+	return values
+}
+
+// readStruct decodes a Thrift compact protocol struct into its fields by id and
+// returns the number of bytes read.
+func readStruct(t *testing.T, data []byte) (map[int16]any, int) {
+	t.Helper()
+	r := &compactReader{data: data}
+	s := r.readStruct()
+	if r.err != nil {
+		t.Fatal(r.err)
+	}
+	return s, len(data) - len(r.data)
+}
+
+// compactReader decodes the Thrift compact protocol.
+type compactReader struct {
+	data []byte
+	err  error
+}
+
+func (r *compactReader) byte() byte {
+	if len(r.data) == 0 {
+		r.err = errors.New("unexpected end of data")
+		return 0
+	}
+	b := r.data[0]
+	r.data = r.data[1:]
+	return b
+}
+
+func (r *compactReader) varint() int64 {
+	v, n := binary.Varint(r.data)
+	if n <= 0 {
+		r.err = errors.New("bad varint")
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *compactReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = errors.New("bad uvarint")
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *compactReader) readStruct() map[int16]any {
+	fields := make(map[int16]any)
+	var last int16
+	for r.err == nil {
+		header := r.byte()
+		if header == 0 {
+			break
+		}
+		id := last + int16(header>>4)
+		if header>>4 == 0 {
+			id = int16(r.varint())
+		}
+		last = id
+		fields[id] = r.readValue(header & 0x0f)
+	}
+	return fields
+}
+
+func (r *compactReader) readValue(typ byte) any {
+	switch typ {
+	case typeTrue:
+		return true
+	case typeFalse:
+		return false
+	case typeByte:
+		return int64(int8(r.byte()))
+	case typeI32, typeI64:
+		return r.varint()
+	case typeBinary:
+		n := r.uvarint()
+		if n > uint64(len(r.data)) {
+			r.err = errors.New("bad length")
+			return nil
+		}
+		b := r.data[:n]
+		r.data = r.data[n:]
+		return b
+	case typeList:
+		header := r.byte()
+		n := uint64(header >> 4)
+		if n == 15 {
+			n = r.uvarint()
+		}
+		list := make([]any, n)
+		for i := range list {
+			if header&0x0f == typeTrue {
+				list[i] = r.byte() == 1
+				continue
+			}
+			list[i] = r.readValue(header & 0x0f)
+		}
+		return list
+	case typeStruct:
+		return r.readStruct()
+	}
+	r.err = errors.New("unsupported type")
+	return nil
+}
+
+func TestWrite(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 30, 0, 500000000, time.UTC)
+	data := [][]any{
+		{int64(1), "alice", 1.5, true, created, []byte{0, 1}, nil},
+		{int64(2), nil, nil, false, created.Add(time.Hour), nil, "x"},
+		{int64(3), "carol", 2.25, nil, nil, []byte("b"), 42},
+	}
+	var buf bytes.Buffer
+	if err := New().Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	f := readFile(t, buf.Bytes())
+	wantNames := []string{"column_0", "column_1", "column_2", "column_3", "column_4", "column_5", "column_6"}
+	if !reflect.DeepEqual(f.names, wantNames) {
+		t.Errorf("names = %v, want %v", f.names, wantNames)
+	}
+	wantTypes := []int64{2, 6, 5, 0, 2, 6, 6}
+	if !reflect.DeepEqual(f.types, wantTypes) {
+		t.Errorf("types = %v, want %v", f.types, wantTypes)
+	}
+	if f.rows != 3 || f.rowGroups != 1 {
+		t.Errorf("rows = %d in %d row groups, want 3 in 1", f.rows, f.rowGroups)
+	}
+	want := [][]any{
+		{int64(1), int64(2), int64(3)},
+		{"alice", nil, "carol"},
+		{1.5, nil, 2.25},
+		{true, false, nil},
+		{created.UnixMicro(), created.Add(time.Hour).UnixMicro(), nil},
+		{"\x00\x01", nil, "b"},
+		{nil, "x", "42"},
+	}
+	if !reflect.DeepEqual(f.values, want) {
+		t.Errorf("values = %v, want %v", f.values, want)
+	}
+	// The timestamp column is annotated as TIMESTAMP_MICROS adjusted to UTC.
+	if ts := f.leaves[4]; ts[6].(int64) != int64(convertedTimestampMicros) || ts[10].(map[int16]any)[8].(map[int16]any)[1] != true {
+		t.Errorf("unexpected timestamp schema %v", ts)
+	}
+}
+
+func TestWriteEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New().Write(scanner.FromData(nil), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if f := readFile(t, buf.Bytes()); f.rows != 0 || len(f.names) != 0 {
+		t.Errorf("got %d rows and columns %v", f.rows, f.names)
+	}
+}
+
+func TestRowGroupsAndPages(t *testing.T) {
+	var data [][]any
+	for i := range 100 {
+		data = append(data, []any{i, strings.Repeat("x", i%7)})
+	}
+	for _, compression := range []Compression{Uncompressed, Gzip} {
+		var buf bytes.Buffer
+		c := New(WithRowGroupSize(300), WithPageSize(50), WithCompression(compression))
+		if err := c.Write(scanner.FromData(data), &buf); err != nil {
+			t.Fatal(err)
+		}
+		f := readFile(t, buf.Bytes())
+		if f.rows != 100 || f.rowGroups < 2 {
+			t.Errorf("rows = %d in %d row groups", f.rows, f.rowGroups)
+		}
+		for i, row := range data {
+			if f.values[0][i] != int64(i) || f.values[1][i] != row[1] {
+				t.Fatalf("row %d = %v, %v", i, f.values[0][i], f.values[1][i])
+			}
+		}
+	}
+}
+
+// testColumn is a column with explicit metadata.
+type testColumn struct {
+	name             string
+	typeName         string
+	precision, scale int64
+	nullable         bool
+}
+
+func (c testColumn) Index() int             { return 0 }
+func (c testColumn) Name() string           { return c.name }
+func (c testColumn) Length() (int64, bool)  { return 0, false }
+func (c testColumn) ScanType() reflect.Type { return nil }
+func (c testColumn) DatabaseTypeName() string {
+	return c.typeName
+}
+func (c testColumn) DecimalSize() (int64, int64, bool) {
+	return c.precision, c.scale, c.precision > 0
+}
+func (c testColumn) Nullable() (bool, bool) { return c.nullable, !c.nullable }
+
+// columnsRows overrides the columns of rows.
+type columnsRows struct {
+	scanner.Rows
+	columns []scanner.Column
+}
+
+func (r columnsRows) Columns() ([]scanner.Column, error) {
+	return r.columns, nil
+}
+
+func TestSchemaInference(t *testing.T) {
+	rows := columnsRows{
+		Rows: scanner.FromData([][]any{{"12.345", "-7", "2024-03-01", "12:30:01.5", "2024-03-01 10:00:00", "1"}}),
+		columns: []scanner.Column{
+			testColumn{name: "price", typeName: "NUMERIC", precision: 10, scale: 2, nullable: true},
+			testColumn{name: "big", typeName: "NUMERIC", precision: 30, scale: 0, nullable: true},
+			testColumn{name: "day", typeName: "DATE"},
+			testColumn{name: "at", typeName: "TIME", nullable: true},
+			testColumn{name: "local", typeName: "TIMESTAMP", nullable: true},
+			testColumn{name: "small", typeName: "SMALLINT", nullable: true},
+		},
+	}
+	var buf bytes.Buffer
+	if err := New().Write(rows, &buf); err != nil {
+		t.Fatal(err)
+	}
+	f := readFile(t, buf.Bytes())
+	if !reflect.DeepEqual(f.types, []int64{2, 6, 1, 2, 2, 1}) {
+		t.Errorf("types = %v", f.types)
+	}
+	if !reflect.DeepEqual(f.required, []bool{false, false, true, false, false, false}) {
+		t.Errorf("required = %v", f.required)
+	}
+	if price := f.leaves[0]; price[7] != int64(2) || price[8] != int64(10) || price[6] != int64(convertedDecimal) {
+		t.Errorf("unexpected decimal schema %v", price)
+	}
+	local := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC).UnixMicro()
+	want := []any{int64(1235), "\xf9", int32(19783), int64(45001500000), local, int32(1)}
+	for i, w := range want {
+		if f.values[i][0] != w {
+			t.Errorf("%s = %#v, want %#v", f.names[i], f.values[i][0], w)
+		}
+	}
+	if ts := f.leaves[4]; ts[6] != nil || ts[10].(map[int16]any)[8].(map[int16]any)[1] != false {
+		t.Errorf("unexpected local timestamp schema %v", ts)
+	}
+}
+
+func TestWriteErrors(t *testing.T) {
+	rows := columnsRows{
+		Rows:    scanner.FromData([][]any{{"abc"}}),
+		columns: []scanner.Column{testColumn{name: "n", typeName: "INTEGER", nullable: true}},
+	}
+	err := New().Write(rows, io.Discard)
+	if !errors.Is(err, errNotConvertible) || !strings.Contains(err.Error(), `row 1, column "n"`) {
+		t.Errorf("unexpected error %v", err)
+	}
+	rows = columnsRows{
+		Rows:    scanner.FromData([][]any{{nil}}),
+		columns: []scanner.Column{testColumn{name: "n", typeName: "INTEGER"}},
+	}
+	if err := New().Write(rows, io.Discard); err == nil {
+		t.Error("NULL in a required column was accepted")
+	}
+}
+
+func TestWithLimitAndPreProcessor(t *testing.T) {
+	data := [][]any{{1}, {2}, {3}, {4}}
+	skipEven := func(rowID int, row []any) ([]any, bool) {
+		return row, rowID%2 == 1
+	}
+	var buf bytes.Buffer
+	if err := New(WithLimit(1), WithPreProcessorFunc(skipEven)).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if f := readFile(t, buf.Bytes()); !reflect.DeepEqual(f.values, [][]any{{int64(1)}}) {
+		t.Errorf("values = %v", f.values)
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	var data [][]any
+	for i := range 100 {
+		var status any = []string{"new", "paid", "shipped"}[i%3]
+		if i%10 == 0 {
+			status = nil
+		}
+		data = append(data, []any{i % 4, status, i})
+	}
+	// The third column falls back to PLAIN once its dictionary exceeds a page.
+	rows := scanner.WithColumnHints(scanner.FromData(data), map[string]map[string]string{
+		"column_0": {scanner.HintEncoding: "plain"},
+	})
+	var buf bytes.Buffer
+	if err := New(WithDictionary(true), WithPageSize(64), WithCompression(Gzip)).Write(rows, &buf); err != nil {
+		t.Fatal(err)
+	}
+	f := readFile(t, buf.Bytes())
+	for i, row := range data {
+		if f.values[0][i] != int64(row[0].(int)) || f.values[1][i] != row[1] || f.values[2][i] != int64(i) {
+			t.Fatalf("row %d = %v, %v, %v", i, f.values[0][i], f.values[1][i], f.values[2][i])
+		}
+	}
+	for i, want := range []bool{false, true, true} {
+		_, ok := f.chunks[i][11]
+		if encodings := f.chunks[i][2].([]any); ok != want || (encodings[0] == int64(encodingRLEDictionary)) != want {
+			t.Errorf("%s: dictionary = %v with encodings %v, want %v", f.names[i], ok, encodings, want)
+		}
+	}
+}
+
+func TestStatistics(t *testing.T) {
+	data := [][]any{
+		{int64(5), "pear", math.NaN(), true},
+		{int64(-3), nil, 0.0, nil},
+		{int64(12), "apple", 2.5, true},
+	}
+	var buf bytes.Buffer
+	if err := New().Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	f := readFile(t, buf.Bytes())
+	le64 := func(v uint64) string { return string(binary.LittleEndian.AppendUint64(nil, v)) }
+	want := [][2]string{
+		{le64(uint64(1<<64 - 3)), le64(12)},
+		{"apple", "pear"},
+		{le64(math.Float64bits(math.Copysign(0, -1))), le64(math.Float64bits(2.5))},
+		{"\x01", "\x01"},
+	}
+	for i, w := range want {
+		stats := f.chunks[i][12].(map[int16]any)
+		if got := [2]string{string(stats[6].([]byte)), string(stats[5].([]byte))}; got != w {
+			t.Errorf("%s: min, max = %q, want %q", f.names[i], got, w)
+		}
+	}
+	if nulls := f.chunks[1][12].(map[int16]any)[3]; nulls != int64(1) {
+		t.Errorf("null count = %v, want 1", nulls)
+	}
+
+	buf.Reset()
+	if err := New(WithStatistics(false)).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	f = readFile(t, buf.Bytes())
+	if stats := f.chunks[1][12].(map[int16]any); stats[3] != int64(1) || stats[5] != nil || stats[6] != nil {
+		t.Errorf("unexpected statistics %v", stats)
+	}
+}
+
+func TestBloomFilters(t *testing.T) {
+	for _, tt := range []struct{ in, want string }{
+		{"", "ef46db3751d8e999"},
+		{"abc", "44bc2cf5ad770999"},
+		{"hello, world", "b33a384e6d1b1242"},
+		{strings.Repeat("x", 33), "b3fa465f554208a6"},
+	} {
+		if got := strconv.FormatUint(xxhash64([]byte(tt.in)), 16); got != tt.want {
+			t.Errorf("xxhash64(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+
+	var data [][]any
+	for i := range 1000 {
+		data = append(data, []any{i, "id-" + strconv.Itoa(i)})
+	}
+	rows := scanner.WithColumnHints(scanner.FromData(data), map[string]map[string]string{
+		"column_1": {scanner.HintBloomFilter: "true"},
+	})
+	var buf bytes.Buffer
+	if err := New().Write(rows, &buf); err != nil {
+		t.Fatal(err)
+	}
+	file := buf.Bytes()
+	f := readFile(t, file)
+	if _, ok := f.chunks[0][14]; ok {
+		t.Error("unexpected bloom filter of column_0")
+	}
+	offset, length := f.chunks[1][14].(int64), f.chunks[1][15].(int64)
+	header, n := readStruct(t, file[offset:])
+	bitset := file[offset+int64(n) : offset+length]
+	if int64(len(bitset)) != header[1].(int64) {
+		t.Fatalf("bloom filter of %d bytes, header %v", len(bitset), header)
+	}
+	contains := func(value string) bool {
+		h := xxhash64([]byte(value))
+		block := bitset[((h>>32)*uint64(len(bitset)/32))>>32*32:]
+		for i, salt := range bloomSalt {
+			if binary.LittleEndian.Uint32(block[i*4:])&(1<<((uint32(h)*salt)>>27)) == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	falsePositives := 0
+	for i := range 1000 {
+		if !contains("id-" + strconv.Itoa(i)) {
+			t.Fatalf("id-%d not found", i)
+		}
+		if contains("other-" + strconv.Itoa(i)) {
+			falsePositives++
+		}
+	}
+	if falsePositives > 50 {
+		t.Errorf("%d false positives in 1000", falsePositives)
+	}
+}
@@ -0,0 +1,401 @@
+// Package parquetcodec provides an Apache Parquet implementation of the Codec interface.
+// This file implements the schema inference and the conversion of values to the column types.
+package parquetcodec
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// maxInt64Precision is the largest decimal precision stored as INT64; decimals
+// of higher precision are stored as BYTE_ARRAY.
+const maxInt64Precision = 18
+
+// newColumn returns the column of the file for col of a source of driver.
+func (c *parquetCodec) newColumn(col scanner.Column, driver string) *column {
+	kind := scanner.ColumnKind(col)
+	el := schemaElement{name: col.Name(), repetition: repetitionOptional, converted: convertedNone}
+	if nullable, ok := col.Nullable(); ok && !nullable {
+		el.repetition = repetitionRequired
+	}
+	var encode func(dst []byte, v any) ([]byte, error)
+	switch kind {
+	case scanner.KindFloat32, scanner.KindFloat64, scanner.KindDecimal, scanner.KindUnknown:
+		if precision, scale, ok := col.DecimalSize(); ok && precision > 0 && scale >= 0 && scale <= precision {
+			el.logical, el.converted = logicalDecimal, convertedDecimal
+			el.precision, el.scale = int32(precision), int32(scale)
+			el.typ, encode = typeByteArray, encodeBigDecimal(el.scale)
+			if precision <= maxInt64Precision {
+				el.typ, encode = typeInt64, encodeDecimal(el.scale)
+			}
+		}
+	}
+	if encode == nil {
+		switch kind {
+		case scanner.KindBoolean:
+			el.typ = typeBoolean
+		case scanner.KindInt16:
+			el.typ, el.converted, el.logical, encode = typeInt32, convertedInt16, logicalInt16, encodeInt32(math.MinInt16, math.MaxInt16)
+		case scanner.KindInt32:
+			el.typ, encode = typeInt32, encodeInt32(math.MinInt32, math.MaxInt32)
+		case scanner.KindInt64:
+			el.typ, encode = typeInt64, encodeInt64
+		case scanner.KindFloat32:
+			el.typ, encode = typeFloat, encodeFloat
+		case scanner.KindFloat64, scanner.KindDecimal:
+			el.typ, encode = typeDouble, encodeDouble
+		case scanner.KindBinary:
+			el.typ, encode = typeByteArray, encodeBytes
+		case scanner.KindDate:
+			el.typ, el.converted, el.logical, encode = typeInt32, convertedDate, logicalDate, encodeDate
+		case scanner.KindTime:
+			el.typ, el.converted, el.logical, encode = typeInt64, convertedTimeMicros, logicalTime, encodeTime
+		case scanner.KindTimestamp:
+			// TIMESTAMP_MICROS implies UTC, so local timestamps only have the logical type.
+			el.typ, el.logical, encode = typeInt64, logicalTimestamp, encodeTimestamp(false)
+		case scanner.KindTimestampTZ:
+			el.typ, el.converted, el.logical, encode = typeInt64, convertedTimestampMicros, logicalTimestampTZ, encodeTimestamp(true)
+		case scanner.KindJSON:
+			el.typ, el.converted, el.logical, encode = typeByteArray, convertedJSON, logicalJSON, c.encodeString(driver, col)
+		default:
+			el.typ, el.converted, el.logical, encode = typeByteArray, convertedUTF8, logicalString, c.encodeString(driver, col)
+		}
+	}
+	column := &column{
+		schemaElement: el,
+		encode:        encode,
+		meta:          columnChunk{path: el.name, typ: el.typ, codec: c.compressionOf(col)},
+	}
+	if el.typ != typeBoolean {
+		if c.dictionaryOf(col) {
+			column.dict, column.dictLimit = newDictionary(), c.pageSize
+		}
+		if c.bloomFilterOf(col) {
+			column.hashes = make(map[uint64]struct{})
+		}
+	}
+	if c.statistics {
+		column.stats = newStatistics(el)
+	}
+	return column
+}
+
+// errNotConvertible is wrapped by the errors of values that cannot be converted
+// to the column type.
+var errNotConvertible = errors.New("cannot convert value")
+
+// convertError returns the error of a value v that cannot be converted to typ.
+func convertError(v any, typ string) error {
+	return fmt.Errorf("%w %v of type %T to %s", errNotConvertible, v, v, typ)
+}
+
+// value returns the value to write for a scanned value: the driver value of
+// driver.Valuer values, and the content of streamed blobs.
+func value(v any) (any, error) {
+	if valuer, ok := v.(driver.Valuer); ok {
+		var err error
+		if v, err = valuer.Value(); err != nil {
+			return nil, err
+		}
+	}
+	if b, ok := v.(scanner.Blob); ok {
+		// Blobs are stored in the page and read fully.
+		return io.ReadAll(b)
+	}
+	return v, nil
+}
+
+// encodeInt32 returns the encoder of INT32 values between lo and hi.
+func encodeInt32(lo, hi int64) func(dst []byte, v any) ([]byte, error) {
+	return func(dst []byte, v any) ([]byte, error) {
+		n, err := toInt64(v)
+		if err != nil || n < lo || n > hi {
+			return dst, convertError(v, "INT32")
+		}
+		return binary.LittleEndian.AppendUint32(dst, uint32(int32(n))), nil
+	}
+}
+
+// encodeInt64 encodes an INT64 value.
+func encodeInt64(dst []byte, v any) ([]byte, error) {
+	n, err := toInt64(v)
+	if err != nil {
+		return dst, convertError(v, "INT64")
+	}
+	return binary.LittleEndian.AppendUint64(dst, uint64(n)), nil
+}
+
+// encodeFloat encodes a FLOAT value.
+func encodeFloat(dst []byte, v any) ([]byte, error) {
+	f, err := toFloat64(v)
+	if err != nil {
+		return dst, convertError(v, "FLOAT")
+	}
+	return binary.LittleEndian.AppendUint32(dst, math.Float32bits(float32(f))), nil
+}
+
+// encodeDouble encodes a DOUBLE value.
+func encodeDouble(dst []byte, v any) ([]byte, error) {
+	f, err := toFloat64(v)
+	if err != nil {
+		return dst, convertError(v, "DOUBLE")
+	}
+	return binary.LittleEndian.AppendUint64(dst, math.Float64bits(f)), nil
+}
+
+// appendByteArray appends a BYTE_ARRAY value.
+func appendByteArray[T string | []byte](dst []byte, b T) []byte {
+	return append(binary.LittleEndian.AppendUint32(dst, uint32(len(b))), b...)
+}
+
+// encodeBytes encodes a binary BYTE_ARRAY value. Values other than byte slices
+// and strings are stored in their string form.
+func encodeBytes(dst []byte, v any) ([]byte, error) {
+	switch v := v.(type) {
+	case []byte:
+		return appendByteArray(dst, v), nil
+	case string:
+		return appendByteArray(dst, v), nil
+	}
+	return appendByteArray(dst, tostring.ToString(v).String), nil
+}
+
+// encodeString returns the encoder of UTF-8 string values of col, which converts
+// values that are not strings with the converter of the codec.
+func (c *parquetCodec) encodeString(driver string, col scanner.Column) func(dst []byte, v any) ([]byte, error) {
+	databaseTypeName := col.DatabaseTypeName()
+	return func(dst []byte, v any) ([]byte, error) {
+		switch v := v.(type) {
+		case string:
+			return appendByteArray(dst, v), nil
+		case []byte:
+			return appendByteArray(dst, v), nil
+		}
+		return appendByteArray(dst, c.converter.ToStringFor(v, driver, databaseTypeName).String), nil
+	}
+}
+
+// encodeDecimal returns the encoder of INT64 decimal values with scale.
+func encodeDecimal(scale int32) func(dst []byte, v any) ([]byte, error) {
+	return func(dst []byte, v any) ([]byte, error) {
+		n, err := unscaled(v, scale)
+		if err != nil || !n.IsInt64() {
+			return dst, convertError(v, "DECIMAL")
+		}
+		return binary.LittleEndian.AppendUint64(dst, uint64(n.Int64())), nil
+	}
+}
+
+// encodeBigDecimal returns the encoder of BYTE_ARRAY decimal values with scale,
+// which hold the unscaled value in big-endian two's complement.
+func encodeBigDecimal(scale int32) func(dst []byte, v any) ([]byte, error) {
+	return func(dst []byte, v any) ([]byte, error) {
+		n, err := unscaled(v, scale)
+		if err != nil {
+			return dst, convertError(v, "DECIMAL")
+		}
+		size := n.BitLen()/8 + 1
+		if n.Sign() < 0 {
+			n.Add(n, new(big.Int).Lsh(big.NewInt(1), uint(8*size)))
+		}
+		return appendByteArray(dst, n.FillBytes(make([]byte, size))), nil
+	}
+}
+
+// unscaled returns v multiplied by 10^scale and rounded half away from zero.
+func unscaled(v any, scale int32) (*big.Int, error) {
+	var s string
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s = strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		// The shortest representation keeps 0.1 from becoming 0.1000000000000000055.
+		s = strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits())
+	case reflect.String:
+		s = rv.String()
+	default:
+		b, ok := v.([]byte)
+		if !ok {
+			return nil, errNotConvertible
+		}
+		s = string(b)
+	}
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return nil, errNotConvertible
+	}
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)))
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if m.Abs(m).Lsh(m, 1).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(r.Num().Sign())))
+	}
+	return q, nil
+}
+
+// encodeDate encodes a DATE value as the days since the Unix epoch.
+func encodeDate(dst []byte, v any) ([]byte, error) {
+	t, err := toTime(v, time.UTC)
+	if err != nil {
+		return dst, convertError(v, "DATE")
+	}
+	y, m, d := t.Date()
+	days := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+	return binary.LittleEndian.AppendUint32(dst, uint32(int32(days))), nil
+}
+
+// encodeTime encodes a TIME value as the microseconds since midnight.
+func encodeTime(dst []byte, v any) ([]byte, error) {
+	var micros int64
+	switch t := v.(type) {
+	case time.Duration:
+		micros = t.Microseconds()
+	case time.Time:
+		micros = timeOfDay(t)
+	default:
+		s, ok := toText(v)
+		if !ok {
+			return dst, convertError(v, "TIME")
+		}
+		parsed, err := time.Parse(time.TimeOnly, s)
+		if err != nil {
+			return dst, convertError(v, "TIME")
+		}
+		micros = timeOfDay(parsed)
+	}
+	return binary.LittleEndian.AppendUint64(dst, uint64(micros)), nil
+}
+
+// timeOfDay returns the microseconds of t since midnight.
+func timeOfDay(t time.Time) int64 {
+	h, m, s := t.Clock()
+	return (int64(h)*3600+int64(m)*60+int64(s))*1e6 + int64(t.Nanosecond()/1000)
+}
+
+// encodeTimestamp returns the encoder of TIMESTAMP values as the microseconds
+// since the Unix epoch. Timestamps adjusted to UTC store the instant, others the
+// wall clock time.
+func encodeTimestamp(adjustedToUTC bool) func(dst []byte, v any) ([]byte, error) {
+	return func(dst []byte, v any) ([]byte, error) {
+		t, err := toTime(v, time.UTC)
+		if err != nil {
+			return dst, convertError(v, "TIMESTAMP")
+		}
+		micros := t.UnixMicro()
+		if !adjustedToUTC {
+			_, offset := t.Zone()
+			micros += int64(offset) * 1e6
+		}
+		return binary.LittleEndian.AppendUint64(dst, uint64(micros)), nil
+	}
+}
+
+// timeLayouts are the layouts of strings converted to dates and timestamps.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", time.DateTime, time.DateOnly}
+
+// toTime converts v to a time, parsing strings without a zone in loc.
+func toTime(v any, loc *time.Location) (time.Time, error) {
+	if t, ok := v.(time.Time); ok {
+		return t, nil
+	}
+	s, ok := toText(v)
+	if !ok {
+		return time.Time{}, errNotConvertible
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errNotConvertible
+}
+
+// toText returns v as trimmed text if it is a string or byte slice.
+func toText(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSpace(v), true
+	case []byte:
+		return strings.TrimSpace(string(v)), true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.String {
+		return strings.TrimSpace(rv.String()), true
+	}
+	return "", false
+}
+
+// toInt64 converts numbers, booleans and numeric text to an int64.
+func toInt64(v any) (int64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u := rv.Uint(); u <= math.MaxInt64 {
+			return int64(u), nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+			return int64(f), nil
+		}
+	case reflect.Bool:
+		if rv.Bool() {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	if s, ok := toText(v); ok {
+		return strconv.ParseInt(s, 10, 64)
+	}
+	return 0, errNotConvertible
+}
+
+// toFloat64 converts numbers and numeric text to a float64.
+func toFloat64(v any) (float64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	}
+	if s, ok := toText(v); ok {
+		return strconv.ParseFloat(s, 64)
+	}
+	return 0, errNotConvertible
+}
+
+// toBool converts booleans, numbers and boolean text to a bool.
+func toBool(v any) (bool, error) {
+	if b, ok := v.(bool); ok {
+		return b, nil
+	}
+	if s, ok := toText(v); ok {
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return false, convertError(v, "BOOLEAN")
+		}
+		return b, nil
+	}
+	n, err := toInt64(v)
+	if err != nil {
+		return false, convertError(v, "BOOLEAN")
+	}
+	return n != 0, nil
+}
@@ -0,0 +1,139 @@
+// Package parquetcodec provides an Apache Parquet implementation of the Codec interface.
+// This file implements the minimum and maximum statistics of column chunks.
+package parquetcodec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+)
+
+// maxStatisticsValue is the size in bytes of the largest value kept as a minimum
+// or maximum; a chunk with a larger value has no minimum and maximum, so that
+// large values such as documents do not bloat the footer.
+const maxStatisticsValue = 4096
+
+// statistics tracks the minimum and maximum of the values of a column chunk in
+// the sort order defined by the column type.
+type statistics struct {
+	typ      int32
+	compare  func(a, b []byte) int // Compares two PLAIN encoded values.
+	min, max []byte
+	found    bool // Whether min and max hold a value.
+	skip     bool // Whether the chunk has a value that is not tracked.
+}
+
+// newStatistics returns the statistics of a column of el, or nil if the order
+// of its values is not supported. Byte arrays are ordered as unsigned bytes,
+// except decimals, whose byte arrays are signed numbers.
+func newStatistics(el schemaElement) *statistics {
+	var compare func(a, b []byte) int
+	switch el.typ {
+	case typeBoolean:
+		compare = bytes.Compare
+	case typeInt32:
+		compare = func(a, b []byte) int {
+			return cmpOrdered(int32(binary.LittleEndian.Uint32(a)), int32(binary.LittleEndian.Uint32(b)))
+		}
+	case typeInt64:
+		compare = func(a, b []byte) int {
+			return cmpOrdered(int64(binary.LittleEndian.Uint64(a)), int64(binary.LittleEndian.Uint64(b)))
+		}
+	case typeFloat:
+		compare = func(a, b []byte) int {
+			return cmpOrdered(math.Float32frombits(binary.LittleEndian.Uint32(a)), math.Float32frombits(binary.LittleEndian.Uint32(b)))
+		}
+	case typeDouble:
+		compare = func(a, b []byte) int {
+			return cmpOrdered(math.Float64frombits(binary.LittleEndian.Uint64(a)), math.Float64frombits(binary.LittleEndian.Uint64(b)))
+		}
+	case typeByteArray:
+		if el.logical == logicalDecimal {
+			return nil
+		}
+		compare = bytes.Compare
+	default:
+		return nil
+	}
+	return &statistics{typ: el.typ, compare: compare}
+}
+
+// cmpOrdered compares two numbers; unlike cmp.Compare, -0 equals +0.
+func cmpOrdered[T int32 | int64 | float32 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// add records a value in the PLAIN encoding without the length of byte arrays.
+func (s *statistics) add(value []byte) {
+	if s.skip || s.isNaN(value) {
+		return
+	}
+	if len(value) > maxStatisticsValue {
+		s.skip = true
+		return
+	}
+	if !s.found {
+		s.min = append(s.min[:0], value...)
+		s.max = append(s.max[:0], value...)
+		s.found = true
+		return
+	}
+	if s.compare(value, s.min) < 0 {
+		s.min = append(s.min[:0], value...)
+	}
+	if s.compare(value, s.max) > 0 {
+		s.max = append(s.max[:0], value...)
+	}
+}
+
+// isNaN reports whether a floating-point value is NaN, which has no order and is
+// left out of the statistics.
+func (s *statistics) isNaN(value []byte) bool {
+	switch s.typ {
+	case typeFloat:
+		f := math.Float32frombits(binary.LittleEndian.Uint32(value))
+		return f != f
+	case typeDouble:
+		f := math.Float64frombits(binary.LittleEndian.Uint64(value))
+		return f != f
+	}
+	return false
+}
+
+// result returns the minimum and maximum of the chunk and whether they are
+// known, and resets the statistics for the next chunk. For floating-point
+// columns, a zero minimum is written as -0 and a zero maximum as +0, as the
+// format requires.
+func (s *statistics) result() (min, max []byte, ok bool) {
+	if s == nil || !s.found || s.skip {
+		if s != nil {
+			s.found, s.skip = false, false
+		}
+		return nil, nil, false
+	}
+	min, max = bytes.Clone(s.min), bytes.Clone(s.max)
+	switch s.typ {
+	case typeFloat:
+		if math.Float32frombits(binary.LittleEndian.Uint32(min)) == 0 {
+			binary.LittleEndian.PutUint32(min, math.Float32bits(float32(math.Copysign(0, -1))))
+		}
+		if math.Float32frombits(binary.LittleEndian.Uint32(max)) == 0 {
+			binary.LittleEndian.PutUint32(max, 0)
+		}
+	case typeDouble:
+		if math.Float64frombits(binary.LittleEndian.Uint64(min)) == 0 {
+			binary.LittleEndian.PutUint64(min, math.Float64bits(math.Copysign(0, -1)))
+		}
+		if math.Float64frombits(binary.LittleEndian.Uint64(max)) == 0 {
+			binary.LittleEndian.PutUint64(max, 0)
+		}
+	}
+	s.found = false
+	return min, max, true
+}
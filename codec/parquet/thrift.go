@@ -0,0 +1,425 @@
+// Package parquetcodec provides an Apache Parquet implementation of the Codec interface.
+// This file implements the file metadata and its Thrift compact protocol encoding.
+package parquetcodec
+
+import "encoding/binary"
+
+// Thrift compact protocol field types.
+const (
+	typeTrue   byte = 1
+	typeFalse  byte = 2
+	typeByte   byte = 3
+	typeI32    byte = 5
+	typeI64    byte = 6
+	typeBinary byte = 8
+	typeList   byte = 9
+	typeStruct byte = 12
+)
+
+// compactWriter encodes Thrift structs with the compact protocol, which Parquet
+// uses for page headers and the file footer.
+type compactWriter struct {
+	buf  []byte
+	last []int16 // The last field id of every open struct.
+}
+
+// begin opens a top-level struct or a struct element of a list.
+func (w *compactWriter) begin() {
+	w.last = append(w.last, 0)
+}
+
+// end closes the innermost struct.
+func (w *compactWriter) end() {
+	w.buf = append(w.buf, 0)
+	w.last = w.last[:len(w.last)-1]
+}
+
+// field writes a field header.
+func (w *compactWriter) field(id int16, typ byte) {
+	last := &w.last[len(w.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = binary.AppendVarint(append(w.buf, typ), int64(id))
+	}
+	*last = id
+}
+
+// structField opens a struct field, closed with end.
+func (w *compactWriter) structField(id int16) {
+	w.field(id, typeStruct)
+	w.begin()
+}
+
+// emptyStruct writes a struct field without fields.
+func (w *compactWriter) emptyStruct(id int16) {
+	w.structField(id)
+	w.end()
+}
+
+// boolField writes a boolean field.
+func (w *compactWriter) boolField(id int16, v bool) {
+	if v {
+		w.field(id, typeTrue)
+	} else {
+		w.field(id, typeFalse)
+	}
+}
+
+// byteField writes an i8 field.
+func (w *compactWriter) byteField(id int16, v int8) {
+	w.field(id, typeByte)
+	w.buf = append(w.buf, byte(v))
+}
+
+// i32Field writes an i32 field.
+func (w *compactWriter) i32Field(id int16, v int32) {
+	w.field(id, typeI32)
+	w.buf = binary.AppendVarint(w.buf, int64(v))
+}
+
+// i64Field writes an i64 field.
+func (w *compactWriter) i64Field(id int16, v int64) {
+	w.field(id, typeI64)
+	w.buf = binary.AppendVarint(w.buf, v)
+}
+
+// stringField writes a string field.
+func (w *compactWriter) stringField(id int16, v string) {
+	w.field(id, typeBinary)
+	w.buf = append(binary.AppendUvarint(w.buf, uint64(len(v))), v...)
+}
+
+// listField writes the header of a list field with n elements of type typ,
+// which the caller writes next.
+func (w *compactWriter) listField(id int16, typ byte, n int) {
+	w.field(id, typeList)
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|typ)
+	} else {
+		w.buf = binary.AppendUvarint(append(w.buf, 0xf0|typ), uint64(n))
+	}
+}
+
+// i32Element writes an i32 list element.
+func (w *compactWriter) i32Element(v int32) {
+	w.buf = binary.AppendVarint(w.buf, int64(v))
+}
+
+// bytesField writes a binary field.
+func (w *compactWriter) bytesField(id int16, v []byte) {
+	w.field(id, typeBinary)
+	w.buf = append(binary.AppendUvarint(w.buf, uint64(len(v))), v...)
+}
+
+// stringElement writes a string list element.
+func (w *compactWriter) stringElement(v string) {
+	w.buf = append(binary.AppendUvarint(w.buf, uint64(len(v))), v...)
+}
+
+// Parquet physical types.
+const (
+	typeBoolean   int32 = 0
+	typeInt32     int32 = 1
+	typeInt64     int32 = 2
+	typeFloat     int32 = 4
+	typeDouble    int32 = 5
+	typeByteArray int32 = 6
+)
+
+// Parquet repetition types.
+const (
+	repetitionRequired int32 = 0
+	repetitionOptional int32 = 1
+)
+
+// Parquet converted types, the legacy annotations still read by older readers.
+const (
+	convertedNone            int32 = -1
+	convertedUTF8            int32 = 0
+	convertedDecimal         int32 = 5
+	convertedDate            int32 = 6
+	convertedTimeMicros      int32 = 8
+	convertedTimestampMicros int32 = 10
+	convertedInt16           int32 = 16
+	convertedJSON            int32 = 19
+)
+
+// Parquet encodings, page types and compression codecs.
+const (
+	encodingPlain         int32 = 0
+	encodingRLE           int32 = 3
+	encodingRLEDictionary int32 = 8
+
+	pageData       int32 = 0
+	pageDictionary int32 = 2
+
+	codecUncompressed int32 = 0
+	codecGzip         int32 = 2
+)
+
+// logicalType is the annotation of a column in the LogicalType union.
+type logicalType int
+
+const (
+	logicalNone logicalType = iota
+	logicalString
+	logicalDecimal
+	logicalDate
+	logicalTime
+	logicalTimestamp
+	logicalTimestampTZ
+	logicalInt16
+	logicalJSON
+)
+
+// schemaElement is a leaf of the schema.
+type schemaElement struct {
+	name       string
+	typ        int32
+	repetition int32
+	converted  int32
+	logical    logicalType
+	precision  int32
+	scale      int32
+}
+
+// encode writes the SchemaElement struct.
+func (s *schemaElement) encode(w *compactWriter) {
+	w.begin()
+	w.i32Field(1, s.typ)
+	w.i32Field(3, s.repetition)
+	w.stringField(4, s.name)
+	if s.converted != convertedNone {
+		w.i32Field(6, s.converted)
+	}
+	if s.logical == logicalDecimal {
+		w.i32Field(7, s.scale)
+		w.i32Field(8, s.precision)
+	}
+	if s.logical != logicalNone {
+		w.structField(10)
+		s.encodeLogical(w)
+		w.end()
+	}
+	w.end()
+}
+
+// encodeLogical writes the field of the LogicalType union.
+func (s *schemaElement) encodeLogical(w *compactWriter) {
+	switch s.logical {
+	case logicalString:
+		w.emptyStruct(1)
+	case logicalDecimal:
+		w.structField(5)
+		w.i32Field(1, s.scale)
+		w.i32Field(2, s.precision)
+		w.end()
+	case logicalDate:
+		w.emptyStruct(6)
+	case logicalTime, logicalTimestamp, logicalTimestampTZ:
+		id := int16(8)
+		if s.logical == logicalTime {
+			id = 7
+		}
+		w.structField(id)
+		w.boolField(1, s.logical != logicalTimestamp)
+		w.structField(2)
+		w.emptyStruct(2) // MICROS
+		w.end()
+		w.end()
+	case logicalInt16:
+		w.structField(10)
+		w.byteField(1, 16)
+		w.boolField(2, true)
+		w.end()
+	case logicalJSON:
+		w.emptyStruct(12)
+	}
+}
+
+// columnChunk is the metadata of the column chunk of a row group.
+type columnChunk struct {
+	path             string
+	typ              int32
+	codec            int32
+	values           int64
+	nulls            int64
+	uncompressedSize int64
+	compressedSize   int64
+	offset           int64 // The offset of the first data page in the file.
+	dictionaryOffset int64 // The offset of the dictionary page, 0 without one.
+
+	min, max      []byte // The PLAIN encoded minimum and maximum, without the length of byte arrays.
+	hasStatistics bool
+
+	bloomFilterOffset int64 // The offset of the bloom filter, 0 without one.
+	bloomFilterLength int32
+}
+
+// start returns the offset of the first page of the column chunk.
+func (c *columnChunk) start() int64 {
+	if c.dictionaryOffset != 0 {
+		return c.dictionaryOffset
+	}
+	return c.offset
+}
+
+// encode writes the ColumnChunk struct with its ColumnMetaData.
+func (c *columnChunk) encode(w *compactWriter) {
+	w.begin()
+	w.i64Field(2, c.start())
+	w.structField(3)
+	w.i32Field(1, c.typ)
+	if c.dictionaryOffset != 0 {
+		w.listField(2, typeI32, 3)
+		w.i32Element(encodingRLEDictionary)
+	} else {
+		w.listField(2, typeI32, 2)
+	}
+	w.i32Element(encodingPlain)
+	w.i32Element(encodingRLE)
+	w.listField(3, typeBinary, 1)
+	w.stringElement(c.path)
+	w.i32Field(4, c.codec)
+	w.i64Field(5, c.values)
+	w.i64Field(6, c.uncompressedSize)
+	w.i64Field(7, c.compressedSize)
+	w.i64Field(9, c.offset)
+	if c.dictionaryOffset != 0 {
+		w.i64Field(11, c.dictionaryOffset)
+	}
+	w.structField(12)
+	w.i64Field(3, c.nulls)
+	if c.hasStatistics {
+		w.bytesField(5, c.max)
+		w.bytesField(6, c.min)
+	}
+	w.end()
+	if c.bloomFilterOffset != 0 {
+		w.i64Field(14, c.bloomFilterOffset)
+		w.i32Field(15, c.bloomFilterLength)
+	}
+	w.end()
+	w.end()
+}
+
+// rowGroup is the metadata of a row group.
+type rowGroup struct {
+	columns []columnChunk
+	rows    int64
+}
+
+// encode writes the RowGroup struct.
+func (g *rowGroup) encode(w *compactWriter) {
+	w.begin()
+	w.listField(1, typeStruct, len(g.columns))
+	var size, compressed int64
+	for i := range g.columns {
+		g.columns[i].encode(w)
+		size += g.columns[i].uncompressedSize
+		compressed += g.columns[i].compressedSize
+	}
+	w.i64Field(2, size)
+	w.i64Field(3, g.rows)
+	if len(g.columns) != 0 {
+		w.i64Field(5, g.columns[0].start())
+	}
+	w.i64Field(6, compressed)
+	w.end()
+}
+
+// fileMetaData is the footer of the file.
+type fileMetaData struct {
+	schema    []schemaElement
+	rowGroups []rowGroup
+	rows      int64
+}
+
+// encode returns the encoded FileMetaData struct.
+func (m *fileMetaData) encode() []byte {
+	w := &compactWriter{}
+	w.begin()
+	w.i32Field(1, 1)
+	w.listField(2, typeStruct, len(m.schema)+1)
+	// The root of the schema is a group of all columns.
+	w.begin()
+	w.stringField(4, "schema")
+	w.i32Field(5, int32(len(m.schema)))
+	w.end()
+	for i := range m.schema {
+		m.schema[i].encode(w)
+	}
+	w.i64Field(3, m.rows)
+	w.listField(4, typeStruct, len(m.rowGroups))
+	for i := range m.rowGroups {
+		m.rowGroups[i].encode(w)
+	}
+	w.stringField(6, createdBy)
+	// All columns use the sort order of their type, which gives a meaning to the
+	// minimum and maximum of the statistics.
+	w.listField(7, typeStruct, len(m.schema))
+	for range m.schema {
+		w.begin()
+		w.emptyStruct(1)
+		w.end()
+	}
+	w.end()
+	return w.buf
+}
+
+// createdBy identifies the writer in the file metadata.
+const createdBy = "go-data-exporter parquetcodec"
+
+// pageHeader returns the encoded PageHeader of a data page with values in the
+// encoding.
+func pageHeader(encoding int32, values, uncompressedSize, compressedSize int) []byte {
+	w := &compactWriter{}
+	w.begin()
+	w.i32Field(1, pageData)
+	w.i32Field(2, int32(uncompressedSize))
+	w.i32Field(3, int32(compressedSize))
+	w.structField(5)
+	w.i32Field(1, int32(values))
+	w.i32Field(2, encoding)
+	w.i32Field(3, encodingRLE)
+	w.i32Field(4, encodingRLE)
+	w.end()
+	w.end()
+	return w.buf
+}
+
+// dictionaryPageHeader returns the encoded PageHeader of a dictionary page of
+// PLAIN encoded values.
+func dictionaryPageHeader(values, uncompressedSize, compressedSize int) []byte {
+	w := &compactWriter{}
+	w.begin()
+	w.i32Field(1, pageDictionary)
+	w.i32Field(2, int32(uncompressedSize))
+	w.i32Field(3, int32(compressedSize))
+	w.structField(7)
+	w.i32Field(1, int32(values))
+	w.i32Field(2, encodingPlain)
+	w.end()
+	w.end()
+	return w.buf
+}
+
+// bloomFilterHeader returns the encoded BloomFilterHeader of a split block bloom
+// filter of size bytes, hashed with xxHash64 and uncompressed.
+func bloomFilterHeader(size int) []byte {
+	w := &compactWriter{}
+	w.begin()
+	w.i32Field(1, int32(size))
+	w.structField(2)
+	w.emptyStruct(1) // BLOCK
+	w.end()
+	w.structField(3)
+	w.emptyStruct(1) // XXHASH
+	w.end()
+	w.structField(4)
+	w.emptyStruct(1) // UNCOMPRESSED
+	w.end()
+	w.end()
+	return w.buf
+}
@@ -0,0 +1,457 @@
+// Package tablecodec provides an implementation of the Codec interface that
+// writes data as an aligned, boxed plain-text table for terminals, similar to the
+// output of psql. Cells are escaped rather than quoted, so that delimiters and
+// line breaks in the data cannot break the layout.
+package tablecodec
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-data-exporter/exporter/internal/rowbuf"
+	"github.com/go-data-exporter/exporter/internal/rowcounter"
+	"github.com/go-data-exporter/exporter/internal/rowiter"
+	"github.com/go-data-exporter/exporter/internal/typecache"
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// Style defines the characters used to draw the borders of a table.
+type Style struct {
+	Horizontal, Vertical               string
+	TopLeft, TopMid, TopRight          string
+	MidLeft, Cross, MidRight           string
+	BottomLeft, BottomMid, BottomRight string
+	Ellipsis                           string // Appended to truncated cells.
+}
+
+var (
+	// ASCII draws tables with ASCII characters only (default).
+	ASCII = Style{
+		Horizontal: "-", Vertical: "|",
+		TopLeft: "+", TopMid: "+", TopRight: "+",
+		MidLeft: "+", Cross: "+", MidRight: "+",
+		BottomLeft: "+", BottomMid: "+", BottomRight: "+",
+		Ellipsis: "...",
+	}
+	// Unicode draws tables with box-drawing characters.
+	Unicode = Style{
+		Horizontal: "─", Vertical: "│",
+		TopLeft: "┌", TopMid: "┬", TopRight: "┐",
+		MidLeft: "├", Cross: "┼", MidRight: "┤",
+		BottomLeft: "└", BottomMid: "┴", BottomRight: "┘",
+		Ellipsis: "…",
+	}
+)
+
+// tableCodec implements the Codec interface for exporting tabular data as a text table.
+type tableCodec struct {
+	customMapper     map[reflect.Type]func(any, scanner.Metadata) tostring.String
+	converter        *tostring.Converter
+	preProcessorFunc func(rowID int, row []string) ([]string, bool)
+
+	style          Style
+	maxColumnWidth int
+	rowNumbers     bool
+	writeHeader    bool
+	writeFooter    bool
+	customHeader   []string
+	nullValue      string
+	limit          int
+}
+
+// Option defines a functional option for configuring the table codec.
+type Option func(*tableCodec)
+
+// New creates a new table codec with the provided options.
+func New(opts ...Option) *tableCodec {
+	c := &tableCodec{
+		customMapper: make(map[reflect.Type]func(any, scanner.Metadata) tostring.String),
+		converter:    tostring.New(),
+		style:        ASCII,
+		writeHeader:  true,
+		writeFooter:  true,
+		limit:        -1,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithCustomType registers a custom string conversion function for a specific Go type.
+func WithCustomType[T any](fn func(v T, metadata scanner.Metadata) tostring.String) Option {
+	return func(c *tableCodec) {
+		var zero T
+		c.customMapper[reflect.TypeOf(zero)] = func(v any, metadata scanner.Metadata) tostring.String {
+			return fn(v.(T), metadata)
+		}
+	}
+}
+
+// WithToString sets the converter used for values without a custom type mapping.
+// It controls time layouts, float formatting, bool literals and []byte encoding.
+func WithToString(converter *tostring.Converter) Option {
+	return func(c *tableCodec) {
+		if converter != nil {
+			c.converter = converter
+		}
+	}
+}
+
+// WithPreProcessorFunc sets a function to preprocess or filter each row before writing.
+// The function receives the row ID and the row values, and can return modified values or skip the row.
+// Row IDs number the written rows starting from 1, so a skipped row passes its ID on to the next row.
+func WithPreProcessorFunc(fn func(rowID int, row []string) ([]string, bool)) Option {
+	return func(c *tableCodec) {
+		c.preProcessorFunc = fn
+	}
+}
+
+// WithStyle sets the characters of the borders, ASCII (default) or Unicode.
+func WithStyle(style Style) Option {
+	return func(c *tableCodec) {
+		c.style = style
+	}
+}
+
+// WithMaxColumnWidth truncates cells and headers wider than width characters,
+// ending them with the ellipsis of the style. A non-positive width means no limit
+// (default).
+func WithMaxColumnWidth(width int) Option {
+	return func(c *tableCodec) {
+		c.maxColumnWidth = width
+	}
+}
+
+// WithRowNumbers controls whether a first column "#" numbers the written rows
+// starting from 1.
+func WithRowNumbers(rowNumbers bool) Option {
+	return func(c *tableCodec) {
+		c.rowNumbers = rowNumbers
+	}
+}
+
+// WithHeader controls whether the table starts with a header row (default true).
+func WithHeader(writeHeader bool) Option {
+	return func(c *tableCodec) {
+		c.writeHeader = writeHeader
+	}
+}
+
+// WithFooter controls whether the table is followed by the number of rows, such
+// as "(3 rows)" (default true).
+func WithFooter(writeFooter bool) Option {
+	return func(c *tableCodec) {
+		c.writeFooter = writeFooter
+	}
+}
+
+// WithCustomHeader sets a custom header to be used instead of automatically derived column names.
+func WithCustomHeader(customHeader []string) Option {
+	return func(c *tableCodec) {
+		c.customHeader = customHeader
+	}
+}
+
+// WithCustomNULL sets the string to be used when representing NULL values in the
+// output (default empty, as in psql).
+func WithCustomNULL(nullValue string) Option {
+	return func(c *tableCodec) {
+		c.nullValue = nullValue
+	}
+}
+
+// WithLimit sets a limit on the number of rows to write. A negative value means no limit.
+// Rows skipped by the preprocessor do not count towards the limit.
+func WithLimit(limit int) Option {
+	return func(c *tableCodec) {
+		c.limit = limit
+	}
+}
+
+// Write writes the scanned rows to the given writer as a table. The width of a
+// column depends on all of its cells, so all rows are held in memory until the
+// table is written; use WithLimit for large sources. Numeric columns, see
+// scanner.ColumnKind, are right-aligned and all other columns left-aligned.
+// Line breaks, tabs and other control characters in cells are escaped, e.g. as
+// \n, and wide characters such as CJK ideographs count as two columns.
+func (c *tableCodec) Write(rows scanner.Rows, writer io.Writer) error {
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	header := make([]string, len(cols))
+	for i, col := range cols {
+		header[i] = col.Name()
+	}
+	if c.customHeader != nil {
+		if len(c.customHeader) != len(cols) {
+			return errors.New("invalid header length")
+		}
+		// The header is escaped in place and must not modify the option.
+		header = append([]string(nil), c.customHeader...)
+	}
+	right := make([]bool, len(cols))
+	for i, col := range cols {
+		right[i] = scanner.ColumnKind(col).IsNumeric()
+	}
+	if c.rowNumbers {
+		header = append([]string{"#"}, header...)
+		right = append([]bool{true}, right...)
+	}
+	header = c.cells(header)
+
+	var table [][]string
+	counter := rowcounter.New(rows, c.limit)
+	if !counter.Done() {
+		if table, err = c.readRows(rows, cols, counter); err != nil {
+			return err
+		}
+	}
+
+	widths := make([]int, len(header))
+	if c.writeHeader {
+		for i, cell := range header {
+			widths[i] = displayWidth(cell)
+		}
+	}
+	for _, row := range table {
+		for i, cell := range row {
+			widths[i] = max(widths[i], displayWidth(cell))
+		}
+	}
+
+	w := bufio.NewWriter(writer)
+	if len(header) != 0 {
+		c.writeRule(w, widths, c.style.TopLeft, c.style.TopMid, c.style.TopRight)
+		if c.writeHeader {
+			c.writeRow(w, header, widths, nil)
+			c.writeRule(w, widths, c.style.MidLeft, c.style.Cross, c.style.MidRight)
+		}
+		for _, row := range table {
+			c.writeRow(w, row, widths, right)
+		}
+		c.writeRule(w, widths, c.style.BottomLeft, c.style.BottomMid, c.style.BottomRight)
+	}
+	if c.writeFooter {
+		if len(table) == 1 {
+			w.WriteString("(1 row)\n")
+		} else {
+			fmt.Fprintf(w, "(%d rows)\n", len(table))
+		}
+	}
+	return w.Flush()
+}
+
+// readRows converts the rows to escaped and truncated cells, including the row
+// number if enabled.
+func (c *tableCodec) readRows(rows scanner.Rows, cols []scanner.Column, counter *rowcounter.Counter) ([][]string, error) {
+	driver := rows.Driver()
+	nullValue := c.nullValue
+	if scanner.NullPolicyOf(rows) != scanner.NullDefault {
+		nullValue = ""
+	}
+	mappers := typecache.New(c.customMapper, len(cols))
+	buf := rowbuf.Get()
+	defer rowbuf.Put(buf)
+	var table [][]string
+	it := rowiter.New(rows)
+	for it.Next() {
+		values, err := it.ScanRow()
+		if err != nil {
+			return nil, err
+		}
+		rowID := counter.Scan()
+		buf.Reset()
+		for i, v := range values {
+			if b, ok := v.(scanner.Blob); ok {
+				// Blobs cannot be streamed into a table and are read fully.
+				if v, err = io.ReadAll(b); err != nil {
+					return nil, err
+				}
+			}
+			meta := scanner.Metadata{
+				RowID:  rowID,
+				Driver: driver,
+				Column: cols[i],
+			}
+			meta.Raw, _ = it.RawValue(i)
+			fn, _ := mappers.Lookup(i, v)
+			c.appendCell(buf, v, fn, meta, nullValue)
+		}
+		row := buf.Strings(nil)
+		writeRow := true
+		if c.preProcessorFunc != nil {
+			row, writeRow = c.preProcessorFunc(rowID, row)
+		}
+		if !writeRow {
+			continue
+		}
+		if len(row) != len(cols) {
+			return nil, fmt.Errorf("could not write %d row: %d cells for %d columns", rowID, len(row), len(cols))
+		}
+		if c.rowNumbers {
+			row = append([]string{fmt.Sprint(counter.Written() + 1)}, row...)
+		}
+		table = append(table, c.cells(row))
+		if counter.Write() {
+			return table, nil
+		}
+	}
+	return table, it.Err()
+}
+
+// appendCell converts a single value and appends it to buf,
+// using the custom type mapper fn if not nil, or falling back to the default converter.
+// If the value is NULL, nullValue is appended.
+func (c *tableCodec) appendCell(buf *rowbuf.Row, v any, fn func(any, scanner.Metadata) tostring.String, metadata scanner.Metadata, nullValue string) {
+	if v != nil && fn != nil {
+		buf.AppendString(fn(v, metadata), nullValue)
+		return
+	}
+	buf.AppendValue(c.converter, v, metadata.Driver, metadata.DatabaseTypeName(), nullValue)
+}
+
+// cells escapes and truncates the cells of row in place and returns it.
+func (c *tableCodec) cells(row []string) []string {
+	for i, cell := range row {
+		row[i] = c.truncate(escape(cell))
+	}
+	return row
+}
+
+// truncate shortens s to the maximum column width, ending it with the ellipsis of
+// the style.
+func (c *tableCodec) truncate(s string) string {
+	if c.maxColumnWidth <= 0 || displayWidth(s) <= c.maxColumnWidth {
+		return s
+	}
+	ellipsis := c.style.Ellipsis
+	keep := c.maxColumnWidth - displayWidth(ellipsis)
+	if keep < 0 {
+		keep, ellipsis = c.maxColumnWidth, ""
+	}
+	width := 0
+	for i, r := range s {
+		if width+runeWidth(r) > keep {
+			return s[:i] + ellipsis
+		}
+		width += runeWidth(r)
+	}
+	return s
+}
+
+// writeRule writes a horizontal border line.
+func (c *tableCodec) writeRule(w *bufio.Writer, widths []int, left, mid, right string) {
+	w.WriteString(left)
+	for i, width := range widths {
+		if i > 0 {
+			w.WriteString(mid)
+		}
+		w.WriteString(strings.Repeat(c.style.Horizontal, width+2))
+	}
+	w.WriteString(right)
+	w.WriteByte('\n')
+}
+
+// writeRow writes a row of cells padded to the column widths. Cells of columns
+// with right set are right-aligned; a nil right centers all cells, as in headers.
+func (c *tableCodec) writeRow(w *bufio.Writer, row []string, widths []int, right []bool) {
+	w.WriteString(c.style.Vertical)
+	for i, cell := range row {
+		pad := widths[i] - displayWidth(cell)
+		before := 0
+		switch {
+		case right == nil:
+			before = pad / 2
+		case right[i]:
+			before = pad
+		}
+		w.WriteByte(' ')
+		w.WriteString(strings.Repeat(" ", before))
+		w.WriteString(cell)
+		w.WriteString(strings.Repeat(" ", pad-before))
+		w.WriteByte(' ')
+		w.WriteString(c.style.Vertical)
+	}
+	w.WriteByte('\n')
+}
+
+// escape replaces the control characters of s with escape sequences, so that
+// every cell is a single line of printable characters.
+func escape(s string) string {
+	if !strings.ContainsFunc(s, unicode.IsControl) {
+		return s
+	}
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case unicode.IsControl(r):
+			fmt.Fprintf(&b, `\x%02x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// displayWidth returns the number of terminal columns s occupies.
+func displayWidth(s string) int {
+	if isASCII(s) {
+		return len(s)
+	}
+	width := 0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// isASCII reports whether s consists of ASCII characters only.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// runeWidth returns the number of terminal columns r occupies: 0 for combining
+// marks and zero-width characters, 2 for East Asian wide and fullwidth
+// characters and emoji, and 1 otherwise.
+func runeWidth(r rune) int {
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me) || r == '\u200b' || r == '\u200d' || r == '\ufeff':
+		return 0
+	case r >= 0x1100 && r <= 0x115f, // Hangul Jamo
+		r >= 0x2e80 && r <= 0x303e, // CJK radicals and punctuation
+		r >= 0x3041 && r <= 0x33ff, // Kana and CJK symbols
+		r >= 0x3400 && r <= 0x4dbf, // CJK extension A
+		r >= 0x4e00 && r <= 0x9fff, // CJK unified ideographs
+		r >= 0xa000 && r <= 0xa4cf, // Yi
+		r >= 0xac00 && r <= 0xd7a3, // Hangul syllables
+		r >= 0xf900 && r <= 0xfaff, // CJK compatibility ideographs
+		r >= 0xfe30 && r <= 0xfe4f, // CJK compatibility forms
+		r >= 0xff00 && r <= 0xff60, // Fullwidth forms
+		r >= 0xffe0 && r <= 0xffe6,
+		r >= 0x1f300 && r <= 0x1f64f, // Emoji
+		r >= 0x1f900 && r <= 0x1f9ff,
+		r >= 0x20000 && r <= 0x3fffd: // CJK extensions B and later
+		return 2
+	}
+	return 1
+}
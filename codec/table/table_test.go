@@ -0,0 +1,98 @@
+package tablecodec
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+func TestWrite(t *testing.T) {
+	data := [][]any{{1, "alice, bob"}, {22, nil}, {333, "line 1\nline 2"}}
+	var buf bytes.Buffer
+	if err := New(WithCustomNULL("NULL")).Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `+----------+----------------+
+| column_0 |    column_1    |
++----------+----------------+
+|        1 | alice, bob     |
+|       22 | NULL           |
+|      333 | line 1\nline 2 |
++----------+----------------+
+(3 rows)
+`
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteUnicode(t *testing.T) {
+	data := [][]any{{"漢字"}, {"a very long value"}}
+	var buf bytes.Buffer
+	c := New(WithStyle(Unicode), WithMaxColumnWidth(8), WithRowNumbers(true), WithCustomHeader([]string{"name"}), WithLimit(2))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `┌───┬──────────┐
+│ # │   name   │
+├───┼──────────┤
+│ 1 │ 漢字     │
+│ 2 │ a very … │
+└───┴──────────┘
+(2 rows)
+`
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteOptions(t *testing.T) {
+	data := [][]any{{"a"}, {"b"}, {"c"}}
+	var buf bytes.Buffer
+	c := New(WithHeader(false), WithFooter(false), WithPreProcessorFunc(func(_ int, row []string) ([]string, bool) {
+		return []string{strings.ToUpper(row[0])}, row[0] != "b"
+	}))
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if want := "+---+\n| A |\n| C |\n+---+\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := New().Write(scanner.FromData(data[:1]), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(buf.String(), "\n(1 row)\n") {
+		t.Errorf("got %q, want a singular footer", buf.String())
+	}
+
+	c = New(WithPreProcessorFunc(func(_ int, row []string) ([]string, bool) {
+		return append(row, "extra"), true
+	}))
+	if err := c.Write(scanner.FromData(data), &buf); err == nil {
+		t.Error("expected an error for a row with too many cells")
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+		style Style
+		want  string
+	}{
+		{"abcdef", 6, ASCII, "abcdef"},
+		{"abcdefg", 6, ASCII, "abc..."},
+		{"abcdefg", 2, ASCII, "ab"},
+		{"日本語テキスト", 5, Unicode, "日本…"},
+	}
+	for _, tt := range tests {
+		c := New(WithMaxColumnWidth(tt.width), WithStyle(tt.style))
+		if got := c.truncate(tt.s); got != tt.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+		}
+	}
+}
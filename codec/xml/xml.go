@@ -6,8 +6,17 @@ package xmlcodec
 import (
 	"encoding/xml"
 	"io"
+	"maps"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/go-data-exporter/exporter/charset"
+	"github.com/go-data-exporter/exporter/internal/rowbuf"
+	"github.com/go-data-exporter/exporter/internal/rowcounter"
+	"github.com/go-data-exporter/exporter/internal/rowiter"
+	"github.com/go-data-exporter/exporter/internal/typecache"
 	"github.com/go-data-exporter/exporter/scanner"
 	"github.com/go-data-exporter/exporter/tostring"
 )
@@ -15,8 +24,14 @@ import (
 // xmlCodec implements the Codec interface to export tabular data as XML.
 type xmlCodec struct {
 	customMapper     map[reflect.Type]func(any, scanner.Metadata) tostring.String
+	converter        *tostring.Converter
 	preProcessorFunc func(rowID int, row []string) ([]string, bool)
 	limit            int
+
+	rowNumbers      bool
+	driverAttribute bool
+	rowAttributes   map[string]string
+	charset         *charset.Charset
 }
 
 // Option defines a functional configuration option for xmlCodec.
@@ -26,6 +41,7 @@ type Option func(*xmlCodec)
 func New(opts ...Option) *xmlCodec {
 	c := &xmlCodec{
 		customMapper: make(map[reflect.Type]func(any, scanner.Metadata) tostring.String),
+		converter:    tostring.New(),
 		limit:        -1,
 	}
 	for _, opt := range opts {
@@ -48,23 +64,74 @@ func WithCustomType[T any](fn func(v T, metadata scanner.Metadata) tostring.Stri
 	}
 }
 
+// WithToString sets the converter used for values without a custom type mapping.
+// It controls time layouts, float formatting, bool literals and []byte encoding.
+func WithToString(converter *tostring.Converter) Option {
+	return func(c *xmlCodec) {
+		if converter != nil {
+			c.converter = converter
+		}
+	}
+}
+
 // WithPreProcessorFunc sets a function to preprocess or filter each row before writing.
+// Row IDs number the written rows starting from 1, so a skipped row passes its ID on to the next row.
 func WithPreProcessorFunc(fn func(rowID int, row []string) ([]string, bool)) Option {
 	return func(c *xmlCodec) {
 		c.preProcessorFunc = fn
 	}
 }
 
+// WithCharset writes the document in a legacy charset such as charset.Windows1252
+// or charset.ShiftJIS instead of UTF-8 (default) and declares it in the XML
+// declaration. Characters the charset cannot encode in text and attribute values
+// are written as character references; column names must be representable.
+func WithCharset(cs *charset.Charset) Option {
+	return func(c *xmlCodec) {
+		c.charset = cs
+	}
+}
+
 // WithLimit sets a limit on the number of rows to write. Negative means unlimited.
+// Rows skipped by the preprocessor do not count towards the limit.
 func WithLimit(limit int) Option {
 	return func(c *xmlCodec) {
 		c.limit = limit
 	}
 }
 
+// WithRowNumbers adds the number of the row in the source as an id attribute to
+// every row element, e.g. <row id="42">, so that consumers can correlate rows with
+// their position in the feed. Rows skipped by the preprocessor are counted, so the
+// numbers match the source rows.
+func WithRowNumbers(rowNumbers bool) Option {
+	return func(c *xmlCodec) {
+		c.rowNumbers = rowNumbers
+	}
+}
+
+// WithDriverAttribute adds the driver of the source as a driver attribute to every row element.
+func WithDriverAttribute(driverAttribute bool) Option {
+	return func(c *xmlCodec) {
+		c.driverAttribute = driverAttribute
+	}
+}
+
+// WithRowAttributes adds fixed attributes to every row element, e.g. the name of
+// the source. They follow the id and driver attributes, ordered by name. Invalid
+// names are sanitized like element names; attributes whose name is already used
+// are dropped.
+func WithRowAttributes(attributes map[string]string) Option {
+	return func(c *xmlCodec) {
+		c.rowAttributes = attributes
+	}
+}
+
 // Write writes the scanned rows as an XML table to the provided writer.
 // It supports headers, NULL styling, row limits, and optional preprocessing.
-func (c *xmlCodec) Write(rows scanner.Rows, writer io.Writer) error {
+// The document is written with an xml.Encoder, so it is always well-formed:
+// element and attribute names are sanitized and text is escaped.
+func (c *xmlCodec) Write(rows scanner.Rows, writer io.Writer) (err error) {
 	if c.limit == 0 {
 		return nil
 	}
@@ -72,61 +139,199 @@ func (c *xmlCodec) Write(rows scanner.Rows, writer io.Writer) error {
 	if err != nil {
 		return err
 	}
-	rowID := 0
+	enc := xml.NewEncoder(charset.NewWriter(writer, c.charset, charset.CharacterReference))
+	counter := rowcounter.New(rows, c.limit)
 	defer func() {
-		if rowID > 0 {
-			writer.Write([]byte("</data>\n"))
+		var closeErr error
+		if counter.Written() > 0 {
+			closeErr = encodeTokens(enc, xml.EndElement{Name: dataName}, newline)
+		}
+		if flushErr := enc.Flush(); closeErr == nil {
+			closeErr = flushErr
+		}
+		if err == nil {
+			err = closeErr
 		}
 	}()
-	for rows.Next() {
-		values, err := rows.ScanRow()
+	names := make([]xml.Name, len(cols))
+	for i, col := range cols {
+		names[i] = xml.Name{Local: elementName(col.Name())}
+	}
+	driver := rows.Driver()
+	attrs := c.fixedAttributes(driver)
+	markNull := scanner.NullPolicyOf(rows) == scanner.NullMarked
+	data := xml.StartElement{Name: dataName}
+	if markNull {
+		data.Attr = []xml.Attr{{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace}}
+	}
+	mappers := typecache.New(c.customMapper, len(cols))
+	buf := rowbuf.Get()
+	defer rowbuf.Put(buf)
+	var row []string
+	sourceRow := 0 // The number of the row in the source for WithRowNumbers.
+	it := rowiter.New(rows)
+	for it.Next() {
+		values, err := it.ScanRow()
 		if err != nil {
 			return err
 		}
-		row := make([]string, len(values))
-		for i := range values {
+		rowID := counter.Scan()
+		sourceRow++
+		buf.Reset()
+		for i, v := range values {
+			if b, ok := v.(scanner.Blob); ok {
+				// Blobs cannot be streamed into XML elements and are read fully.
+				if v, err = io.ReadAll(b); err != nil {
+					return err
+				}
+			}
 			meta := scanner.Metadata{
-				RowID:  rowID + 1,
-				Driver: rows.Driver(),
+				RowID:  rowID,
+				Driver: driver,
 				Column: cols[i],
 			}
-			s := c.toString(values[i], meta)
-			if s.IsNULL {
-				values[i] = nil
+			meta.Raw, _ = it.RawValue(i)
+			fn, _ := mappers.Lookup(i, v)
+			if v != nil && fn != nil {
+				buf.AppendString(fn(v, meta), "")
+				continue
 			}
-			row[i] = s.String
+			buf.AppendValue(c.converter, v, driver, meta.DatabaseTypeName(), "")
+		}
+		if c.preProcessorFunc != nil {
+			// The preprocessor may retain the row, so it is not reused.
+			row = nil
 		}
+		row = buf.Strings(row)
 
 		writeRow := true
 		if c.preProcessorFunc != nil {
-			row, writeRow = c.preProcessorFunc(rowID+1, row)
+			row, writeRow = c.preProcessorFunc(rowID, row)
 		}
 		if !writeRow {
 			continue
 		}
-		if rowID == 0 {
-			writer.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>`))
-			writer.Write([]byte("\n<data>\n"))
+		if counter.Written() == 0 {
+			header := xml.ProcInst{Target: "xml", Inst: []byte(`version="1.0" encoding="` + c.charset.Name() + `"`)}
+			if err := encodeTokens(enc, header, newline, data, newline); err != nil {
+				return err
+			}
+		}
+		start := xml.StartElement{Name: rowName, Attr: attrs}
+		if c.rowNumbers {
+			start.Attr = append([]xml.Attr{{Name: xml.Name{Local: "id"}, Value: strconv.Itoa(sourceRow)}}, attrs...)
+		}
+		if err := enc.EncodeToken(start); err != nil {
+			return err
 		}
-		writer.Write([]byte("<row>"))
 		for i := range row {
-			if values[i] == nil {
+			if i >= len(cols) {
 				continue
 			}
-			colName := cols[i].Name()
-			writer.Write([]byte("<" + colName + ">"))
-			xml.EscapeText(writer, []byte(row[i]))
-			writer.Write([]byte("</" + colName + ">"))
-
+			if buf.IsNULL(i) {
+				if markNull {
+					nilElement := xml.StartElement{Name: names[i], Attr: xsiNil}
+					if err := encodeTokens(enc, nilElement, nilElement.End()); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			err := encodeTokens(enc, xml.StartElement{Name: names[i]}, xml.CharData(row[i]), xml.EndElement{Name: names[i]})
+			if err != nil {
+				return err
+			}
 		}
-		writer.Write([]byte("</row>\n"))
-		rowID++
-		if c.limit >= 0 && rowID >= c.limit {
+		if err := encodeTokens(enc, start.End(), newline); err != nil {
+			return err
+		}
+		if counter.Write() {
 			return nil
 		}
 	}
 
-	return rows.Err()
+	return it.Err()
+}
+
+// TrailerLine implements codec.Trailer with an XML comment, which may follow the
+// root element.
+func (c *xmlCodec) TrailerLine(text string) (string, bool) {
+	return "<!-- " + text + " -->\n", true
+}
+
+// Names and tokens of the document structure.
+var (
+	dataName = xml.Name{Local: "data"}
+	rowName  = xml.Name{Local: "row"}
+	newline  = xml.CharData("\n")
+
+	// xsiNamespace and xsiNil mark NULL values with the NullMarked policy.
+	xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+	xsiNil       = []xml.Attr{{Name: xml.Name{Local: "xsi:nil"}, Value: "true"}}
+)
+
+// encodeTokens encodes the tokens in order.
+func encodeTokens(enc *xml.Encoder, tokens ...xml.Token) error {
+	for _, tok := range tokens {
+		if err := enc.EncodeToken(tok); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fixedAttributes returns the driver and user-defined row attributes.
+func (c *xmlCodec) fixedAttributes(driver string) []xml.Attr {
+	var attrs []xml.Attr
+	seen := map[string]bool{"id": c.rowNumbers}
+	addAttr := func(name, value string) {
+		name = elementName(name)
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+		attrs = append(attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+	}
+	if c.driverAttribute {
+		addAttr("driver", driver)
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.rowAttributes)) {
+		addAttr(name, c.rowAttributes[name])
+	}
+	return attrs
+}
+
+// elementName returns name as a valid XML element name. Characters that are not
+// allowed in names, including the namespace separator ':', are replaced with '_',
+// and '_' is prepended if the name does not start with a letter or '_'.
+func elementName(name string) string {
+	if !strings.Contains(name, ":") && isName(name) {
+		return name
+	}
+	var b strings.Builder
+	for _, r := range name {
+		if r == ':' || !isName("a"+string(r)) {
+			r = '_'
+		}
+		if b.Len() == 0 && !isName(string(r)) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
+
+// isName reports whether s is accepted as an element name by the XML decoder.
+func isName(s string) bool {
+	if s == "" {
+		return false
+	}
+	tok, err := xml.NewDecoder(strings.NewReader("<" + s + "/>")).Token()
+	start, ok := tok.(xml.StartElement)
+	return err == nil && ok && start.Name.Space == "" && start.Name.Local == s
 }
 
 // toString converts a value to a string using a custom mapper if available,
@@ -138,5 +343,5 @@ func (c *xmlCodec) toString(v any, metadata scanner.Metadata) tostring.String {
 	if fn, ok := c.customMapper[reflect.TypeOf(v)]; ok {
 		return fn(v, metadata)
 	}
-	return tostring.ToString(v)
+	return c.converter.ToStringFor(v, metadata.Driver, metadata.DatabaseTypeName())
 }
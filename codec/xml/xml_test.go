@@ -2,11 +2,14 @@ package xmlcodec
 
 import (
 	"bytes"
+	"encoding/xml"
+	"io"
 	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-data-exporter/exporter/charset"
 	"github.com/go-data-exporter/exporter/scanner"
 	"github.com/go-data-exporter/exporter/tostring"
 )
@@ -257,3 +260,109 @@ func TestWriteEmpty(t *testing.T) {
 		t.Error("limit 0 should produce no output")
 	}
 }
+
+func FuzzWrite(f *testing.F) {
+	for seed := range uint64(8) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed uint64) {
+		var buf bytes.Buffer
+		// Flattening turns the keys of nested values into adversarial column names.
+		rows := scanner.Flatten(scanner.Fuzz(seed), ".", 0)
+		if err := New().Write(rows, &buf); err != nil {
+			t.Fatal(err)
+		}
+		dec := xml.NewDecoder(&buf)
+		for {
+			_, err := dec.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("invalid XML: %v", err)
+			}
+		}
+	})
+}
+
+func TestElementName(t *testing.T) {
+	tests := map[string]string{
+		"id":         "id",
+		"first name": "first_name",
+		"1st":        "_1st",
+		"a<b>&c":     "a_b__c",
+		"ns:key":     "ns_key",
+		"größe":      "größe",
+		"":           "_",
+	}
+	for name, want := range tests {
+		if got := elementName(name); got != want {
+			t.Errorf("elementName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestRowAttributes(t *testing.T) {
+	data := [][]any{{1}, {2}, {3}}
+	c := New(
+		WithRowNumbers(true),
+		WithDriverAttribute(true),
+		WithRowAttributes(map[string]string{"source": `crm "eu"`, "id": "dropped", "1st": "x"}),
+		WithPreProcessorFunc(func(_ int, row []string) ([]string, bool) {
+			return row, row[0] != "2"
+		}),
+	)
+	var buf bytes.Buffer
+	if err := c.Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	want := `<row id="3" driver="go-slice" _1st="x" source="crm &#34;eu&#34;"><column_0>3</column_0></row>`
+	if !strings.Contains(output, want) || strings.Contains(output, `id="2"`) {
+		t.Errorf("unexpected row attributes: %s", output)
+	}
+	dec := xml.NewDecoder(&buf)
+	for {
+		if _, err := dec.Token(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("invalid XML: %v", err)
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func TestWriteReturnsWriterError(t *testing.T) {
+	data := [][]any{{"line\r\nbreak"}}
+	if err := New().Write(scanner.FromData(data), failingWriter{}); err != io.ErrClosedPipe {
+		t.Errorf("expected the writer error, got %v", err)
+	}
+	var buf bytes.Buffer
+	if err := New().Write(scanner.FromData(data), &buf); err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Rows []struct {
+			Value string `xml:"column_0"`
+		} `xml:"row"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil || len(doc.Rows) != 1 || doc.Rows[0].Value != "line\r\nbreak" {
+		t.Errorf("value not preserved: %+v, %v", doc, err)
+	}
+}
+
+func TestWithCharset(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New(WithCharset(charset.ShiftJIS)).Write(scanner.FromData([][]any{{"日本€"}}), &buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `<?xml version="1.0" encoding="Shift_JIS"?>` + "\n<data>\n<row><column_0>\x93\xfa\x96\x7b&#8364;</column_0></row>\n</data>\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
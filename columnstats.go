@@ -0,0 +1,117 @@
+// This file implements the collection of per-column statistics during an export.
+
+package exporter
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/go-data-exporter/exporter/internal/colstats"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// ColumnStats describes the values of a single column seen during an export.
+type ColumnStats struct {
+	Name             string `json:"name"`
+	Count            int64  `json:"count"`             // Number of non-NULL values.
+	Nulls            int64  `json:"nulls"`             // Number of NULL values.
+	DistinctEstimate uint64 `json:"distinct_estimate"` // Estimated number of distinct non-NULL values.
+	MaxLength        int    `json:"max_length"`        // Maximum length in characters of the string form of the values.
+	Min              any    `json:"min,omitempty"`     // Smallest number, time or string value, if any.
+	Max              any    `json:"max,omitempty"`     // Largest number, time or string value, if any.
+
+	values colstats.Column
+}
+
+// WithColumnStats collects statistics about the values of every column while the
+// rows are exported and returns them in Stats.Columns, so that data-quality reports
+// do not need a second scan. The distinct count is a HyperLogLog estimate with an
+// error of about 1%; min and max are tracked for numbers, times and strings.
+func WithColumnStats(collect bool) Option {
+	return func(e *Exporter) {
+		e.columnStats = collect
+	}
+}
+
+// WithColumnStatsFile collects column statistics like WithColumnStats and writes
+// them as a JSON array to the named sidecar file after a successful export.
+func WithColumnStatsFile(filename string) Option {
+	return func(e *Exporter) {
+		e.columnStats = true
+		e.columnStatsFile = filename
+	}
+}
+
+// writeColumnStatsFile writes the column statistics to the configured sidecar file.
+func (cs *Exporter) writeColumnStatsFile(stats []ColumnStats) error {
+	if cs.columnStatsFile == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(cs.columnStatsFile, append(data, '\n'), 0o644)
+}
+
+// columnStatsRows wraps a Rows and collects statistics about the scanned values.
+type columnStatsRows struct {
+	scanner.Rows
+
+	stats []ColumnStats
+}
+
+// Columns returns the column metadata and prepares the statistics.
+func (c *columnStatsRows) Columns() ([]scanner.Column, error) {
+	cols, err := c.Rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if c.stats == nil {
+		c.stats = make([]ColumnStats, len(cols))
+		for i, col := range cols {
+			c.stats[i].Name = col.Name()
+		}
+	}
+	return cols, nil
+}
+
+// ScanRow returns the current row and adds its values to the statistics.
+func (c *columnStatsRows) ScanRow() ([]any, error) {
+	row, err := c.Rows.ScanRow()
+	if err != nil {
+		return nil, err
+	}
+	if c.stats == nil {
+		if _, err := c.Columns(); err != nil {
+			return nil, err
+		}
+	}
+	for i, v := range row {
+		if i < len(c.stats) {
+			c.stats[i].values.Add(v)
+		}
+	}
+	return row, nil
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (c *columnStatsRows) RawValue(column int) (any, bool) {
+	return scanner.RawValue(c.Rows, column)
+}
+
+// EstimateRows returns the row estimate of the underlying rows.
+func (c *columnStatsRows) EstimateRows() (int64, bool) {
+	return scanner.EstimateRows(c.Rows)
+}
+
+// result returns the collected statistics.
+func (c *columnStatsRows) result() []ColumnStats {
+	for i := range c.stats {
+		s := &c.stats[i]
+		s.Count, s.Nulls, s.MaxLength = s.values.Count, s.values.Nulls, s.values.MaxLength
+		s.Min, s.Max = s.values.Min, s.values.Max
+		s.DistinctEstimate = s.values.Distinct()
+	}
+	return c.stats
+}
@@ -0,0 +1,175 @@
+// This file implements output compression, including parallel gzip compression.
+
+package exporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"sync"
+)
+
+// Compression identifies the compression applied to the export output.
+type Compression int
+
+const (
+	// CompressionNone writes the output uncompressed (default).
+	CompressionNone Compression = iota
+	// CompressionGzip compresses the output with gzip.
+	CompressionGzip
+)
+
+// defaultCompressionBlockSize is the amount of uncompressed data compressed by one
+// worker of the parallel compressor.
+const defaultCompressionBlockSize = 1 << 20
+
+// WithCompression compresses the export output with the given compression and level,
+// e.g. gzip.BestSpeed or gzip.DefaultCompression. Stats.Bytes and WithMaxBytes
+// count compressed bytes.
+func WithCompression(compression Compression, level int) Option {
+	return func(e *Exporter) {
+		e.compression = compression
+		e.compressionLevel = level
+	}
+}
+
+// WithCompressionConcurrency compresses the output on up to n goroutines, so that
+// compression does not become the bottleneck of large exports. The output is split
+// into blocks of blockSize bytes (1 MiB if non-positive) that are compressed
+// independently and written in order as consecutive gzip members, which standard
+// gzip readers decompress as a single stream. The compression ratio is slightly
+// lower than with a single compressor. A value of n below 2 disables parallel compression.
+func WithCompressionConcurrency(n, blockSize int) Option {
+	return func(e *Exporter) {
+		e.compressionConcurrency = n
+		e.compressionBlockSize = blockSize
+	}
+}
+
+// compressor returns the writer the export output is written to and a function
+// that flushes and finishes the compressed stream.
+func (cs *Exporter) compressor(writer io.Writer) (io.Writer, func() error, error) {
+	if cs.compression != CompressionGzip {
+		return writer, func() error { return nil }, nil
+	}
+	if cs.compressionConcurrency > 1 {
+		blockSize := cs.compressionBlockSize
+		if blockSize <= 0 {
+			blockSize = defaultCompressionBlockSize
+		}
+		if _, err := gzip.NewWriterLevel(io.Discard, cs.compressionLevel); err != nil {
+			return nil, nil, err
+		}
+		w := newParallelGzipWriter(writer, cs.compressionLevel, blockSize, cs.compressionConcurrency)
+		return w, w.Close, nil
+	}
+	w, err := gzip.NewWriterLevel(writer, cs.compressionLevel)
+	if err != nil {
+		return nil, nil, err
+	}
+	return w, w.Close, nil
+}
+
+// parallelGzipWriter compresses fixed-size blocks concurrently and writes them
+// to the underlying writer in order, each as a separate gzip member.
+type parallelGzipWriter struct {
+	w         io.Writer
+	level     int
+	blockSize int
+
+	buf     []byte
+	blocks  int
+	pending chan chan []byte
+	done    chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+// newParallelGzipWriter starts a parallel gzip writer with up to concurrency blocks in flight.
+func newParallelGzipWriter(w io.Writer, level, blockSize, concurrency int) *parallelGzipWriter {
+	p := &parallelGzipWriter{
+		w:         w,
+		level:     level,
+		blockSize: blockSize,
+		buf:       make([]byte, 0, blockSize),
+		pending:   make(chan chan []byte, concurrency),
+		done:      make(chan struct{}),
+	}
+	go p.writeLoop()
+	return p
+}
+
+// Write buffers p and hands every full block over to a compressing goroutine.
+func (p *parallelGzipWriter) Write(b []byte) (int, error) {
+	if err := p.error(); err != nil {
+		return 0, err
+	}
+	n := len(b)
+	for len(b) > 0 {
+		k := min(len(b), p.blockSize-len(p.buf))
+		p.buf = append(p.buf, b[:k]...)
+		b = b[k:]
+		if len(p.buf) == p.blockSize {
+			p.submit()
+		}
+	}
+	return n, nil
+}
+
+// Close compresses the remaining data, waits for all blocks to be written and
+// returns the first error encountered. An empty output is a valid empty gzip stream.
+func (p *parallelGzipWriter) Close() error {
+	if len(p.buf) > 0 || p.blocks == 0 {
+		p.submit()
+	}
+	close(p.pending)
+	<-p.done
+	return p.error()
+}
+
+// submit starts compressing the buffered block. It blocks while the maximum
+// number of blocks is in flight.
+func (p *parallelGzipWriter) submit() {
+	block := p.buf
+	p.buf = make([]byte, 0, p.blockSize)
+	p.blocks++
+	result := make(chan []byte, 1)
+	p.pending <- result
+	go func() {
+		result <- compressBlock(block, p.level)
+	}()
+}
+
+// writeLoop writes the compressed blocks in submission order.
+func (p *parallelGzipWriter) writeLoop() {
+	defer close(p.done)
+	for result := range p.pending {
+		data := <-result
+		if p.error() != nil {
+			continue
+		}
+		if _, err := p.w.Write(data); err != nil {
+			p.mu.Lock()
+			p.err = err
+			p.mu.Unlock()
+		}
+	}
+}
+
+// error returns the first error encountered while writing.
+func (p *parallelGzipWriter) error() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err
+}
+
+// compressBlock compresses block as a complete gzip member.
+func compressBlock(block []byte, level int) []byte {
+	var out bytes.Buffer
+	// The level has been validated when the writer was created.
+	zw, _ := gzip.NewWriterLevel(&out, level)
+	zw.Write(block)
+	zw.Close()
+	return out.Bytes()
+}
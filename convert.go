@@ -0,0 +1,118 @@
+// This file implements conversion between file formats.
+
+package exporter
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-data-exporter/exporter/codec"
+	csvcodec "github.com/go-data-exporter/exporter/codec/csv"
+	jsoncodec "github.com/go-data-exporter/exporter/codec/json"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// Convert writes the rows of src to w in the format of dst. It is a shorthand for
+// New(src, dst, opts...).Write(w), so all export options apply, and src is closed
+// when the conversion ends if it implements io.Closer.
+func Convert(src scanner.Rows, dst codec.Codec, w io.Writer, opts ...Option) error {
+	return New(src, dst, opts...).Write(w)
+}
+
+// ConvertFile converts the file in to the file out, detecting both formats from
+// the file extensions:
+//
+//   - .csv: comma-separated values with a header
+//   - .tsv: tab-separated values with a header
+//   - .json: a JSON array of objects
+//   - .ndjson, .jsonl: newline-delimited JSON objects
+//   - .html, .htm: an HTML table (output only)
+//   - .xml: an XML document (output only)
+//   - .parquet: an Apache Parquet file (output only)
+//
+// A trailing .gz extension, as in data.csv.gz, reads or writes a gzip-compressed
+// file. CSV input values are strings; JSON input numbers are kept as json.Number.
+// The output file is removed if the conversion fails.
+func ConvertFile(in, out string, opts ...Option) error {
+	inFormat, inGzip := fileFormat(in)
+	outFormat, outGzip := fileFormat(out)
+	dst, err := formatCodec(outFormat)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if inGzip {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		r = zr
+	}
+	src, err := formatSource(inFormat, r)
+	if err != nil {
+		return err
+	}
+	if outGzip {
+		opts = append(opts, WithCompression(CompressionGzip, gzip.DefaultCompression))
+	}
+	dest, err := FileDestination(out)
+	if err != nil {
+		return err
+	}
+	return New(src, dst, opts...).WriteDestinations(dest)
+}
+
+// fileFormat returns the lower-case format extension of filename without the dot,
+// and whether the file is gzip-compressed.
+func fileFormat(filename string) (string, bool) {
+	ext := strings.ToLower(filepath.Ext(filename))
+	compressed := ext == ".gz"
+	if compressed {
+		ext = strings.ToLower(filepath.Ext(strings.TrimSuffix(filename, filepath.Ext(filename))))
+	}
+	return strings.TrimPrefix(ext, "."), compressed
+}
+
+// formatSource returns the rows read from r in the given format.
+func formatSource(format string, r io.Reader) (scanner.Rows, error) {
+	switch format {
+	case "csv":
+		return scanner.FromCSV(r), nil
+	case "tsv":
+		return scanner.FromCSV(r, scanner.WithCSVComma('\t')), nil
+	case "json", "ndjson", "jsonl":
+		return scanner.FromJSON(r), nil
+	}
+	return nil, fmt.Errorf("exporter: unsupported input format %q", format)
+}
+
+// formatCodec returns the codec writing the given format.
+func formatCodec(format string) (codec.Codec, error) {
+	switch format {
+	case "csv":
+		return codec.CSV(), nil
+	case "tsv":
+		return codec.CSV(csvcodec.WithCustomDelimiter('\t')), nil
+	case "json":
+		return codec.JSON(), nil
+	case "ndjson", "jsonl":
+		return codec.JSON(jsoncodec.WithNewlineDelimited(true)), nil
+	case "html", "htm":
+		return codec.HTML(), nil
+	case "xml":
+		return codec.XML(), nil
+	case "parquet":
+		return codec.Parquet(), nil
+	}
+	return nil, fmt.Errorf("exporter: unsupported output format %q", format)
+}
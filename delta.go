@@ -0,0 +1,332 @@
+// This file implements incremental exports against an index of the previous run.
+
+package exporter
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/go-data-exporter/exporter/codec"
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// deltaIndexMagic starts every delta index file.
+const deltaIndexMagic = "exporter-delta-index v1\n"
+
+// Delta writes the changes of rows since the previous run to w with c, for
+// incremental feeds from sources without native change data capture. It keeps a
+// compact index of the key and a 64-bit hash of every row in indexFile: rows whose
+// key is not in the index are written as DiffAdded, rows whose hash differs as
+// DiffChanged, and keys of the index missing from rows as DiffRemoved, with the key
+// columns set to the string form of the key and all other columns NULL. The change
+// type is appended in DiffChangeColumn, as with Diff.
+//
+// Without an index file, the first run writes all rows as added. The index is
+// replaced atomically once the export has succeeded, so a failed run can be
+// repeated. The index is only replaced if all changes were written: an export
+// that ends early, e.g. with WithMaxBytes or the limit of a codec, fails and leaves
+// the index unchanged. The key columns must be the same in every run. The options
+// apply to the export as with New.
+func Delta(rows scanner.Rows, keyColumns []string, indexFile string, w io.Writer, c codec.Codec, opts ...Option) error {
+	previous, err := readDeltaIndex(indexFile, keyColumns)
+	if err != nil {
+		return err
+	}
+	delta := &deltaRows{Rows: rows, keyColumns: keyColumns, previous: previous, index: make(map[string]uint64)}
+	stats, err := New(delta, c, opts...).Export(w)
+	if err != nil {
+		return err
+	}
+	if !delta.done || stats.Truncated {
+		// The keys that were not reached would be dropped from the index without
+		// ever being written as removed.
+		return errors.New("exporter: delta export ended before all changes were written, the index is not updated")
+	}
+	return writeDeltaIndex(indexFile, keyColumns, delta.index)
+}
+
+// deltaRows wraps a Rows and returns its changes against the previous index.
+type deltaRows struct {
+	scanner.Rows
+
+	keyColumns []string
+	previous   map[string]uint64 // Row hashes of the previous run by encoded key.
+	index      map[string]uint64 // Row hashes of this run by encoded key.
+
+	columns []scanner.Column
+	keys    []int
+	current []any
+	removed []string // Encoded keys of the previous run missing from this run.
+	done    bool     // Whether all changes have been returned.
+	err     error
+}
+
+// Columns returns the columns of the rows followed by the change column.
+func (d *deltaRows) Columns() ([]scanner.Column, error) {
+	if d.columns != nil {
+		return d.columns, nil
+	}
+	cols, err := d.Rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]int, len(d.keyColumns))
+	for i, name := range d.keyColumns {
+		keys[i] = slices.IndexFunc(cols, func(col scanner.Column) bool { return col.Name() == name })
+		if keys[i] < 0 {
+			return nil, fmt.Errorf("exporter: unknown column %q", name)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("exporter: delta requires key columns")
+	}
+	d.columns = append(cols[:len(cols):len(cols)], &errorColumn{index: len(cols), name: DiffChangeColumn})
+	d.keys = keys
+	return d.columns, nil
+}
+
+// Next advances to the next added, changed or removed row.
+func (d *deltaRows) Next() bool {
+	if d.err != nil {
+		return false
+	}
+	if d.columns == nil {
+		if _, d.err = d.Columns(); d.err != nil {
+			return false
+		}
+	}
+	for d.removed == nil && d.Rows.Next() {
+		row, err := d.Rows.ScanRow()
+		if err != nil {
+			d.err = err
+			return false
+		}
+		key, hash := d.encodeKey(row), rowHash(row)
+		if _, ok := d.index[key]; ok {
+			parts, _ := decodeDeltaKey(key, len(d.keys))
+			d.err = fmt.Errorf("exporter: duplicate delta key %v", parts)
+			return false
+		}
+		d.index[key] = hash
+		previous, ok := d.previous[key]
+		switch {
+		case !ok:
+			d.current = append(append(d.current[:0], row...), DiffAdded)
+			return true
+		case previous != hash:
+			d.current = append(append(d.current[:0], row...), DiffChanged)
+			return true
+		}
+	}
+	if d.removed == nil {
+		if d.err = d.Rows.Err(); d.err != nil {
+			return false
+		}
+		d.removed = []string{}
+		for key := range d.previous {
+			if _, ok := d.index[key]; !ok {
+				d.removed = append(d.removed, key)
+			}
+		}
+		slices.Sort(d.removed)
+	}
+	if len(d.removed) == 0 {
+		d.done = true
+		return false
+	}
+	key := d.removed[0]
+	d.removed = d.removed[1:]
+	d.current = append(d.current[:0], make([]any, len(d.columns))...)
+	parts, err := decodeDeltaKey(key, len(d.keys))
+	if err != nil {
+		d.err = err
+		return false
+	}
+	for i, col := range d.keys {
+		d.current[col] = parts[i]
+	}
+	d.current[len(d.columns)-1] = DiffRemoved
+	return true
+}
+
+// ScanRow returns the current changed row.
+func (d *deltaRows) ScanRow() ([]any, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	return d.current, nil
+}
+
+// Err returns the error encountered while reading, if any.
+func (d *deltaRows) Err() error {
+	return d.err
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (d *deltaRows) Close() error {
+	return scanner.Close(d.Rows)
+}
+
+// encodeKey returns the binary encoding of the key values of row: for every value
+// a NULL flag and the length-prefixed string form.
+func (d *deltaRows) encodeKey(row []any) string {
+	var b []byte
+	for _, i := range d.keys {
+		s := tostring.ToString(row[i])
+		if s.IsNULL {
+			b = append(b, 1, 0)
+			continue
+		}
+		b = append(b, 0)
+		b = binary.AppendUvarint(b, uint64(len(s.String)))
+		b = append(b, s.String...)
+	}
+	return string(b)
+}
+
+// decodeDeltaKey returns the key values encoded by encodeKey, with nil for NULL.
+func decodeDeltaKey(key string, n int) ([]any, error) {
+	parts := make([]any, n)
+	b := []byte(key)
+	for i := range parts {
+		if len(b) == 0 {
+			return nil, errors.New("exporter: corrupt delta index key")
+		}
+		null := b[0] == 1
+		length, size := binary.Uvarint(b[1:])
+		if size <= 0 || uint64(len(b)-1-size) < length {
+			return nil, errors.New("exporter: corrupt delta index key")
+		}
+		b = b[1+size:]
+		if !null {
+			parts[i] = string(b[:length])
+		}
+		b = b[length:]
+	}
+	return parts, nil
+}
+
+// rowHash returns a 64-bit hash of the string form of the values of row.
+func rowHash(row []any) uint64 {
+	h := fnv.New64a()
+	var buf []byte
+	for _, v := range row {
+		s := tostring.ToString(v)
+		buf = buf[:0]
+		if s.IsNULL {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+			buf = binary.AppendUvarint(buf, uint64(len(s.String)))
+			buf = append(buf, s.String...)
+		}
+		h.Write(buf)
+	}
+	return h.Sum64()
+}
+
+// readDeltaIndex reads the index of the previous run, or returns an empty index
+// if the file does not exist.
+func readDeltaIndex(filename string, keyColumns []string) (map[string]uint64, error) {
+	index := make(map[string]uint64)
+	f, err := os.Open(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return index, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	magic := make([]byte, len(deltaIndexMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != deltaIndexMagic {
+		return nil, fmt.Errorf("exporter: %s is not a delta index", filename)
+	}
+	columns, err := readDeltaString(r)
+	if err != nil {
+		return nil, err
+	}
+	if want := string(encodeDeltaColumns(keyColumns)); columns != want {
+		return nil, fmt.Errorf("exporter: delta index %s was written for other key columns", filename)
+	}
+	for {
+		key, err := readDeltaString(r)
+		if errors.Is(err, io.EOF) {
+			return index, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var hash [8]byte
+		if _, err := io.ReadFull(r, hash[:]); err != nil {
+			return nil, fmt.Errorf("exporter: corrupt delta index %s: %w", filename, err)
+		}
+		index[key] = binary.BigEndian.Uint64(hash[:])
+	}
+}
+
+// writeDeltaIndex atomically replaces the index file with index.
+func writeDeltaIndex(filename string, keyColumns []string, index map[string]uint64) error {
+	f, err := os.CreateTemp(filepath.Dir(filename), ".delta-index-*.tmp")
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	w.WriteString(deltaIndexMagic)
+	writeDeltaString(w, string(encodeDeltaColumns(keyColumns)))
+	for key, hash := range index {
+		writeDeltaString(w, key)
+		w.Write(binary.BigEndian.AppendUint64(nil, hash))
+	}
+	err = w.Flush()
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), filename)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// encodeDeltaColumns encodes the names of the key columns for the index header.
+func encodeDeltaColumns(keyColumns []string) []byte {
+	var b []byte
+	for _, name := range keyColumns {
+		b = binary.AppendUvarint(b, uint64(len(name)))
+		b = append(b, name...)
+	}
+	return b
+}
+
+// writeDeltaString writes a length-prefixed string.
+func writeDeltaString(w *bufio.Writer, s string) {
+	w.Write(binary.AppendUvarint(nil, uint64(len(s))))
+	w.WriteString(s)
+}
+
+// readDeltaString reads a length-prefixed string. It returns io.EOF at the end of the input.
+func readDeltaString(r *bufio.Reader) (string, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", fmt.Errorf("exporter: corrupt delta index: %w", err)
+	}
+	return string(b), nil
+}
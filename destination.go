@@ -0,0 +1,166 @@
+// This file defines destinations and fan-out of a single export to multiple outputs.
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Destination is an output that must be finalized once the export has been
+// written, such as a file, an upload or a database load.
+type Destination interface {
+	io.Writer
+
+	// Close finalizes the destination after all data has been written successfully.
+	Close() error
+}
+
+// Aborter is implemented by destinations that can discard a partially written
+// export instead of committing it. If a destination implements Aborter, Abort
+// is called instead of Close when the export or the destination itself fails.
+type Aborter interface {
+	Abort(err error) error
+}
+
+// DestinationError reports the failure of a single destination in a fan-out export.
+type DestinationError struct {
+	Index int   // Position of the destination in the argument list.
+	Err   error // The error returned by the destination.
+}
+
+// Error implements the error interface.
+func (e *DestinationError) Error() string {
+	return fmt.Sprintf("destination %d: %s", e.Index, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *DestinationError) Unwrap() error {
+	return e.Err
+}
+
+// WriteAll encodes the export once and writes the stream to every writer.
+// A writer that fails is dropped while the others continue to receive data;
+// the export only stops early if all writers have failed. The returned error
+// joins the export error and a *DestinationError for every failed writer.
+func (cs *Exporter) WriteAll(dests ...io.Writer) error {
+	fw := newFanOutWriter(dests)
+	err := cs.Write(fw)
+	return errors.Join(append([]error{err}, fw.errors()...)...)
+}
+
+// WriteDestinations works like WriteAll and then finalizes every destination:
+// destinations that received the complete export are closed, while destinations
+// that failed, or all of them if the export failed, are aborted if they implement
+// Aborter and closed otherwise.
+func (cs *Exporter) WriteDestinations(dests ...Destination) error {
+	_, err := cs.exportDestinations(dests)
+	return err
+}
+
+// exportDestinations implements WriteDestinations and returns the export statistics.
+func (cs *Exporter) exportDestinations(dests []Destination) (Stats, error) {
+	writers := make([]io.Writer, len(dests))
+	for i, dest := range dests {
+		writers[i] = dest
+	}
+	fw := newFanOutWriter(writers)
+	stats, exportErr := cs.Export(fw)
+	errs := []error{exportErr}
+	for i, dest := range dests {
+		failure := fw.errs[i]
+		if failure == nil {
+			failure = exportErr
+		}
+		var err error
+		if aborter, ok := dest.(Aborter); ok && failure != nil {
+			err = aborter.Abort(failure)
+		} else {
+			err = dest.Close()
+		}
+		if fw.errs[i] == nil && err != nil {
+			fw.errs[i] = err
+		}
+	}
+	return stats, errors.Join(append(errs, fw.errors()...)...)
+}
+
+// FileDestination creates the named file and returns it as a Destination.
+// The file is synced when closed and removed when the export is aborted.
+func FileDestination(filename string) (Destination, error) {
+	f, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+	return &fileDestination{File: f}, nil
+}
+
+// fileDestination is a Destination backed by a local file.
+type fileDestination struct {
+	*os.File
+}
+
+// Close syncs and closes the file.
+func (f *fileDestination) Close() error {
+	if err := f.File.Sync(); err != nil {
+		f.File.Close()
+		return err
+	}
+	return f.File.Close()
+}
+
+// Abort closes and removes the partially written file.
+func (f *fileDestination) Abort(error) error {
+	f.File.Close()
+	return os.Remove(f.File.Name())
+}
+
+// fanOutWriter writes to several writers, dropping those that fail.
+type fanOutWriter struct {
+	writers []io.Writer
+	errs    []error
+	alive   int
+}
+
+// newFanOutWriter creates a fanOutWriter for the given writers.
+func newFanOutWriter(writers []io.Writer) *fanOutWriter {
+	return &fanOutWriter{
+		writers: writers,
+		errs:    make([]error, len(writers)),
+		alive:   len(writers),
+	}
+}
+
+// Write writes p to every healthy writer. It only fails once all writers have failed.
+func (w *fanOutWriter) Write(p []byte) (int, error) {
+	for i, writer := range w.writers {
+		if w.errs[i] != nil {
+			continue
+		}
+		n, err := writer.Write(p)
+		if err == nil && n != len(p) {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			w.errs[i] = err
+			w.alive--
+		}
+	}
+	if w.alive == 0 && len(w.writers) != 0 {
+		return 0, errors.New("exporter: all destinations failed")
+	}
+	return len(p), nil
+}
+
+// errors returns a *DestinationError for every failed writer.
+func (w *fanOutWriter) errors() []error {
+	var errs []error
+	for i, err := range w.errs {
+		if err != nil {
+			errs = append(errs, &DestinationError{Index: i, Err: err})
+		}
+	}
+	return errs
+}
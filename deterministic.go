@@ -0,0 +1,16 @@
+// This file implements the deterministic output mode.
+
+package exporter
+
+// WithDeterministicOutput guarantees byte-identical output for identical input, so
+// that exports can be diffed and cached reliably. The codecs already write JSON object
+// keys in sorted order and add no timestamps of their own; in addition, this option
+// converts time values to UTC, or to the location of WithTimeZone, so the output
+// does not depend on the local time zone of the machine or the connection. Values
+// encrypted with WithEncryptedColumns still differ between runs; use
+// WithDeterministicEncryptedColumns if equal values may be revealed.
+func WithDeterministicOutput(deterministic bool) Option {
+	return func(e *Exporter) {
+		e.deterministic = deterministic
+	}
+}
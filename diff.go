@@ -0,0 +1,423 @@
+// This file implements the export of the differences between two sources.
+
+package exporter
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/go-data-exporter/exporter/codec"
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/spill"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// DiffChangeColumn is the name of the column appended by Diff to hold the change type.
+const DiffChangeColumn = "_change"
+
+// Change types written to DiffChangeColumn.
+const (
+	DiffAdded   = "added"   // The row exists in the new source only.
+	DiffRemoved = "removed" // The row exists in the old source only.
+	DiffChanged = "changed" // The row exists in both sources with different values.
+)
+
+// WithDiffMerge makes Diff compare its sources with a merge join, which requires
+// both sources to be sorted in ascending order by the key columns but reads them
+// in constant memory. Key columns of a numeric kind, see scanner.ColumnKind, are
+// compared as numbers and all other key columns byte-wise by their string form,
+// with NULL first: sort string keys with a binary collation, such as ORDER BY key
+// COLLATE "C" NULLS FIRST in PostgreSQL, since the default collation of most
+// databases orders them differently. Diff fails if a source is not in this order
+// or has duplicate keys.
+func WithDiffMerge(merge bool) Option {
+	return func(e *Exporter) {
+		e.diffMerge = merge
+	}
+}
+
+// WithStringInterning deduplicates the repeated string and byte slice values of
+// rows the export holds in memory, such as the old source of Diff, in columns with
+// up to maxDistinct distinct values, so that exports dominated by repeated values
+// such as statuses or country codes need far less memory. See scanner.Interner.
+// A non-positive value disables interning (default).
+func WithStringInterning(maxDistinct int) Option {
+	return func(e *Exporter) {
+		e.internDistinct = maxDistinct
+	}
+}
+
+// WithSpill bounds the memory used by rows the export holds in memory, such as
+// the old source of Diff: once they take an estimated memoryLimit bytes, further
+// rows are written to a temporary file in dir (os.TempDir if empty), which is
+// removed when the export ends. The keys of the rows stay in memory. See
+// spill.Buffer. A non-positive memoryLimit keeps all rows in memory (default).
+func WithSpill(dir string, memoryLimit int64) Option {
+	return func(e *Exporter) {
+		e.spillDir = dir
+		e.spillMemory = memoryLimit
+	}
+}
+
+// Diff writes the rows that differ between the old source a and the new source b,
+// identified by the values of keyColumns, to w with c, e.g. to publish daily delta
+// files instead of full snapshots. Added and changed rows are written with the
+// values of b, removed rows with the values of a, and DiffChangeColumn is appended
+// to every row with the change type. Unchanged rows are left out. Values are
+// compared by their string form, so that drivers returning []byte or string for
+// the same column compare equal.
+//
+// Both sources must have the same column names in the same order, and the keys
+// must be unique within each source; Diff fails on a duplicate key. By default a is
+// read into memory and b is streamed; with WithDiffMerge both are streamed. The
+// other options apply to the export as with New. Both sources are closed if they
+// implement io.Closer.
+func Diff(a, b scanner.Rows, keyColumns []string, w io.Writer, c codec.Codec, opts ...Option) error {
+	rows := &diffRows{old: a, new: b, keyColumns: keyColumns}
+	e := New(rows, c, opts...)
+	rows.merge = e.diffMerge
+	if e.internDistinct > 0 {
+		rows.interner = scanner.NewInterner(e.internDistinct)
+	}
+	rows.oldRows = spill.New(spill.WithDir(e.spillDir), spill.WithMemoryLimit(e.spillMemory))
+	return e.Write(w)
+}
+
+// diffRows is the Rows of the differences between two sources.
+type diffRows struct {
+	old, new   scanner.Rows
+	keyColumns []string
+	merge      bool
+	interner   *scanner.Interner // Interns the rows of old held in memory, if set.
+
+	columns []scanner.Column
+	keys    []int
+	numeric []bool // Whether the key columns are compared as numbers.
+	current []any
+	err     error
+
+	// Hash comparison: the rows of old by key, whether b contained them, and the
+	// keys of b missing from old.
+	byKey   map[string]int
+	oldRows *spill.Buffer
+	seen    []bool
+	added   map[string]struct{}
+	removed int // Position of the next removed row to report once new is exhausted.
+
+	// Merge comparison: the current rows of both sources, nil once exhausted, and
+	// the previous rows to check the order of the sources.
+	oldRow, newRow   []any
+	oldPrev, newPrev []any
+	started          bool
+}
+
+// Driver returns the driver of the new source.
+func (d *diffRows) Driver() string {
+	return d.new.Driver()
+}
+
+// Columns returns the columns of the new source followed by the change column.
+func (d *diffRows) Columns() ([]scanner.Column, error) {
+	if d.columns != nil {
+		return d.columns, nil
+	}
+	oldCols, err := d.old.Columns()
+	if err != nil {
+		return nil, err
+	}
+	cols, err := d.new.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.EqualFunc(oldCols, cols, func(a, b scanner.Column) bool { return a.Name() == b.Name() }) {
+		return nil, errors.New("exporter: diff sources have different columns")
+	}
+	keys := make([]int, len(d.keyColumns))
+	for i, name := range d.keyColumns {
+		keys[i] = slices.IndexFunc(cols, func(col scanner.Column) bool { return col.Name() == name })
+		if keys[i] < 0 {
+			return nil, fmt.Errorf("exporter: unknown column %q", name)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("exporter: diff requires key columns")
+	}
+	// The change column is a string column like the error column of rejects.
+	d.columns = append(cols[:len(cols):len(cols)], &errorColumn{index: len(cols), name: DiffChangeColumn})
+	d.keys = keys
+	d.numeric = make([]bool, len(keys))
+	for i, key := range keys {
+		d.numeric[i] = scanner.ColumnKind(cols[key]).IsNumeric()
+	}
+	return d.columns, nil
+}
+
+// Next advances to the next differing row.
+func (d *diffRows) Next() bool {
+	if d.err != nil {
+		return false
+	}
+	if d.columns == nil {
+		if _, d.err = d.Columns(); d.err != nil {
+			return false
+		}
+	}
+	if d.merge {
+		return d.nextMerge()
+	}
+	return d.nextHash()
+}
+
+// nextHash advances with the rows of old held in memory.
+func (d *diffRows) nextHash() bool {
+	if d.byKey == nil {
+		d.byKey = make(map[string]int)
+		for d.old.Next() {
+			row, err := d.old.ScanRow()
+			if err != nil {
+				d.err = err
+				return false
+			}
+			key := d.key(row)
+			if _, ok := d.byKey[key]; ok {
+				d.err = fmt.Errorf("exporter: duplicate diff key %v in the old source", d.keyValues(row))
+				return false
+			}
+			d.byKey[key] = d.oldRows.Len()
+			row = scanner.CloneRow(row)
+			if d.interner != nil {
+				d.interner.Intern(row)
+			}
+			if d.err = d.oldRows.Append(row); d.err != nil {
+				return false
+			}
+		}
+		if d.err = d.old.Err(); d.err != nil {
+			return false
+		}
+		d.seen = make([]bool, d.oldRows.Len())
+		d.added = make(map[string]struct{})
+	}
+	for d.new.Next() {
+		row, err := d.new.ScanRow()
+		if err != nil {
+			d.err = err
+			return false
+		}
+		key := d.key(row)
+		i, ok := d.byKey[key]
+		if !ok {
+			if _, ok := d.added[key]; ok {
+				d.err = fmt.Errorf("exporter: duplicate diff key %v in the new source", d.keyValues(row))
+				return false
+			}
+			d.added[key] = struct{}{}
+			return d.emit(row, DiffAdded)
+		}
+		if d.seen[i] {
+			d.err = fmt.Errorf("exporter: duplicate diff key %v in the new source", d.keyValues(row))
+			return false
+		}
+		d.seen[i] = true
+		old, err := d.oldRows.Row(i)
+		if err != nil {
+			d.err = err
+			return false
+		}
+		if !d.equal(old, row) {
+			return d.emit(row, DiffChanged)
+		}
+	}
+	if d.err = d.new.Err(); d.err != nil {
+		return false
+	}
+	for d.removed < d.oldRows.Len() {
+		i := d.removed
+		d.removed++
+		if !d.seen[i] {
+			old, err := d.oldRows.Row(i)
+			if err != nil {
+				d.err = err
+				return false
+			}
+			return d.emit(old, DiffRemoved)
+		}
+	}
+	return false
+}
+
+// nextMerge advances through both sources sorted by key.
+func (d *diffRows) nextMerge() bool {
+	if !d.started {
+		d.started = true
+		d.oldRow = d.advance(d.old, &d.oldPrev, "old")
+		d.newRow = d.advance(d.new, &d.newPrev, "new")
+	}
+	for d.err == nil && (d.oldRow != nil || d.newRow != nil) {
+		order := 0
+		switch {
+		case d.oldRow == nil:
+			order = 1
+		case d.newRow == nil:
+			order = -1
+		default:
+			if order, d.err = d.compareKeys(d.oldRow, d.newRow); d.err != nil {
+				return false
+			}
+		}
+		switch {
+		case order < 0:
+			row := d.oldRow
+			d.oldRow = d.advance(d.old, &d.oldPrev, "old")
+			return d.emit(row, DiffRemoved)
+		case order > 0:
+			row := d.newRow
+			d.newRow = d.advance(d.new, &d.newPrev, "new")
+			return d.emit(row, DiffAdded)
+		}
+		oldRow, newRow := d.oldRow, d.newRow
+		d.oldRow, d.newRow = d.advance(d.old, &d.oldPrev, "old"), d.advance(d.new, &d.newPrev, "new")
+		if d.err != nil {
+			return false
+		}
+		if !d.equal(oldRow, newRow) {
+			return d.emit(newRow, DiffChanged)
+		}
+	}
+	return false
+}
+
+// advance returns a copy of the next row of rows, or nil at the end. It fails if
+// the key of the row does not follow the key of the previous row *prev of the
+// source named name, since the merge join would silently produce a wrong diff.
+func (d *diffRows) advance(rows scanner.Rows, prev *[]any, name string) []any {
+	if d.err != nil || !rows.Next() {
+		if d.err == nil {
+			d.err = rows.Err()
+		}
+		return nil
+	}
+	row, err := rows.ScanRow()
+	if err != nil {
+		d.err = err
+		return nil
+	}
+	row = scanner.CloneRow(row)
+	if *prev != nil {
+		order, err := d.compareKeys(*prev, row)
+		switch {
+		case err != nil:
+			d.err = err
+			return nil
+		case order == 0:
+			d.err = fmt.Errorf("exporter: duplicate diff key %v in the %s source", d.keyValues(row), name)
+			return nil
+		case order > 0:
+			d.err = fmt.Errorf("exporter: %s diff source is not sorted by key: %v follows %v", name, d.keyValues(row), d.keyValues(*prev))
+			return nil
+		}
+	}
+	*prev = row
+	return row
+}
+
+// keyValues returns the key values of row for error messages.
+func (d *diffRows) keyValues(row []any) []any {
+	values := make([]any, len(d.keys))
+	for i, key := range d.keys {
+		values[i] = row[key]
+	}
+	return values
+}
+
+// emit sets the current row to row followed by the change type.
+func (d *diffRows) emit(row []any, change string) bool {
+	d.current = append(append(d.current[:0], row...), change)
+	return true
+}
+
+// ScanRow returns the current differing row.
+func (d *diffRows) ScanRow() ([]any, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	return d.current, nil
+}
+
+// Err returns the error encountered while reading either source, if any.
+func (d *diffRows) Err() error {
+	return d.err
+}
+
+// Close closes both sources if they implement io.Closer and removes the rows of
+// old held in memory or spilled to disk.
+func (d *diffRows) Close() error {
+	return errors.Join(scanner.Close(d.old), scanner.Close(d.new), d.oldRows.Close())
+}
+
+// key returns the string form of the key values of row.
+func (d *diffRows) key(row []any) string {
+	var b strings.Builder
+	for _, i := range d.keys {
+		s := tostring.ToString(row[i])
+		if s.IsNULL {
+			b.WriteString("\x00N")
+		} else {
+			b.WriteString(s.String)
+		}
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+// equal reports whether two rows have the same values.
+func (d *diffRows) equal(a, b []any) bool {
+	return slices.EqualFunc(a, b, func(x, y any) bool {
+		return tostring.ToString(x) == tostring.ToString(y)
+	})
+}
+
+// compareKeys compares the keys of two rows.
+func (d *diffRows) compareKeys(a, b []any) (int, error) {
+	for i, key := range d.keys {
+		order, err := compareKey(a[key], b[key], d.numeric[i])
+		if err != nil || order != 0 {
+			return order, err
+		}
+	}
+	return 0, nil
+}
+
+// compareKey compares two key values, as numbers if numeric is set and byte-wise
+// by their string form otherwise. NULL sorts first.
+func compareKey(a, b any, numeric bool) (int, error) {
+	sa, sb := tostring.ToString(a), tostring.ToString(b)
+	if sa.IsNULL || sb.IsNULL {
+		return cmp.Compare(boolOrder(!sa.IsNULL), boolOrder(!sb.IsNULL)), nil
+	}
+	if !numeric {
+		return strings.Compare(sa.String, sb.String), nil
+	}
+	na, ok := new(big.Rat).SetString(sa.String)
+	if !ok {
+		return 0, fmt.Errorf("exporter: diff key %q is not a number", sa.String)
+	}
+	nb, ok := new(big.Rat).SetString(sb.String)
+	if !ok {
+		return 0, fmt.Errorf("exporter: diff key %q is not a number", sb.String)
+	}
+	return na.Cmp(nb), nil
+}
+
+// boolOrder returns 1 for true and 0 for false.
+func boolOrder(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,140 @@
+// This file implements the encryption of selected column values.
+
+package exporter
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// WithEncryptedColumns encrypts the values of the named columns with AES-GCM while
+// the other columns stay readable. The key must be 16, 24 or 32 bytes long to select
+// AES-128, AES-192 or AES-256; an invalid key fails the export. The encryption key is
+// derived from key with HKDF-SHA256, so key itself is never used directly.
+//
+// Every non-NULL value is replaced with the base64 encoding (standard, padded) of a
+// random nonce followed by the ciphertext of its string form. The column name is
+// authenticated as additional data, so a value cannot be moved to another column
+// unnoticed. NULL values are left untouched. Use DecryptValue to recover a value.
+func WithEncryptedColumns(columnNames []string, key []byte) Option {
+	return encryptColumns(columnNames, key, false)
+}
+
+// WithDeterministicEncryptedColumns encrypts the values of the named columns like
+// WithEncryptedColumns, but derives the nonce of every value from a separate
+// subkey, the column name and the value instead of drawing it at random, so that
+// equal values of a column encrypt equally, e.g. to join or group encrypted columns
+// or to keep repeated exports byte-identical. This reveals which values of a column
+// are equal; use it only where that is acceptable. DecryptValue decrypts the values.
+func WithDeterministicEncryptedColumns(columnNames []string, key []byte) Option {
+	return encryptColumns(columnNames, key, true)
+}
+
+// encryptColumns returns the option of WithEncryptedColumns and
+// WithDeterministicEncryptedColumns.
+func encryptColumns(columnNames []string, key []byte, deterministic bool) Option {
+	aead, err := newGCM(key)
+	nonceKey := hkdfSHA256(key, nonceKeyInfo, sha256.Size)
+	return func(e *Exporter) {
+		for _, name := range columnNames {
+			e.transforms = append(e.transforms, transform{
+				column: name,
+				fn: func(v any, _ scanner.Metadata) (any, error) {
+					if err != nil {
+						return nil, err
+					}
+					s := tostring.ToString(v)
+					if s.IsNULL {
+						return nil, nil
+					}
+					nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(s.String)+aead.Overhead())
+					if deterministic {
+						copy(nonce, syntheticNonce(nonceKey, name, s.String))
+					} else if _, err := rand.Read(nonce); err != nil {
+						return nil, err
+					}
+					sealed := aead.Seal(nonce, nonce, []byte(s.String), []byte(name))
+					return base64.StdEncoding.EncodeToString(sealed), nil
+				},
+			})
+		}
+	}
+}
+
+// DecryptValue decrypts a value of columnName encrypted by WithEncryptedColumns
+// and returns its string form.
+func DecryptValue(key []byte, columnName, value string) (string, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("exporter: invalid encrypted value: %w", err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("exporter: invalid encrypted value: too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(columnName))
+	if err != nil {
+		return "", fmt.Errorf("exporter: failed to decrypt value: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// syntheticNonce derives the nonce of a value from the nonce subkey, the column and
+// the value.
+func syntheticNonce(nonceKey []byte, columnName, value string) []byte {
+	mac := hmac.New(sha256.New, nonceKey)
+	mac.Write([]byte(columnName))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+// HKDF info strings of the subkeys derived from an encryption key.
+const (
+	encryptionKeyInfo = "exporter column encryption key"
+	nonceKeyInfo      = "exporter column nonce key"
+)
+
+// newGCM returns an AES-GCM cipher for the encryption subkey of key, which has the
+// length of key.
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if _, err := aes.NewCipher(key); err != nil {
+		return nil, fmt.Errorf("exporter: invalid encryption key: %w", err)
+	}
+	block, err := aes.NewCipher(hkdfSHA256(key, encryptionKeyInfo, len(key)))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// hkdfSHA256 derives a subkey of length bytes from secret for the purpose info
+// with HKDF-SHA256 (RFC 5869) without salt.
+func hkdfSHA256(secret []byte, info string, length int) []byte {
+	extract := hmac.New(sha256.New, nil)
+	extract.Write(secret)
+	prk := extract.Sum(nil)
+	var out, block []byte
+	for counter := byte(1); len(out) < length; counter++ {
+		expand := hmac.New(sha256.New, prk)
+		expand.Write(block)
+		expand.Write([]byte(info))
+		expand.Write([]byte{counter})
+		block = expand.Sum(nil)
+		out = append(out, block...)
+	}
+	return out[:length]
+}
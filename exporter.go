@@ -4,32 +4,419 @@
 package exporter
 
 import (
+	"errors"
 	"io"
 	"os"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
 
 	"github.com/go-data-exporter/exporter/codec"
 	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
 )
 
 // Exporter is the main struct that coordinates exporting data.
 // It uses a scanner.Rows as the data source and a codec.Codec
 // to determine the output format.
 type Exporter struct {
-	rows  scanner.Rows
-	codec codec.Codec
+	rows       scanner.Rows
+	codec      codec.Codec
+	transforms []transform
+
+	maxCellLength     int
+	ellipsis          string
+	stripControlChars bool
+	normalizeNewlines bool
+
+	maxBufferSize int
+
+	prologue func(w io.Writer) error
+	epilogue func(w io.Writer) error
+
+	maxBytes int64
+
+	compression            Compression
+	compressionLevel       int
+	compressionConcurrency int
+	compressionBlockSize   int
+
+	shards         int
+	shardKey       string
+	parallelShards bool
+
+	columnStats     bool
+	columnStatsFile string
+
+	checks      []Check
+	failOnCheck bool
+
+	auditor     Auditor
+	auditUser   string
+	auditSource string
+
+	rejects       io.Writer
+	rejectsColumn string
+
+	chunkRows  int
+	chunkBytes int64
+
+	deterministic bool
+
+	metadata map[string]string
+
+	nullPolicy scanner.NullPolicy
+
+	summaryFile bool
+
+	diffMerge bool
+
+	columnHints map[string]map[string]string
+
+	timeZone *time.Location
+
+	integrityFooter bool
+
+	asyncLimit int
+	asyncStall time.Duration
+
+	internDistinct int
+
+	spillDir    string
+	spillMemory int64
 }
 
+// Option defines a functional option for configuring the Exporter.
+type Option func(*Exporter)
+
 // New creates a new Exporter instance using the given data source and codec.
-func New(rows scanner.Rows, codec codec.Codec) *Exporter {
-	return &Exporter{
+// Optional configuration can be provided via functional options.
+func New(rows scanner.Rows, codec codec.Codec, opts ...Option) *Exporter {
+	e := &Exporter{
 		rows:  rows,
 		codec: codec,
 	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// WithColumnConverter registers a function that converts every value of the named
+// column before it is passed to the codec, so the conversion applies to all output
+// formats. It runs before codec-specific mappings such as WithCustomType, which
+// therefore see the converted value. NULL values are passed to fn as nil.
+// Multiple converters for the same column are applied in registration order.
+func WithColumnConverter(columnName string, fn func(v any, metadata scanner.Metadata) any) Option {
+	return func(e *Exporter) {
+		e.transforms = append(e.transforms, transform{
+			column: columnName,
+			fn: func(v any, metadata scanner.Metadata) (any, error) {
+				return fn(v, metadata), nil
+			},
+		})
+	}
+}
+
+// CellTemplateData is the data passed to templates registered with WithCellTemplate.
+type CellTemplateData struct {
+	Value    any              // The typed cell value.
+	Metadata scanner.Metadata // Contextual information about the cell.
+}
+
+// WithCellTemplate formats every non-NULL value of the named column by executing
+// tmpl with CellTemplateData, e.g. `{{printf "%.2f" .Value}} USD`. The rendered
+// text replaces the value. NULL values are left untouched. A template execution
+// error aborts the export.
+func WithCellTemplate(columnName string, tmpl *template.Template) Option {
+	return func(e *Exporter) {
+		e.transforms = append(e.transforms, transform{
+			column: columnName,
+			fn: func(v any, metadata scanner.Metadata) (any, error) {
+				if v == nil {
+					return nil, nil
+				}
+				var b strings.Builder
+				if err := tmpl.Execute(&b, CellTemplateData{Value: v, Metadata: metadata}); err != nil {
+					return nil, err
+				}
+				return b.String(), nil
+			},
+		})
+	}
+}
+
+// WithValueMap translates the values of the named column using values, e.g.
+// map[any]string{1: "active", 2: "disabled"}. Keys are matched by value first and
+// then by their string representation, so an int key matches an int64 or []byte
+// value delivered by a driver. Non-NULL values without a match are replaced with
+// fallback; NULL values are left untouched.
+func WithValueMap(columnName string, values map[any]string, fallback string) Option {
+	byString := make(map[string]string, len(values))
+	for k, v := range values {
+		byString[tostring.ToString(k).String] = v
+	}
+	return func(e *Exporter) {
+		e.transforms = append(e.transforms, transform{
+			column: columnName,
+			fn: func(v any, _ scanner.Metadata) (any, error) {
+				if v == nil {
+					return nil, nil
+				}
+				if reflect.TypeOf(v).Comparable() {
+					if mapped, ok := values[v]; ok {
+						return mapped, nil
+					}
+				}
+				if mapped, ok := byString[tostring.ToString(v).String]; ok {
+					return mapped, nil
+				}
+				return fallback, nil
+			},
+		})
+	}
+}
+
+// WithPrologue writes text before the codec output, e.g. a comment banner in CSV.
+func WithPrologue(text string) Option {
+	return WithPrologueFunc(func(w io.Writer) error {
+		_, err := io.WriteString(w, text)
+		return err
+	})
+}
+
+// WithPrologueFunc sets a function that writes arbitrary bytes before the codec output.
+func WithPrologueFunc(fn func(w io.Writer) error) Option {
+	return func(e *Exporter) {
+		e.prologue = fn
+	}
+}
+
+// WithEpilogue writes text after the codec output. It is not written if the export fails.
+func WithEpilogue(text string) Option {
+	return WithEpilogueFunc(func(w io.Writer) error {
+		_, err := io.WriteString(w, text)
+		return err
+	})
+}
+
+// WithEpilogueFunc sets a function that writes arbitrary bytes after the codec output.
+// It is not called if the export fails.
+func WithEpilogueFunc(fn func(w io.Writer) error) Option {
+	return func(e *Exporter) {
+		e.epilogue = fn
+	}
+}
+
+// WithNullPolicy sets how all codecs render NULL values, e.g. scanner.NullMarked,
+// overriding codec options such as WithCustomNULL. The default,
+// scanner.NullDefault, leaves the rendering to the codec options.
+func WithNullPolicy(policy scanner.NullPolicy) Option {
+	return func(e *Exporter) {
+		e.nullPolicy = policy
+	}
+}
+
+// WithColumnHint sets the hint key of the named column to value, e.g.
+// WithColumnHint("country", scanner.HintEncoding, "dictionary"), for codecs with
+// tunable binary layouts. Codecs read hints with scanner.Hint and ignore hints
+// they do not support; see scanner.HintEncoding for the well-known keys.
+func WithColumnHint(columnName, key, value string) Option {
+	return func(e *Exporter) {
+		if e.columnHints == nil {
+			e.columnHints = make(map[string]map[string]string)
+		}
+		if e.columnHints[columnName] == nil {
+			e.columnHints[columnName] = make(map[string]string)
+		}
+		e.columnHints[columnName][key] = value
+	}
 }
 
 // Write writes the exported data to the given io.Writer using the codec.
 func (cs *Exporter) Write(writer io.Writer) error {
-	return cs.codec.Write(cs.rows, writer)
+	_, err := cs.Export(writer)
+	return err
+}
+
+// Export writes the exported data to the given io.Writer using the codec
+// and returns statistics about the export. If the source rows implement
+// io.Closer, they are closed once the export has finished or failed.
+func (cs *Exporter) Export(writer io.Writer) (stats Stats, err error) {
+	if cs.asyncLimit > 0 {
+		async := newAsyncWriter(writer, cs.asyncLimit, cs.asyncStall)
+		writer = async
+		defer func() {
+			if closeErr := async.close(); err == nil {
+				err = closeErr
+			}
+		}()
+	}
+	if trail := cs.startAudit(writer); trail != nil {
+		writer = io.MultiWriter(writer, trail.hash)
+		defer func() {
+			if auditErr := trail.finish(cs.auditor, stats, err); err == nil {
+				err = auditErr
+			}
+		}()
+	}
+	defer func() {
+		if closeErr := scanner.Close(cs.rows); err == nil {
+			err = closeErr
+		}
+	}()
+	cw := &countingWriter{Writer: writer}
+	src, observers := cs.observedSource()
+	counted := &statsRows{
+		Rows:     src,
+		stats:    &stats,
+		written:  cw,
+		maxBytes: cs.maxBytes,
+		metadata: cs.describe(src, time.Now()),
+	}
+	var rows scanner.Rows = counted
+	if batch, ok := src.(scanner.BatchScanner); ok && cs.maxBytes <= 0 {
+		rows = &statsBatchRows{statsRows: counted, batch: batch}
+	}
+	out, closeOut, err := cs.compressor(cw)
+	if err != nil {
+		return stats, err
+	}
+	var footer *integrityWriter
+	if cs.integrityFooter {
+		if footer, err = newIntegrityWriter(out, cs.codec); err != nil {
+			closeOut()
+			return stats, err
+		}
+		out = footer
+	}
+	err = cs.export(out, rows)
+	stats.Rows = counted.rowsWritten()
+	if err == nil && footer != nil {
+		err = footer.finish(stats.Rows)
+	}
+	if closeErr := closeOut(); err == nil {
+		err = closeErr
+	}
+	stats.Bytes = cw.n
+	err = observers.finish(cs, &stats, err)
+	return stats, err
+}
+
+// sourceObservers are the wrappers of the source that observe every row of an
+// export, whether it is written by Export or split by WriteShards or WriteChunks.
+type sourceObservers struct {
+	rejects     *rejectRows
+	checks      *checkRows
+	columnStats *columnStatsRows
+}
+
+// observedSource returns the source wrapped with the rejects, checks and column
+// statistics of the export, if enabled.
+func (cs *Exporter) observedSource() (scanner.Rows, *sourceObservers) {
+	src := cs.source()
+	o := &sourceObservers{}
+	if cs.rejects != nil {
+		o.rejects = &rejectRows{Rows: src, exporter: cs}
+		src = o.rejects
+	}
+	if len(cs.checks) != 0 {
+		o.checks = newCheckRows(src, cs.checks)
+		src = o.checks
+	}
+	if cs.columnStats {
+		o.columnStats = &columnStatsRows{Rows: src}
+		src = o.columnStats
+	}
+	return src, o
+}
+
+// finish ends the rejects output and stores the results of the observers in
+// stats. It returns err, the error of the export, or if it is nil the first error
+// of the rejects output, of a failed check with WithFailOnCheck or of writing the
+// column statistics file.
+func (o *sourceObservers) finish(cs *Exporter, stats *Stats, err error) error {
+	if o.rejects != nil {
+		stats.Rejected = o.rejects.rejected
+		if rejectsErr := o.rejects.finish(); err == nil {
+			err = rejectsErr
+		}
+	}
+	if o.checks != nil {
+		var checkErr error
+		stats.Checks, checkErr = o.checks.result()
+		if err == nil && cs.failOnCheck {
+			err = checkErr
+		}
+	}
+	if o.columnStats != nil {
+		stats.Columns = o.columnStats.result()
+		if err == nil {
+			err = cs.writeColumnStatsFile(stats.Columns)
+		}
+	}
+	return err
+}
+
+// errSplitOutput reports options that apply to a single output and are not
+// supported by WriteShards, WriteChunks and Chunks.
+func (cs *Exporter) errSplitOutput() error {
+	switch {
+	case cs.maxBytes > 0:
+		return errors.New("exporter: WithMaxBytes is not supported with shards or chunks")
+	case cs.integrityFooter:
+		return errors.New("exporter: WithIntegrityFooter is not supported with shards or chunks")
+	case cs.asyncLimit > 0:
+		return errors.New("exporter: WithAsyncWrite is not supported with shards or chunks")
+	}
+	return nil
+}
+
+// export writes the prologue, the codec output for rows and the epilogue.
+func (cs *Exporter) export(writer io.Writer, rows scanner.Rows) error {
+	if cs.prologue != nil {
+		if err := cs.prologue(writer); err != nil {
+			return err
+		}
+	}
+	if len(cs.columnHints) != 0 {
+		rows = scanner.WithColumnHints(rows, cs.columnHints)
+	}
+	if err := cs.codec.Write(cs.withNullPolicy(rows), writer); err != nil {
+		return err
+	}
+	if cs.epilogue != nil {
+		return cs.epilogue(writer)
+	}
+	return nil
+}
+
+// withNullPolicy attaches the NULL policy to rows if one is set.
+func (cs *Exporter) withNullPolicy(rows scanner.Rows) scanner.Rows {
+	if cs.nullPolicy == scanner.NullDefault {
+		return rows
+	}
+	return scanner.WithNullPolicy(rows, cs.nullPolicy)
+}
+
+// source returns the rows passed to the codec, wrapped with the configured transformations.
+// Sanitization and truncation always run after the user-defined transformations.
+func (cs *Exporter) source() scanner.Rows {
+	transforms := cs.transforms
+	if cs.hasCellCleaning() {
+		transforms = append(transforms[:len(transforms):len(transforms)], transform{fn: cs.cleanCell})
+	}
+	if loc := cs.timeZone; loc != nil || cs.deterministic {
+		if loc == nil {
+			loc = time.UTC
+		}
+		transforms = append(transforms[:len(transforms):len(transforms)], transform{fn: inTimeZone(loc)})
+	}
+	if len(transforms) == 0 {
+		return cs.rows
+	}
+	return newTransformRows(cs.rows, transforms)
 }
 
 // WriteFile writes the exported data directly to a file specified by filename.
@@ -39,10 +426,14 @@ func (cs *Exporter) WriteFile(filename string) error {
 		return err
 	}
 	defer f.Close()
-	if err = cs.Write(f); err != nil {
+	_, summary, err := cs.exportFile(f)
+	if err != nil {
 		_ = f.Sync()
 		return err
 	}
 	_ = f.Sync()
-	return f.Close()
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return writeSummary(filename, summary)
 }
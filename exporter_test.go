@@ -0,0 +1,1728 @@
+package exporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/go-data-exporter/exporter/codec"
+	csvcodec "github.com/go-data-exporter/exporter/codec/csv"
+	htmlcodec "github.com/go-data-exporter/exporter/codec/html"
+	jsoncodec "github.com/go-data-exporter/exporter/codec/json"
+	"github.com/go-data-exporter/exporter/exportertest"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+func TestWithColumnConverter(t *testing.T) {
+	ts := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	data := [][]any{
+		{1, ts},
+		{2, nil},
+	}
+	e := New(scanner.FromData(data), codec.CSV(),
+		WithColumnConverter("column_1", func(v any, _ scanner.Metadata) any {
+			if t, ok := v.(time.Time); ok {
+				return t.Format(time.DateOnly)
+			}
+			return v
+		}),
+	)
+	var buf bytes.Buffer
+	if err := e.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "column_0,column_1\n1,2024-03-04\n2,\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+	if data[0][1] != ts {
+		t.Error("source data was modified")
+	}
+}
+
+func TestWithColumnConverterUnknownColumn(t *testing.T) {
+	e := New(scanner.FromData([][]any{{1}}), codec.CSV(),
+		WithColumnConverter("missing", func(v any, _ scanner.Metadata) any { return v }),
+	)
+	var buf bytes.Buffer
+	if err := e.Write(&buf); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("expected unknown column error, got %v", err)
+	}
+}
+
+func TestCellCleaning(t *testing.T) {
+	data := [][]any{
+		{"line1\r\nline2\x00\x07", []byte("abcdefghij"), 42},
+		{"привет, мир", "short", 7},
+	}
+	e := New(scanner.FromData(data), codec.JSON(),
+		WithMaxCellLength(6, "…"),
+		WithSanitize(true, true),
+	)
+	var buf bytes.Buffer
+	if err := e.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{`"line1…"`, `"приве…"`, `"short"`, `42`, `"YWJjZGXigKY="`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output %s does not contain %s", out, want)
+		}
+	}
+
+	// Binary data is neither sanitized nor cut.
+	binary := []byte{0xff, 0x00, 0x07, '\r', 0xfe, 0x80, 0x01, 0x02}
+	rows := scanner.FromData([][]any{{binary}})
+	buf.Reset()
+	err := New(rows, codec.JSON(), WithMaxCellLength(4, "…"), WithSanitize(true, true)).Write(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := base64.StdEncoding.EncodeToString(binary); !strings.Contains(buf.String(), want) {
+		t.Errorf("output %s does not contain the unchanged binary value %s", buf.String(), want)
+	}
+}
+
+func TestCleanString(t *testing.T) {
+	e := New(nil, nil, WithSanitize(true, true))
+	if got := e.cleanString("a\r\nb\rc\x1bd\te"); got != "a\nb\ncd\te" {
+		t.Errorf("got %q", got)
+	}
+	e = New(nil, nil, WithMaxCellLength(2, "..."))
+	if got := e.cleanString("abcdef"); got != "ab" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestWithCellTemplate(t *testing.T) {
+	data := [][]any{{1.5}, {nil}, {2.25}}
+	tmpl := template.Must(template.New("price").Parse(`{{printf "%.2f" .Value}} USD ({{.Metadata.RowID}})`))
+	e := New(scanner.FromData(data), codec.CSV(csvcodec.WithHeader(false)), WithCellTemplate("column_0", tmpl))
+	var buf bytes.Buffer
+	if err := e.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "1.50 USD (1)\n\n2.25 USD (3)\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestWithValueMap(t *testing.T) {
+	data := [][]any{{int64(1)}, {[]byte("2")}, {nil}, {3}}
+	e := New(scanner.FromData(data), codec.CSV(csvcodec.WithHeader(false)),
+		WithValueMap("column_0", map[any]string{1: "active", 2: "disabled"}, "unknown"),
+	)
+	var buf bytes.Buffer
+	if err := e.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "active\ndisabled\n\nunknown\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestBytesAndString(t *testing.T) {
+	data := [][]any{{1, "a"}, {2, "b"}}
+	s, err := New(scanner.FromData(data), codec.CSV()).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != "column_0,column_1\n1,a\n2,b\n" {
+		t.Errorf("got %q", s)
+	}
+
+	_, err = New(scanner.FromData(data), codec.JSON(), WithMaxBufferSize(10)).Bytes()
+	if !errors.Is(err, ErrTooLarge) {
+		t.Errorf("expected ErrTooLarge, got %v", err)
+	}
+}
+
+// errWriter always fails.
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestWriteAll(t *testing.T) {
+	data := [][]any{{1}, {2}}
+	var a, b bytes.Buffer
+	err := New(scanner.FromData(data), codec.CSV()).WriteAll(&a, errWriter{}, &b)
+	var destErr *DestinationError
+	if !errors.As(err, &destErr) || destErr.Index != 1 {
+		t.Fatalf("expected error for destination 1, got %v", err)
+	}
+	want := "column_0\n1\n2\n"
+	if a.String() != want || b.String() != want {
+		t.Errorf("got %q and %q, want %q", a.String(), b.String(), want)
+	}
+}
+
+func TestWriteDestinations(t *testing.T) {
+	dir := t.TempDir()
+	ok, err := FileDestination(dir + "/ok.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := New(scanner.FromData([][]any{{1}}), codec.CSV()).WriteDestinations(ok); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(dir + "/ok.csv"); string(data) != "column_0\n1\n" {
+		t.Errorf("unexpected file content %q", data)
+	}
+
+	aborted, err := FileDestination(dir + "/aborted.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := New(scanner.FromData([][]any{{1}}), codec.CSV(),
+		WithColumnConverter("missing", func(v any, _ scanner.Metadata) any { return v }))
+	if err := e.WriteDestinations(aborted); err == nil {
+		t.Fatal("expected export error")
+	}
+	if _, err := os.Stat(dir + "/aborted.csv"); !os.IsNotExist(err) {
+		t.Errorf("aborted file should be removed, got %v", err)
+	}
+}
+
+func TestPrologueAndEpilogue(t *testing.T) {
+	e := New(scanner.FromData([][]any{{1}}), codec.CSV(),
+		WithPrologue("# generated\n"),
+		WithEpilogueFunc(func(w io.Writer) error {
+			_, err := io.WriteString(w, "# end\n")
+			return err
+		}),
+	)
+	s, err := e.String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != "# generated\ncolumn_0\n1\n# end\n" {
+		t.Errorf("got %q", s)
+	}
+}
+
+func TestExportWithMaxBytes(t *testing.T) {
+	data := make([][]any, 100)
+	for i := range data {
+		data[i] = []any{i, "some text"}
+	}
+	var buf bytes.Buffer
+	stats, err := New(scanner.FromData(data), codec.JSON(), WithMaxBytes(100)).Export(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stats.Truncated || stats.Rows == 0 || stats.Rows == 100 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+	if stats.Bytes != int64(buf.Len()) {
+		t.Errorf("stats report %d bytes, buffer has %d", stats.Bytes, buf.Len())
+	}
+	if !strings.HasSuffix(buf.String(), "\n]\n") {
+		t.Errorf("JSON array not closed: %q", buf.String())
+	}
+
+	stats, err = New(scanner.FromData(data[:2]), codec.JSON(), WithMaxBytes(1<<20)).Export(io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Truncated || stats.Rows != 2 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestExportStatsRows(t *testing.T) {
+	data := make([][]any, 1000)
+	for i := range data {
+		data[i] = []any{i}
+	}
+	sources := map[string]func() scanner.Rows{
+		"batch": func() scanner.Rows { return scanner.FromData(data) },
+		"plain": func() scanner.Rows { return estimatingRows{scanner.FromData(data), 1000} },
+	}
+	for name, source := range sources {
+		// Rows read ahead in batches or beyond the limit are not counted.
+		stats, err := New(source(), codec.JSON(jsoncodec.WithLimit(2))).Export(io.Discard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats.Rows != 2 {
+			t.Errorf("%s source: got %d rows, want 2", name, stats.Rows)
+		}
+	}
+}
+
+// estimatingRows adds a row estimate to a Rows.
+type estimatingRows struct {
+	scanner.Rows
+	n int64
+}
+
+func (r estimatingRows) EstimateRows() (int64, bool) { return r.n, true }
+
+func TestPreview(t *testing.T) {
+	data := [][]any{{1}, {2}, {3}}
+	p, err := Preview(estimatingRows{scanner.FromData(data), 3}, codec.CSV(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(p.Data) != "column_0\n1\n2\n" || p.Rows != 2 {
+		t.Errorf("unexpected preview %q (%d rows)", p.Data, p.Rows)
+	}
+	if len(p.Columns) != 1 || !p.HasEstimate || p.EstimatedRows != 3 {
+		t.Errorf("unexpected preview metadata %+v", p)
+	}
+}
+
+func TestCompression(t *testing.T) {
+	data := make([][]any, 5000)
+	for i := range data {
+		data[i] = []any{i, "some text to compress"}
+	}
+	var plain bytes.Buffer
+	if err := New(scanner.FromData(data), codec.CSV()).Write(&plain); err != nil {
+		t.Fatal(err)
+	}
+	for _, opts := range [][]Option{
+		{WithCompression(CompressionGzip, gzip.BestSpeed)},
+		{WithCompression(CompressionGzip, gzip.BestSpeed), WithCompressionConcurrency(4, 1000)},
+	} {
+		var buf bytes.Buffer
+		stats, err := New(scanner.FromData(data), codec.CSV(), opts...).Export(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats.Bytes != int64(buf.Len()) || buf.Len() >= plain.Len() {
+			t.Errorf("unexpected compressed size %d (stats %+v)", buf.Len(), stats)
+		}
+		zr, err := gzip.NewReader(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(zr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, plain.Bytes()) {
+			t.Errorf("decompressed output differs from the uncompressed export")
+		}
+	}
+
+	var empty bytes.Buffer
+	err := New(scanner.FromData(nil), codec.CSV(),
+		WithCompression(CompressionGzip, gzip.DefaultCompression),
+		WithCompressionConcurrency(2, 0),
+	).Write(&empty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gzip.NewReader(&empty); err != nil {
+		t.Errorf("empty export is not a valid gzip stream: %v", err)
+	}
+}
+
+func TestWithBlobFiles(t *testing.T) {
+	dir := t.TempDir()
+	data := [][]any{{1, scanner.Blob{Reader: strings.NewReader("attachment")}}, {2, nil}}
+	var buf bytes.Buffer
+	err := New(scanner.FromData(data), codec.CSV(), WithBlobFiles(dir, nil)).Write(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "column_1_1.bin")
+	if want := "column_0,column_1\n1," + path + "\n2,\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+	if content, err := os.ReadFile(path); err != nil || string(content) != "attachment" {
+		t.Errorf("unexpected blob file content %q: %v", content, err)
+	}
+}
+
+func TestWriteShards(t *testing.T) {
+	data := make([][]any, 100)
+	for i := range data {
+		data[i] = []any{i, i % 7}
+	}
+	for _, parallel := range []bool{false, true} {
+		dir := t.TempDir()
+		pattern := filepath.Join(dir, "part-%d.csv")
+		e := New(scanner.FromData(data), codec.CSV(), WithShards(3, "column_1"), WithParallelShards(parallel))
+		if err := e.WriteShards(ShardFiles(pattern)); err != nil {
+			t.Fatal(err)
+		}
+		total := 0
+		shardOf := map[string]int{}
+		for shard := range 3 {
+			content, err := os.ReadFile(fmt.Sprintf(pattern, shard))
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+			if lines[0] != "column_0,column_1" {
+				t.Errorf("shard %d has no header: %q", shard, lines[0])
+			}
+			for _, line := range lines[1:] {
+				key := strings.Split(line, ",")[1]
+				if prev, ok := shardOf[key]; ok && prev != shard {
+					t.Errorf("key %s is in shards %d and %d", key, prev, shard)
+				}
+				shardOf[key] = shard
+				total++
+			}
+		}
+		if total != len(data) {
+			t.Errorf("parallel=%v: got %d rows in shards, want %d", parallel, total, len(data))
+		}
+	}
+
+	err := New(scanner.FromData(data), codec.CSV(), WithShards(2, "missing")).WriteShards(ShardFiles(filepath.Join(t.TempDir(), "%d")))
+	if err == nil {
+		t.Error("expected an error for an unknown key column")
+	}
+}
+
+// closingRows records whether it has been closed.
+type closingRows struct {
+	scanner.Rows
+	closed bool
+}
+
+func (r *closingRows) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestExportClosesRows(t *testing.T) {
+	rows := &closingRows{Rows: scanner.FromData([][]any{{1}})}
+	if err := New(rows, codec.CSV()).Write(io.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if !rows.closed {
+		t.Error("rows were not closed after the export")
+	}
+
+	rows = &closingRows{Rows: scanner.FromData([][]any{{1}})}
+	err := New(rows, codec.CSV(), WithPrologueFunc(func(io.Writer) error {
+		return errors.New("prologue failed")
+	})).Write(io.Discard)
+	if err == nil || !rows.closed {
+		t.Errorf("rows were not closed after a failed export: %v", err)
+	}
+}
+
+func TestWithColumnStats(t *testing.T) {
+	data := [][]any{
+		{1, "apple"},
+		{3, nil},
+		{2, "kiwi"},
+		{3, "apple"},
+	}
+	sidecar := filepath.Join(t.TempDir(), "stats.json")
+	stats, err := New(scanner.FromData(data), codec.CSV(), WithColumnStatsFile(sidecar)).Export(io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Columns) != 2 {
+		t.Fatalf("got %d column stats, want 2", len(stats.Columns))
+	}
+	ids, names := stats.Columns[0], stats.Columns[1]
+	if ids.Count != 4 || ids.Nulls != 0 || ids.Min != int64(1) || ids.Max != int64(3) || ids.DistinctEstimate != 3 {
+		t.Errorf("unexpected stats for column_0: %+v", ids)
+	}
+	if names.Count != 3 || names.Nulls != 1 || names.Min != "apple" || names.Max != "kiwi" ||
+		names.MaxLength != 5 || names.DistinctEstimate != 2 {
+		t.Errorf("unexpected stats for column_1: %+v", names)
+	}
+	content, err := os.ReadFile(sidecar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), `"distinct_estimate": 3`) {
+		t.Errorf("unexpected sidecar file:\n%s", content)
+	}
+}
+
+func TestWithChecks(t *testing.T) {
+	data := [][]any{
+		{"a@example.com", 5, "DE"},
+		{"b@example.com", 12, "FR"},
+		{nil, 7, "XX"},
+		{"invalid", 3, nil},
+	}
+	checks := WithChecks(
+		NotNull("column_0", 0.7),
+		Matches("column_0", regexp.MustCompile(`^[^@]+@[^@]+$`), 0.5),
+		InRange("column_1", 1, 10),
+		InSet("column_2", "DE", "FR"),
+	)
+	stats, err := New(scanner.FromData(data), codec.CSV(), checks).Export(io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []CheckResult{
+		{Check: "not null(column_0)", Column: "column_0", Checked: 4, Violations: 1, Passed: true},
+		{Check: "matches(column_0, ^[^@]+@[^@]+$)", Column: "column_0", Checked: 3, Violations: 1, Passed: true},
+		{Check: "in range(column_1, 1, 10)", Column: "column_1", Checked: 4, Violations: 1, Passed: false},
+		{Check: "in set(column_2)", Column: "column_2", Checked: 3, Violations: 1, Passed: false},
+	}
+	if !reflect.DeepEqual(stats.Checks, want) {
+		t.Errorf("got check results\n%+v\nwant\n%+v", stats.Checks, want)
+	}
+
+	_, err = New(scanner.FromData(data), codec.CSV(), checks, WithFailOnCheck(true)).Export(io.Discard)
+	var checkErr *CheckError
+	if !errors.As(err, &checkErr) || len(checkErr.Failed) != 2 {
+		t.Errorf("expected a CheckError with 2 failed checks, got %v", err)
+	}
+
+	// Sharded and chunked exports run the checks as well.
+	err = New(scanner.FromData(data), codec.CSV(), checks, WithFailOnCheck(true), WithShards(2, "")).
+		WriteShards(func(int) (Destination, error) { return nopDestination{io.Discard}, nil })
+	if !errors.As(err, &checkErr) {
+		t.Errorf("expected a CheckError from WriteShards, got %v", err)
+	}
+	for _, err = range New(scanner.FromData(data), codec.CSV(), checks, WithFailOnCheck(true)).Chunks(10) {
+	}
+	if !errors.As(err, &checkErr) {
+		t.Errorf("expected a CheckError from Chunks, got %v", err)
+	}
+	err = New(scanner.FromData(data), codec.CSV(), WithMaxBytes(10)).
+		WriteShards(func(int) (Destination, error) { return nopDestination{io.Discard}, nil })
+	if err == nil {
+		t.Error("expected an error for WithMaxBytes with shards")
+	}
+}
+
+// nopDestination is a Destination whose Close does nothing.
+type nopDestination struct {
+	io.Writer
+}
+
+func (nopDestination) Close() error { return nil }
+
+func TestWithTokenizedColumns(t *testing.T) {
+	tok := &tokenizer{key: []byte("secret")}
+	seen := make(map[int8]int8)
+	for i := math.MinInt8; i <= math.MaxInt8; i++ {
+		v, err := tok.tokenize(int8(i), scanner.Metadata{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		token := v.(int8)
+		if prev, ok := seen[token]; ok {
+			t.Fatalf("%d and %d share the token %d", prev, i, token)
+		}
+		seen[token] = int8(i)
+		if (token < 0) != (i < 0) || len(strconv.Itoa(int(token))) != len(strconv.Itoa(i)) {
+			t.Errorf("token %d of %d does not preserve the format", token, i)
+		}
+	}
+
+	data := [][]any{
+		{int64(4711), "007123", "jane@example.com"},
+		{int64(4711), "007123", "john@example.com"},
+	}
+	var buf bytes.Buffer
+	err := New(scanner.FromData(data), codec.CSV(),
+		WithTokenizedColumns([]byte("secret"), "column_0", "column_1", "column_2")).Write(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	first, second := strings.Split(lines[1], ","), strings.Split(lines[2], ",")
+	if first[0] != second[0] || len(first[0]) != 4 || first[0] == "4711" {
+		t.Errorf("unexpected tokens for the integer ID: %q, %q", first[0], second[0])
+	}
+	if first[1] != second[1] || len(first[1]) != 6 || !isDigits(first[1]) || first[1] == "007123" {
+		t.Errorf("unexpected tokens for the string ID: %q, %q", first[1], second[1])
+	}
+	if first[2] == second[2] || len(first[2]) != 32 {
+		t.Errorf("unexpected tokens for the emails: %q, %q", first[2], second[2])
+	}
+}
+
+func TestWithEncryptedColumns(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	data := [][]any{
+		{1, "123-45-6789"},
+		{2, nil},
+	}
+	var buf bytes.Buffer
+	if err := New(scanner.FromData(data), codec.CSV(), WithEncryptedColumns([]string{"column_1"}, key)).Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	first := strings.Split(lines[1], ",")
+	if first[0] != "1" || strings.Contains(first[1], "6789") {
+		t.Fatalf("unexpected row: %q", lines[1])
+	}
+	plaintext, err := DecryptValue(key, "column_1", first[1])
+	if err != nil || plaintext != "123-45-6789" {
+		t.Errorf("DecryptValue() = %q, %v", plaintext, err)
+	}
+	if _, err := DecryptValue(key, "column_0", first[1]); err == nil {
+		t.Error("expected an error when decrypting a value of another column")
+	}
+	if lines[2] != "2," {
+		t.Errorf("NULL value was encrypted: %q", lines[2])
+	}
+
+	err = New(scanner.FromData(data), codec.CSV(), WithEncryptedColumns([]string{"column_1"}, []byte("short"))).Write(io.Discard)
+	if err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+
+	// Only WithDeterministicEncryptedColumns encrypts equal values equally.
+	data = [][]any{{"secret"}, {"secret"}}
+	for _, deterministic := range []bool{false, true} {
+		encrypt := WithEncryptedColumns
+		if deterministic {
+			encrypt = WithDeterministicEncryptedColumns
+		}
+		out, err := New(scanner.FromData(data), codec.CSV(csvcodec.WithHeader(false)), WithDeterministicOutput(true),
+			encrypt([]string{"column_0"}, key)).String()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values := strings.Fields(out)
+		if (values[0] == values[1]) != deterministic {
+			t.Errorf("deterministic %v: got %q", deterministic, values)
+		}
+		if plaintext, err := DecryptValue(key, "column_0", values[1]); err != nil || plaintext != "secret" {
+			t.Errorf("deterministic %v: DecryptValue() = %q, %v", deterministic, plaintext, err)
+		}
+	}
+}
+
+func TestWithAuditor(t *testing.T) {
+	var log bytes.Buffer
+	filename := filepath.Join(t.TempDir(), "out.csv")
+	e := New(scanner.FromData([][]any{{1}, {2}}), codec.CSV(),
+		WithAuditor(NewJSONAuditor(&log), "alice", "SELECT id FROM users"))
+	if err := e.WriteFile(filename); err != nil {
+		t.Fatal(err)
+	}
+	var record AuditRecord
+	if err := json.Unmarshal(log.Bytes(), &record); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(content)
+	if record.User != "alice" || record.Source != "SELECT id FROM users" || record.Rows != 2 ||
+		record.Bytes != int64(len(content)) || record.Destination != filename ||
+		record.Checksum != hex.EncodeToString(sum[:]) || record.Started.IsZero() || record.Error != "" {
+		t.Errorf("unexpected audit record: %+v", record)
+	}
+
+	auditErr := errors.New("audit failed")
+	err = New(scanner.FromData([][]any{{1}}), codec.CSV(), WithAuditor(AuditorFunc(func(AuditRecord) error {
+		return auditErr
+	}), "", "")).Write(io.Discard)
+	if !errors.Is(err, auditErr) {
+		t.Errorf("expected the auditor error, got %v", err)
+	}
+}
+
+func TestWithRejects(t *testing.T) {
+	data := [][]any{
+		{"1", "10"},
+		{"2", "ten"},
+		{"3", "30"},
+	}
+	rows := scanner.Cast(scanner.FromData(data), map[string]scanner.TargetType{"column_1": scanner.TypeInt64})
+	var out, rejects bytes.Buffer
+	stats, err := New(rows, codec.CSV(), WithRejects(&rejects, "error")).Export(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "column_0,column_1\n1,10\n3,30\n"; out.String() != want {
+		t.Errorf("got output\n%s\nwant\n%s", out.String(), want)
+	}
+	want := "column_0,column_1,error\n" +
+		`2,ten,"cast column ""column_1"" row 2: strconv.ParseInt: parsing ""ten"": invalid syntax"` + "\n"
+	if rejects.String() != want {
+		t.Errorf("got rejects\n%s\nwant\n%s", rejects.String(), want)
+	}
+	if stats.Rows != 2 || stats.Rejected != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestClickHouseDestination(t *testing.T) {
+	var query, user, body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, user = r.URL.Query().Get("query"), r.Header.Get("X-ClickHouse-User")
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		if r.URL.Query().Get("database") != "analytics" {
+			http.Error(w, "Code: 81. DB::Exception: Database does not exist", http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	dest, err := ClickHouseDestination(ctx, srv.URL, "events", "CSVWithNames",
+		WithClickHouseAuth("default", "secret"), WithClickHouseDatabase("analytics"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := New(scanner.FromData([][]any{{1, "a"}}), codec.CSV()).WriteDestinations(dest); err != nil {
+		t.Fatal(err)
+	}
+	if query != "INSERT INTO events FORMAT CSVWithNames" || user != "default" || body != "column_0,column_1\n1,a\n" {
+		t.Errorf("unexpected request: query %q, user %q, body %q", query, user, body)
+	}
+
+	dest, err = ClickHouseDestination(ctx, srv.URL, "events", "CSVWithNames")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = New(scanner.FromData([][]any{{1, "a"}}), codec.CSV()).WriteDestinations(dest)
+	if err == nil || !strings.Contains(err.Error(), "Database does not exist") {
+		t.Errorf("expected the ClickHouse error, got %v", err)
+	}
+}
+
+// execRecorder is a database/sql driver that records executed statements.
+type execRecorder struct {
+	statements []string
+	uploaded   string
+}
+
+func (r *execRecorder) Open(string) (driver.Conn, error) { return r, nil }
+func (r *execRecorder) Prepare(query string) (driver.Stmt, error) {
+	return &execRecorderStmt{r: r, query: query}, nil
+}
+func (r *execRecorder) Close() error              { return nil }
+func (r *execRecorder) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type execRecorderStmt struct {
+	r     *execRecorder
+	query string
+}
+
+func (s *execRecorderStmt) Close() error  { return nil }
+func (s *execRecorderStmt) NumInput() int { return 0 }
+func (s *execRecorderStmt) Exec([]driver.Value) (driver.Result, error) {
+	if rest, ok := strings.CutPrefix(s.query, "PUT 'file://"); ok {
+		path, tail, _ := strings.Cut(rest, "'")
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		s.r.uploaded = string(content)
+		s.query = "PUT '" + filepath.Base(path) + "'" + tail
+	}
+	s.r.statements = append(s.r.statements, s.query)
+	return driver.RowsAffected(0), nil
+}
+func (s *execRecorderStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestSnowflakeStageDestination(t *testing.T) {
+	rec := &execRecorder{}
+	sql.Register("exporter-snowflake-recorder", rec)
+	db, err := sql.Open("exporter-snowflake-recorder", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	dest, err := SnowflakeStageDestination(context.Background(), db, "@exports/daily", "users.csv",
+		WithSnowflakeCopyInto("users", "TYPE = CSV SKIP_HEADER = 1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := New(scanner.FromData([][]any{{1}}), codec.CSV()).WriteDestinations(dest); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"PUT 'users.csv' @exports/daily",
+		"COPY INTO users FROM @exports/daily/users.csv FILE_FORMAT = (TYPE = CSV SKIP_HEADER = 1)",
+	}
+	if !reflect.DeepEqual(rec.statements, want) || rec.uploaded != "column_0\n1\n" {
+		t.Errorf("unexpected statements %q with upload %q", rec.statements, rec.uploaded)
+	}
+	if _, err := os.Stat(dest.(*snowflakeDestination).dir); !os.IsNotExist(err) {
+		t.Error("temporary directory was not removed")
+	}
+}
+
+func TestWriteChunks(t *testing.T) {
+	var chunks []string
+	var completion string
+	failed := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		if r.URL.Path == "/done" {
+			completion = string(data)
+			return
+		}
+		if r.Header.Get("X-Chunk-Index") == "1" && !failed {
+			failed = true
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		chunks = append(chunks, string(data))
+	}))
+	defer srv.Close()
+
+	data := [][]any{{1}, {2}, {3}, {4}, {5}}
+	sender := NewHTTPChunkSender(context.Background(), srv.URL, "text/csv",
+		WithHTTPRetries(2, time.Millisecond), WithHTTPCompletion(srv.URL+"/done"))
+	if err := New(scanner.FromData(data), codec.CSV(), WithChunkSize(2, 0)).WriteChunks(sender); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"column_0\n1\n2\n", "column_0\n3\n4\n", "column_0\n5\n"}
+	if !reflect.DeepEqual(chunks, want) {
+		t.Errorf("got chunks %q, want %q", chunks, want)
+	}
+	if completion != `{"chunks":3}` {
+		t.Errorf("unexpected completion %q", completion)
+	}
+}
+
+func TestExportStore(t *testing.T) {
+	store, err := NewExportStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(t.TempDir(), "users.csv")
+	opened := 0
+	open := func() (Destination, error) {
+		opened++
+		return FileDestination(filename)
+	}
+	export := func(data [][]any) {
+		t.Helper()
+		dest, err := store.Destination("users", open)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := New(scanner.FromData(data), codec.CSV()).WriteDestinations(dest); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	export([][]any{{1}})
+	export([][]any{{1}})
+	if opened != 1 {
+		t.Errorf("identical export was delivered %d times", opened)
+	}
+	export([][]any{{2}})
+	if opened != 2 {
+		t.Error("changed export was not delivered")
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil || string(content) != "column_0\n2\n" {
+		t.Errorf("unexpected file content %q: %v", content, err)
+	}
+	sum := sha256.Sum256(content)
+	if checksum, ok := store.Checksum("users"); !ok || checksum != hex.EncodeToString(sum[:]) {
+		t.Errorf("unexpected checksum %q", checksum)
+	}
+}
+
+func TestWithDeterministicOutput(t *testing.T) {
+	ts := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	export := func(loc *time.Location) string {
+		t.Helper()
+		data := [][]any{{ts.In(loc), "secret"}}
+		out, err := New(scanner.FromData(data), codec.CSV(), WithDeterministicOutput(true),
+			WithDeterministicEncryptedColumns([]string{"column_1"}, []byte("0123456789abcdef"))).String()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+	first, second := export(time.FixedZone("CET", 3600)), export(time.FixedZone("PST", -8*3600))
+	if first != second {
+		t.Errorf("output differs:\n%s\n%s", first, second)
+	}
+}
+
+func TestConvertFile(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "users.csv")
+	if err := os.WriteFile(in, []byte("id,name\n1,alice\n2,bob\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(dir, "users.jsonl.gz")
+	if err := ConvertFile(in, out); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "{\"id\":\"1\",\"name\":\"alice\"}\n{\"id\":\"2\",\"name\":\"bob\"}\n"
+	if string(content) != want {
+		t.Errorf("got %q, want %q", content, want)
+	}
+
+	back := filepath.Join(dir, "users.tsv")
+	if err := ConvertFile(out, back); err != nil {
+		t.Fatal(err)
+	}
+	if content, err := os.ReadFile(back); err != nil || string(content) != "id\tname\n1\talice\n2\tbob\n" {
+		t.Errorf("unexpected TSV %q: %v", content, err)
+	}
+
+	parquet := filepath.Join(dir, "users.parquet")
+	if err := ConvertFile(in, parquet); err != nil {
+		t.Fatal(err)
+	}
+	if content, err := os.ReadFile(parquet); err != nil || !bytes.HasPrefix(content, []byte("PAR1")) || !bytes.HasSuffix(content, []byte("PAR1")) {
+		t.Errorf("unexpected Parquet file %q: %v", content, err)
+	}
+	if err := ConvertFile(in, filepath.Join(dir, "users.xlsx")); err == nil || !strings.Contains(err.Error(), "unsupported output format") {
+		t.Errorf("expected an unsupported format error, got %v", err)
+	}
+
+	var b bytes.Buffer
+	if err := Convert(scanner.FromJSON(strings.NewReader(`[{"a": 1}]`)), codec.CSV(), &b); err != nil || b.String() != "a\n1\n" {
+		t.Errorf("unexpected CSV %q: %v", b.String(), err)
+	}
+}
+
+// codecFunc adapts a function to the codec.Codec interface.
+type codecFunc func(rows scanner.Rows, w io.Writer) error
+
+func (f codecFunc) Write(rows scanner.Rows, w io.Writer) error {
+	return f(rows, w)
+}
+
+func TestWithMetadata(t *testing.T) {
+	data := [][]any{{1}, {2}}
+	var got map[string]string
+	c := codecFunc(func(rows scanner.Rows, w io.Writer) error {
+		got = scanner.Describe(rows)
+		return nil
+	})
+	rows := scanner.WithRowEstimate(scanner.FromData(data), 2)
+	if err := New(rows, c, WithMetadata(map[string]string{"query": "users", MetadataRows: "two"}), WithDeterministicOutput(true)).Write(io.Discard); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"query": "users", MetadataDriver: "go-slice", MetadataRows: "two"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got metadata %v, want %v", got, want)
+	}
+
+	out, err := New(scanner.FromData(data), codec.HTML(htmlcodec.WithMetadataBlock(true)),
+		WithMetadata(map[string]string{"filter": "age > 18 & active"})).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, `<dl class="metadata"><dt>driver</dt><dd>go-slice</dd><dt>filter</dt><dd>age &gt; 18 &amp; active</dd><dt>generated_at</dt><dd>`) {
+		t.Errorf("metadata block not rendered: %s", out)
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	dir := t.TempDir()
+	dest, err := FileDestination(dir + "/users.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := [][]any{{1, "a@example.com", "x"}, {2, nil, "y"}, {3, "c@example.com", "z"}}
+	err = From(scanner.FromData(data)).
+		Select("column_1", "column_0").
+		Mask("column_1").
+		Limit(2).
+		As(codec.CSV()).
+		To(dest).
+		Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := os.ReadFile(dir + "/users.csv"); string(got) != "column_1,column_0\n****,1\n,2\n" {
+		t.Errorf("unexpected file content %q", got)
+	}
+
+	canceled, err := FileDestination(dir + "/canceled.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = From(scanner.FromData(data)).As(codec.CSV()).To(canceled).Run(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	if _, err := os.Stat(dir + "/canceled.csv"); !os.IsNotExist(err) {
+		t.Errorf("canceled file should be removed, got %v", err)
+	}
+
+	if err := From(scanner.FromData(data)).To(canceled).Run(context.Background()); err == nil {
+		t.Error("expected an error without a codec")
+	}
+}
+
+func TestWriteFilePattern(t *testing.T) {
+	dir := t.TempDir()
+	name, err := New(scanner.FromData([][]any{{1}, {2}}), codec.CSV()).
+		WriteFilePattern(filepath.Join(dir, "export_{date}_{driver}_{rows}.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := filepath.Join(dir, "export_"+time.Now().Format("2006-01-02")+"_go-slice_2.csv")
+	if name != want {
+		t.Errorf("got file %s, want %s", name, want)
+	}
+	if data, _ := os.ReadFile(name); string(data) != "column_0\n1\n2\n" {
+		t.Errorf("unexpected file content %q", data)
+	}
+	plain := filepath.Join(dir, "plain.csv")
+	if err := New(scanner.FromData(nil), codec.CSV()).WriteFile(plain); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plainInfo, err := os.Stat(plain); err != nil {
+		t.Error(err)
+	} else if info.Mode() != plainInfo.Mode() {
+		t.Errorf("got mode %v, want %v like WriteFile", info.Mode(), plainInfo.Mode())
+	}
+	os.Remove(plain)
+
+	// {rows} counts the written rows, not the rows read ahead from the source.
+	name, err = New(scanner.FromData([][]any{{1}, {2}, {3}}), codec.CSV(csvcodec.WithLimit(1))).
+		WriteFilePattern(filepath.Join(dir, "limited_{rows}.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(dir, "limited_1.csv"); name != want {
+		t.Errorf("got file %s, want %s", name, want)
+	}
+	os.Remove(name)
+
+	e := New(scanner.FromData([][]any{{1}}), codec.CSV(),
+		WithColumnConverter("missing", func(v any, _ scanner.Metadata) any { return v }))
+	if _, err := e.WriteFilePattern(filepath.Join(dir, "failed_{rows}.csv")); err == nil {
+		t.Fatal("expected export error")
+	}
+	if _, err := New(scanner.FromData(nil), codec.CSV()).WriteFilePattern(filepath.Join(dir, "{user}.csv")); err == nil {
+		t.Error("expected an error for an unknown variable")
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("got %d files, want only the successful export", len(entries))
+	}
+}
+
+func TestWithNullPolicy(t *testing.T) {
+	data := [][]any{{1, nil}}
+	for _, tc := range []struct {
+		codec  codec.Codec
+		policy scanner.NullPolicy
+		want   string
+	}{
+		{codec.CSV(csvcodec.WithCustomNULL(`\N`)), scanner.NullDefault, "column_0,column_1\n1,\\N\n"},
+		{codec.CSV(csvcodec.WithCustomNULL(`\N`)), scanner.NullMarked, "column_0,column_1\n1,\n"},
+		{codec.HTML(), scanner.NullEmpty, "<tr><td>1</td><td></td></tr>"},
+		{codec.HTML(), scanner.NullMarked, `<td><span class="null">[NULL]</span></td>`},
+		{codec.XML(), scanner.NullEmpty, "<row><column_0>1</column_0></row>"},
+		{codec.XML(), scanner.NullMarked, `<data xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` + "\n" +
+			`<row><column_0>1</column_0><column_1 xsi:nil="true"></column_1></row>`},
+	} {
+		out, err := New(scanner.FromData(data), tc.codec, WithNullPolicy(tc.policy)).String()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out, tc.want) {
+			t.Errorf("policy %d: output %q does not contain %q", tc.policy, out, tc.want)
+		}
+	}
+}
+
+func TestWithSummaryFile(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "users.csv")
+	e := New(scanner.FromData([][]any{{1, "a"}, {2, "b"}}), codec.CSV(), WithSummaryFile(true), WithDeterministicOutput(true))
+	if err := e.WriteFile(filename); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filename + SummarySuffix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var summary Summary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		t.Fatal(err)
+	}
+	checksum := sha256.Sum256(content)
+	if summary.File != "users.csv" || summary.Rows != 2 || summary.Bytes != int64(len(content)) ||
+		summary.Checksum != hex.EncodeToString(checksum[:]) || !summary.Finished.IsZero() {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+	if len(summary.Schema.Columns) != 2 || summary.Schema.Columns[1].Name != "column_1" {
+		t.Errorf("unexpected schema %+v", summary.Schema)
+	}
+	if summary.Options.Codec != "*csvcodec.csvCodec" || !summary.Options.Deterministic {
+		t.Errorf("unexpected options %+v", summary.Options)
+	}
+
+	name, err := New(scanner.FromData([][]any{{1}}), codec.CSV(), WithSummaryFile(true)).
+		WriteFilePattern(filepath.Join(dir, "export_{rows}.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(name + SummarySuffix); err != nil {
+		t.Errorf("summary of %s not written: %v", name, err)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := [][]any{{1, "a"}, {2, "b"}, {3, "c"}}
+	updated := [][]any{{1, "a"}, {3, []byte("C")}, {4, "d"}}
+	for _, merge := range []bool{false, true} {
+		var buf bytes.Buffer
+		err := Diff(scanner.FromData(old), scanner.FromData(updated), []string{"column_0"}, &buf, codec.CSV(), WithDiffMerge(merge))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "column_0,column_1,_change\n3,C,changed\n4,d,added\n2,b,removed\n"
+		if merge {
+			want = "column_0,column_1,_change\n2,b,removed\n3,C,changed\n4,d,added\n"
+		}
+		if buf.String() != want {
+			t.Errorf("merge %v: got %q, want %q", merge, buf.String(), want)
+		}
+	}
+
+	err := Diff(scanner.FromData(old), scanner.FromData([][]any{{1}}), []string{"column_0"}, io.Discard, codec.CSV())
+	if err == nil {
+		t.Error("expected an error for different columns")
+	}
+}
+
+func TestDiffDuplicateKeys(t *testing.T) {
+	for _, merge := range []bool{false, true} {
+		for _, data := range [][2][][]any{
+			{{{1, "a"}, {1, "b"}}, {{1, "a"}}},
+			{{{1, "a"}}, {{1, "a"}, {1, "b"}}},
+			{{{1, "a"}}, {{2, "a"}, {2, "b"}}},
+		} {
+			err := Diff(scanner.FromData(data[0]), scanner.FromData(data[1]), []string{"column_0"}, io.Discard, codec.CSV(), WithDiffMerge(merge))
+			if err == nil || !strings.Contains(err.Error(), "duplicate diff key") {
+				t.Errorf("merge %v, %v: got %v, want a duplicate key error", merge, data, err)
+			}
+		}
+	}
+}
+
+func TestDiffMergeOrder(t *testing.T) {
+	// String keys are sorted byte-wise, numeric keys as numbers.
+	var buf bytes.Buffer
+	err := Diff(scanner.FromData([][]any{{"10"}, {"9"}}), scanner.FromData([][]any{{"9"}}), []string{"column_0"}, &buf, codec.CSV(), WithDiffMerge(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "column_0,_change\n10,removed\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+	buf.Reset()
+	err = Diff(scanner.FromData([][]any{{9}, {10}}), scanner.FromData([][]any{{10}}), []string{"column_0"}, &buf, codec.CSV(), WithDiffMerge(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "column_0,_change\n9,removed\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+
+	for _, old := range [][][]any{{{"9"}, {"10"}}, {{"1"}, {"1"}}} {
+		err = Diff(scanner.FromData(old), scanner.FromData([][]any{{"9"}}), []string{"column_0"}, io.Discard, codec.CSV(), WithDiffMerge(true))
+		if err == nil {
+			t.Errorf("expected an error for old keys %v", old)
+		}
+	}
+}
+
+func TestDelta(t *testing.T) {
+	index := filepath.Join(t.TempDir(), "users.idx")
+	run := func(data [][]any) string {
+		t.Helper()
+		var buf bytes.Buffer
+		if err := Delta(scanner.FromData(data), []string{"column_0"}, index, &buf, codec.CSV()); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+	if got, want := run([][]any{{1, "a"}, {2, "b"}}), "column_0,column_1,_change\n1,a,added\n2,b,added\n"; got != want {
+		t.Errorf("first run: got %q, want %q", got, want)
+	}
+	if got, want := run([][]any{{2, "B"}, {3, "c"}}), "column_0,column_1,_change\n2,B,changed\n3,c,added\n1,,removed\n"; got != want {
+		t.Errorf("second run: got %q, want %q", got, want)
+	}
+	if got, want := run([][]any{{2, "B"}, {3, "c"}}), "column_0,column_1,_change\n"; got != want {
+		t.Errorf("unchanged run: got %q, want %q", got, want)
+	}
+
+	err := Delta(scanner.FromData([][]any{{1, "a"}}), []string{"column_1"}, index, io.Discard, codec.CSV())
+	if err == nil {
+		t.Error("expected an error for other key columns")
+	}
+	err = Delta(scanner.FromData([][]any{{1, "a"}, {1, "b"}}), []string{"column_0"}, index, io.Discard, codec.CSV())
+	if err == nil {
+		t.Error("expected an error for a duplicate key")
+	}
+	if got, want := run([][]any{{2, "B"}, {3, "c"}}), "column_0,column_1,_change\n"; got != want {
+		t.Errorf("index changed by a failed run: got %q, want %q", got, want)
+	}
+
+	// An export that ends early must not drop the keys it did not reach.
+	err = Delta(scanner.FromData([][]any{{4, "d"}}), []string{"column_0"}, index, io.Discard, codec.CSV(csvcodec.WithLimit(1)))
+	if err == nil {
+		t.Error("expected an error for a delta export ended by the codec limit")
+	}
+	err = Delta(scanner.FromData([][]any{{4, "d"}}), []string{"column_0"}, index, io.Discard,
+		codec.CSV(csvcodec.WithFlushEveryRows(1)), WithMaxBytes(20))
+	if err == nil {
+		t.Error("expected an error for a truncated delta export")
+	}
+	if got, want := run([][]any{{2, "B"}, {3, "c"}}), "column_0,column_1,_change\n"; got != want {
+		t.Errorf("index changed by a truncated run: got %q, want %q", got, want)
+	}
+}
+
+func TestRunAll(t *testing.T) {
+	dir := t.TempDir()
+	data := [][]any{{1, "a"}, {2, "b"}}
+	job := func(name string, openErr error) Job {
+		return Job{Name: name, Open: func(ctx context.Context) (*Builder, error) {
+			if openErr != nil {
+				return nil, openErr
+			}
+			dest, err := FileDestination(filepath.Join(dir, name+".csv"))
+			if err != nil {
+				return nil, err
+			}
+			return From(scanner.FromData(data)).As(codec.CSV()).To(dest), nil
+		}}
+	}
+	errBroken := errors.New("broken")
+	jobs := []Job{job("users", nil), job("orders", errBroken), job("items", nil)}
+
+	report, err := RunAll(context.Background(), jobs, 2, WithRowRate(1000))
+	var jobErr *JobError
+	if !errors.As(err, &jobErr) || jobErr.Name != "orders" || !errors.Is(err, errBroken) {
+		t.Fatalf("got error %v, want failure of orders", err)
+	}
+	if report.Rows != 4 || report.Failed != 1 || report.Skipped != 0 || len(report.Jobs) != 3 {
+		t.Errorf("unexpected report %+v", report)
+	}
+	if got, _ := os.ReadFile(filepath.Join(dir, "items.csv")); string(got) != "column_0,column_1\n1,a\n2,b\n" {
+		t.Errorf("unexpected file content %q", got)
+	}
+
+	report, err = RunAll(context.Background(), jobs, 1, WithFailFast(true))
+	if !errors.Is(err, errBroken) {
+		t.Fatalf("got error %v, want %v", err, errBroken)
+	}
+	if !report.Jobs[2].Skipped || report.Skipped != 1 || report.Rows != 2 {
+		t.Errorf("items should be skipped after the failure, got %+v", report)
+	}
+}
+
+func TestWithColumnHint(t *testing.T) {
+	var got string
+	c := codecFunc(func(rows scanner.Rows, w io.Writer) error {
+		cols, err := rows.Columns()
+		if err != nil {
+			return err
+		}
+		got, _ = scanner.Hint(cols[1], scanner.HintCompression)
+		return nil
+	})
+	rows := scanner.FromData([][]any{{1, "a"}})
+	if err := New(rows, c, WithColumnHint("column_1", scanner.HintCompression, "zstd")).Write(io.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if got != "zstd" {
+		t.Errorf("got compression hint %q, want %q", got, "zstd")
+	}
+}
+
+func TestWithTimeZone(t *testing.T) {
+	ts := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	data := [][]any{
+		{ts.In(time.FixedZone("CET", 3600))},
+		{sql.NullTime{Time: ts.In(time.FixedZone("PST", -8*3600)), Valid: true}},
+	}
+	tokyo := time.FixedZone("JST", 9*3600)
+	var got []time.Time
+	c := codecFunc(func(rows scanner.Rows, w io.Writer) error {
+		for rows.Next() {
+			row, err := rows.ScanRow()
+			if err != nil {
+				return err
+			}
+			switch v := row[0].(type) {
+			case time.Time:
+				got = append(got, v)
+			case sql.NullTime:
+				got = append(got, v.Time)
+			}
+		}
+		return rows.Err()
+	})
+	if err := New(scanner.FromData(data), c, WithTimeZone(tokyo)).Write(io.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d time values, want 2", len(got))
+	}
+	for _, v := range got {
+		if v.Location() != tokyo || !v.Equal(ts) {
+			t.Errorf("got %v, want %v", v, ts.In(tokyo))
+		}
+	}
+}
+
+func TestAppendFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "rows.json")
+	c := codec.JSON(jsoncodec.WithAppendMode(true))
+	appendRows := func(data [][]any) error {
+		return New(scanner.FromData(data), c).AppendFile(filename)
+	}
+	for _, data := range [][][]any{{{1}}, nil, {{2}, {3}}} {
+		if err := appendRows(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, _ := os.ReadFile(filename)
+	var rows []map[string]int
+	if err := json.Unmarshal(got, &rows); err != nil {
+		t.Fatalf("invalid JSON %q: %v", got, err)
+	}
+	if len(rows) != 3 || rows[0]["column_0"] != 1 || rows[2]["column_0"] != 3 {
+		t.Errorf("unexpected rows %v in %q", rows, got)
+	}
+
+	failing := exportertest.FailAfter(scanner.FromData([][]any{{4}, {5}}), 1, errors.New("broken"))
+	if err := New(failing, c).AppendFile(filename); err == nil {
+		t.Fatal("expected an error")
+	}
+	if after, _ := os.ReadFile(filename); !bytes.Equal(after, got) {
+		t.Errorf("failed append changed the file:\n%q\n%q", got, after)
+	}
+
+	if err := New(scanner.FromData(nil), codec.JSON()).AppendFile(filename); err == nil {
+		t.Error("expected an error for a codec not in append mode")
+	}
+}
+
+func TestWithIntegrityFooter(t *testing.T) {
+	data := [][]any{{1, "a"}, {2, "b"}, {3, "c"}}
+	var buf bytes.Buffer
+	err := New(scanner.FromData(data), codec.CSV(), WithIntegrityFooter(true)).Write(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.HasPrefix(out, "column_0,column_1\n1,a\n") || !strings.Contains(out, "\n# exporter-integrity rows=3 sha256=") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+	integrity, err := Verify(strings.NewReader(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if integrity.Rows != 3 {
+		t.Errorf("got %d rows, want 3", integrity.Rows)
+	}
+	tampered := strings.Replace(out, "2,b", "2,x", 1)
+	if _, err := Verify(strings.NewReader(tampered)); !errors.Is(err, ErrIntegrityMismatch) {
+		t.Errorf("tampered content: got %v, want ErrIntegrityMismatch", err)
+	}
+	truncated := strings.Replace(out, "3,c\n", "", 1)
+	if _, err := Verify(strings.NewReader(truncated)); !errors.Is(err, ErrIntegrityMismatch) {
+		t.Errorf("truncated content: got %v, want ErrIntegrityMismatch", err)
+	}
+	if _, err := Verify(strings.NewReader("column_0\n1\n")); !errors.Is(err, ErrNoIntegrityFooter) {
+		t.Errorf("no footer: got %v, want ErrNoIntegrityFooter", err)
+	}
+
+	buf.Reset()
+	err = New(scanner.FromData(data), codec.JSON(jsoncodec.WithNewlineDelimited(true)), WithIntegrityFooter(true)).Write(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if integrity, err := Verify(&buf); err != nil || integrity.Rows != 3 {
+		t.Errorf("NDJSON: got %+v, %v", integrity, err)
+	}
+	if err := New(scanner.FromData(data), codec.JSON(), WithIntegrityFooter(true)).Write(io.Discard); err == nil {
+		t.Error("expected an error for a JSON array")
+	}
+
+	// The footer counts the written rows, not the rows read ahead from a batch
+	// source or skipped by a preprocessor.
+	many := make([][]any, 1000)
+	for i := range many {
+		many[i] = []any{i}
+	}
+	skipOdd := csvcodec.WithPreProcessorFunc(func(_ int, row []string) ([]string, bool) {
+		return row, row[0] != "1"
+	})
+	buf.Reset()
+	err = New(scanner.FromData(many), codec.CSV(csvcodec.WithLimit(2), skipOdd), WithIntegrityFooter(true)).Write(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "column_0\n0\n2\n# exporter-integrity rows=2 ") {
+		t.Fatalf("unexpected output:\n%s", buf.String())
+	}
+	if integrity, err := Verify(&buf); err != nil || integrity.Rows != 2 {
+		t.Errorf("limited batch source: got %+v, %v", integrity, err)
+	}
+}
+
+func TestChunks(t *testing.T) {
+	data := [][]any{{1}, {2}, {3}, {4}, {5}}
+	var chunks []string
+	for r, err := range New(scanner.FromData(data), codec.CSV(csvcodec.WithFlushEveryRows(1))).Chunks(13) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chunks = append(chunks, string(b))
+	}
+	want := []string{"column_0\n1\n2\n", "column_0\n3\n4\n", "column_0\n5\n"}
+	if !reflect.DeepEqual(chunks, want) {
+		t.Errorf("got chunks %q, want %q", chunks, want)
+	}
+
+	rows := &closingRows{Rows: scanner.FromData(data)}
+	for range New(rows, codec.CSV(), WithChunkSize(1, 0)).Chunks(0) {
+		break
+	}
+	if !rows.closed {
+		t.Error("rows were not closed after leaving the loop early")
+	}
+
+	// A codec limit applies to every chunk.
+	chunks = nil
+	for r, err := range New(scanner.FromData(data), codec.CSV(csvcodec.WithLimit(2)), WithChunkSize(3, 0)).Chunks(0) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chunks = append(chunks, string(b))
+	}
+	want = []string{"column_0\n1\n2\n", "column_0\n3\n4\n", "column_0\n5\n"}
+	if !reflect.DeepEqual(chunks, want) {
+		t.Errorf("limited codec: got chunks %q, want %q", chunks, want)
+	}
+
+	// A codec that reads no row cannot make progress.
+	var n int
+	var lastErr error
+	for _, err := range New(scanner.FromData(data), codec.CSV(csvcodec.WithLimit(0))).Chunks(0) {
+		if lastErr = err; err != nil || n > len(data) {
+			break
+		}
+		n++
+	}
+	if lastErr == nil || n != 0 {
+		t.Errorf("limit 0: got %d chunks and error %v, want an error without chunks", n, lastErr)
+	}
+}
+
+// slowWriter writes after a delay.
+type slowWriter struct {
+	bytes.Buffer
+	delay time.Duration
+}
+
+func (w *slowWriter) Write(p []byte) (int, error) {
+	time.Sleep(w.delay)
+	return w.Buffer.Write(p)
+}
+
+func TestWithAsyncWrite(t *testing.T) {
+	data := make([][]any, 100)
+	for i := range data {
+		data[i] = []any{i, "value"}
+	}
+	want, err := New(scanner.FromData(data), codec.CSV()).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &slowWriter{delay: 100 * time.Microsecond}
+	if err := New(scanner.FromData(data), codec.CSV(), WithAsyncWrite(16, time.Second)).Write(w); err != nil {
+		t.Fatal(err)
+	}
+	if w.String() != want {
+		t.Errorf("got %q, want %q", w.String(), want)
+	}
+
+	w = &slowWriter{delay: time.Second}
+	err = New(scanner.FromData(data), codec.CSV(), WithAsyncWrite(16, 10*time.Millisecond)).Write(w)
+	if !errors.Is(err, ErrWriterStalled) {
+		t.Errorf("got %v, want ErrWriterStalled", err)
+	}
+
+	err = New(scanner.FromData(data), codec.CSV(), WithAsyncWrite(16, 0)).Write(errWriter{})
+	if err == nil || !strings.Contains(err.Error(), "write failed") {
+		t.Errorf("got %v, want the destination error", err)
+	}
+}
+
+func TestUpload(t *testing.T) {
+	data := [][]any{{1}, {2}, {3}}
+	var got []byte
+	stats, err := New(scanner.FromData(data), codec.CSV()).Upload(context.Background(), func(_ context.Context, r io.Reader) error {
+		var err error
+		got, err = io.ReadAll(r)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "column_0\n1\n2\n3\n" || stats.Rows != 3 {
+		t.Errorf("got %q and %d rows", got, stats.Rows)
+	}
+
+	uploadErr := errors.New("access denied")
+	rows := &closingRows{Rows: scanner.FromData(data)}
+	_, err = New(rows, codec.CSV()).Upload(context.Background(), func(context.Context, io.Reader) error {
+		return uploadErr
+	})
+	if !errors.Is(err, uploadErr) || !rows.closed {
+		t.Errorf("got %v, closed %v; want the upload error", err, rows.closed)
+	}
+
+	exportErr := errors.New("connection reset")
+	var ctxErr error
+	_, err = New(exportertest.FailAfter(scanner.FromData(data), 1, exportErr), codec.CSV()).Upload(context.Background(), func(ctx context.Context, r io.Reader) error {
+		_, err := io.ReadAll(r)
+		ctxErr = ctx.Err()
+		return err
+	})
+	if !errors.Is(err, exportErr) || ctxErr == nil {
+		t.Errorf("got %v, upload context error %v; want the export error", err, ctxErr)
+	}
+
+	_, err = New(scanner.FromData(data), codec.CSV()).Upload(context.Background(), func(context.Context, io.Reader) error {
+		return nil
+	})
+	if !errors.Is(err, errUploadReturned) {
+		t.Errorf("got %v, want errUploadReturned", err)
+	}
+}
+
+func TestDiffWithStringInterning(t *testing.T) {
+	old := [][]any{{1, "active"}, {2, "active"}, {3, "inactive"}}
+	updated := [][]any{{1, "active"}, {2, "inactive"}, {4, "active"}}
+	var buf bytes.Buffer
+	err := Diff(scanner.FromData(old), scanner.FromData(updated), []string{"column_0"}, &buf,
+		codec.CSV(csvcodec.WithHeader(false)), WithStringInterning(16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "2,inactive,changed\n4,active,added\n3,inactive,removed\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestDiffWithSpill(t *testing.T) {
+	dir := t.TempDir()
+	old := [][]any{{1, "active"}, {2, "active"}, {3, "inactive"}}
+	updated := [][]any{{1, "active"}, {2, "inactive"}, {4, "active"}}
+	var buf bytes.Buffer
+	err := Diff(scanner.FromData(old), scanner.FromData(updated), []string{"column_0"}, &buf,
+		codec.CSV(csvcodec.WithHeader(false)), WithSpill(dir, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "2,inactive,changed\n4,active,added\n3,inactive,removed\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("temporary files were not removed: %v", entries)
+	}
+}
+
+// flakyDestination fails after writing failAfter bytes, aborting the data.
+type flakyDestination struct {
+	buf       bytes.Buffer
+	failAfter int
+	delivered *[]string
+	aborted   *int
+}
+
+func (d *flakyDestination) Write(p []byte) (int, error) {
+	if d.failAfter >= 0 && d.buf.Len()+len(p) > d.failAfter {
+		return 0, errors.New("connection reset")
+	}
+	return d.buf.Write(p)
+}
+
+func (d *flakyDestination) Close() error {
+	*d.delivered = append(*d.delivered, d.buf.String())
+	return nil
+}
+
+func (d *flakyDestination) Abort(error) error {
+	*d.aborted++
+	return nil
+}
+
+func TestRetryDestination(t *testing.T) {
+	data := make([][]any, 50)
+	for i := range data {
+		data[i] = []any{i}
+	}
+	want, err := New(scanner.FromData(data), codec.CSV()).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var delivered []string
+	aborted, opened := 0, 0
+	failures := []int{20, 0, -1} // The first two attempts fail.
+	open := func() (Destination, error) {
+		opened++
+		return &flakyDestination{failAfter: failures[opened-1], delivered: &delivered, aborted: &aborted}, nil
+	}
+	dir := t.TempDir()
+	dest, err := RetryDestination(context.Background(), open, WithSpoolDir(dir), WithDeliveryRetries(3, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := New(scanner.FromData(data), codec.CSV(csvcodec.WithFlushEveryRows(1))).WriteDestinations(dest); err != nil {
+		t.Fatal(err)
+	}
+	if opened != 3 || aborted != 2 || len(delivered) != 1 || delivered[0] != want {
+		t.Errorf("opened %d, aborted %d, delivered %q", opened, aborted, delivered)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("spool file was not removed: %v", entries)
+	}
+
+	opened, failures = 0, []int{0, 0}
+	dest, err = RetryDestination(context.Background(), open, WithSpoolDir(dir), WithDeliveryRetries(1, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = New(scanner.FromData(data), codec.CSV()).WriteDestinations(dest)
+	if err == nil || !strings.Contains(err.Error(), "connection reset") {
+		t.Errorf("expected the delivery error, got %v", err)
+	}
+}
+
+// gatedRows blocks its first Next until release is closed, after closing started.
+type gatedRows struct {
+	scanner.Rows
+	started, release chan struct{}
+	once             sync.Once
+}
+
+func (r *gatedRows) Next() bool {
+	r.once.Do(func() {
+		close(r.started)
+		<-r.release
+	})
+	return r.Rows.Next()
+}
+
+func TestManager(t *testing.T) {
+	m := NewManager(WithTenantLimits(1, 0), WithTenantOverride("small", 1, 20))
+	data := [][]any{{1}, {2}, {3}}
+	rows := &gatedRows{Rows: scanner.FromData(data), started: make(chan struct{}), release: make(chan struct{})}
+	done := make(chan error)
+	go func() {
+		_, err := m.Run(context.Background(), "a", "acme", New(rows, codec.CSV()), io.Discard)
+		done <- err
+	}()
+	<-rows.started
+	if jobs := m.Running(); len(jobs) != 1 || jobs[0].ID != "a" || jobs[0].Tenant != "acme" {
+		t.Errorf("unexpected jobs %+v", jobs)
+	}
+	if _, err := m.Run(context.Background(), "b", "acme", New(scanner.FromData(data), codec.CSV()), io.Discard); !errors.Is(err, ErrTooManyExports) {
+		t.Errorf("got %v, want ErrTooManyExports", err)
+	}
+	if _, err := m.Run(context.Background(), "a", "other", New(scanner.FromData(data), codec.CSV()), io.Discard); !errors.Is(err, ErrDuplicateExport) {
+		t.Errorf("got %v, want ErrDuplicateExport", err)
+	}
+	if _, err := m.Run(context.Background(), "c", "other", New(scanner.FromData(data), codec.CSV()), io.Discard); err != nil {
+		t.Errorf("export of another tenant failed: %v", err)
+	}
+	if !m.Cancel("a") || m.Cancel("unknown") {
+		t.Error("Cancel reported wrong results")
+	}
+	close(rows.release)
+	if err := <-done; !errors.Is(err, ErrExportCanceled) {
+		t.Errorf("got %v, want ErrExportCanceled", err)
+	}
+	if jobs := m.Running(); len(jobs) != 0 {
+		t.Errorf("finished jobs still listed: %+v", jobs)
+	}
+	if usage := m.Usage("other"); usage != int64(len("column_0\n1\n2\n3\n")) {
+		t.Errorf("usage of other = %d", usage)
+	}
+
+	var buf bytes.Buffer
+	many := make([][]any, 100)
+	for i := range many {
+		many[i] = []any{i}
+	}
+	if _, err := m.Run(context.Background(), "d", "small", New(scanner.FromData(many), codec.CSV(csvcodec.WithFlushEveryRows(1))), &buf); !errors.Is(err, ErrByteQuota) {
+		t.Errorf("got %v, want ErrByteQuota", err)
+	}
+	if usage := m.Usage("small"); usage > 20 || usage != int64(buf.Len()) {
+		t.Errorf("usage %d exceeds the quota or differs from the %d bytes written", usage, buf.Len())
+	}
+	if _, err := m.Run(context.Background(), "e", "small", New(scanner.FromData(data[:1]), codec.CSV()), io.Discard); !errors.Is(err, ErrByteQuota) && m.Usage("small") < 20 {
+		t.Errorf("got %v with usage %d", err, m.Usage("small"))
+	}
+	m.ResetUsage("small")
+	if _, err := m.Run(context.Background(), "f", "small", New(scanner.FromData(data[:1]), codec.CSV()), io.Discard); err != nil {
+		t.Errorf("export after ResetUsage failed: %v", err)
+	}
+}
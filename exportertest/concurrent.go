@@ -0,0 +1,45 @@
+// Package exportertest provides helpers for testing codecs, custom mappers and
+// Rows wrappers. This file checks that a codec can be shared between goroutines.
+package exportertest
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/go-data-exporter/exporter/codec"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// Concurrent writes the rows returned by newRows with c from n goroutines at once
+// and fails the test unless every output equals the output of a sequential Write,
+// enforcing the contract of codec.Codec that a configured codec may be shared by
+// parallel exports. newRows is called once per Write and must return equal rows
+// every time. Run the test with the race detector to also catch unsynchronized
+// state that happens not to corrupt the output.
+func Concurrent(t testing.TB, c codec.Codec, newRows func() scanner.Rows, n int) {
+	t.Helper()
+	want, err := Encode(c, newRows())
+	if err != nil {
+		t.Fatalf("encoding failed: %v", err)
+	}
+	outputs := make([][]byte, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			outputs[i], errs[i] = Encode(c, newRows())
+		}()
+	}
+	wg.Wait()
+	for i := range n {
+		if errs[i] != nil {
+			t.Fatalf("concurrent write %d failed: %v", i, errs[i])
+		}
+		if !bytes.Equal(outputs[i], want) {
+			t.Fatalf("concurrent write %d differs from the sequential output:\n--- got\n%s\n--- want\n%s", i, outputs[i], want)
+		}
+	}
+}
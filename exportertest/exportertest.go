@@ -0,0 +1,102 @@
+// Package exportertest provides helpers for testing codecs, custom mappers and
+// Rows wrappers: encoding fixtures with a codec, comparing the output against
+// golden files, checking that a codec can be shared by concurrent exports, and
+// Rows that fail in the middle of the stream.
+//
+// Golden files are read from the testdata directory of the package under test.
+// Run the tests with the environment variable UPDATE_GOLDEN=1 to (re)write them:
+//
+//	UPDATE_GOLDEN=1 go test ./...
+package exportertest
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-data-exporter/exporter/codec"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// Encode writes rows with c and returns the output.
+func Encode(c codec.Codec, rows scanner.Rows) ([]byte, error) {
+	var buf bytes.Buffer
+	err := c.Write(rows, &buf)
+	return buf.Bytes(), err
+}
+
+// EncodeData writes the fixture data, as produced by scanner.FromData, with c and
+// returns the output. It fails the test if the codec returns an error.
+func EncodeData(t testing.TB, c codec.Codec, data [][]any) []byte {
+	t.Helper()
+	out, err := Encode(c, scanner.FromData(data))
+	if err != nil {
+		t.Fatalf("encoding failed: %v", err)
+	}
+	return out
+}
+
+// GoldenOption defines a functional option for configuring Golden.
+type GoldenOption func(*golden)
+
+// golden holds the configuration of a golden file comparison.
+type golden struct {
+	replacements []replacement
+}
+
+// replacement replaces the matches of a pattern with a fixed text.
+type replacement struct {
+	pattern *regexp.Regexp
+	text    string
+}
+
+// WithReplace replaces all matches of pattern with text, in the output as
+// well as in the golden file, before they are compared. Use it to normalize volatile
+// fields such as timestamps or random identifiers, e.g.
+// WithReplace(`\d{4}-\d{2}-\d{2}T[0-9:.]+Z`, "<time>").
+func WithReplace(pattern, text string) GoldenOption {
+	re := regexp.MustCompile(pattern)
+	return func(g *golden) {
+		g.replacements = append(g.replacements, replacement{pattern: re, text: text})
+	}
+}
+
+// Golden compares got with the golden file testdata/name after normalization and
+// fails the test if they differ. With UPDATE_GOLDEN=1 set, the golden file is
+// written with the normalized output instead.
+func Golden(t testing.TB, name string, got []byte, opts ...GoldenOption) {
+	t.Helper()
+	g := &golden{}
+	for _, opt := range opts {
+		opt(g)
+	}
+	got = g.normalize(got)
+	path := filepath.Join("testdata", name)
+	if os.Getenv("UPDATE_GOLDEN") != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file (run with UPDATE_GOLDEN=1 to create it): %v", err)
+	}
+	want = g.normalize(want)
+	if !bytes.Equal(got, want) {
+		t.Errorf("output does not match %s:\n--- got\n%s\n--- want\n%s", path, got, want)
+	}
+}
+
+// normalize applies the replacements to data.
+func (g *golden) normalize(data []byte) []byte {
+	for _, r := range g.replacements {
+		data = r.pattern.ReplaceAllLiteral(data, []byte(r.text))
+	}
+	return data
+}
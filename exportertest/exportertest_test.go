@@ -0,0 +1,76 @@
+package exportertest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-data-exporter/exporter/codec"
+	csvcodec "github.com/go-data-exporter/exporter/codec/csv"
+	htmlcodec "github.com/go-data-exporter/exporter/codec/html"
+	jsoncodec "github.com/go-data-exporter/exporter/codec/json"
+	parquetcodec "github.com/go-data-exporter/exporter/codec/parquet"
+	tablecodec "github.com/go-data-exporter/exporter/codec/table"
+	xmlcodec "github.com/go-data-exporter/exporter/codec/xml"
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+func TestGolden(t *testing.T) {
+	data := [][]any{
+		{1, "alice", time.Now().UTC()},
+		{2, "bob", time.Now().UTC()},
+	}
+	out := EncodeData(t, codec.CSV(), data)
+	Golden(t, "users.csv", out, WithReplace(`\d{4}-\d{2}-\d{2}[^,\n]*`, "<time>"))
+}
+
+func TestFailingRows(t *testing.T) {
+	data := [][]any{{1}, {2}, {3}}
+	injected := errors.New("connection reset")
+
+	if _, err := Encode(codec.CSV(), FailScan(scanner.FromData(data), 2, injected)); !errors.Is(err, injected) {
+		t.Errorf("FailScan: expected the injected error, got %v", err)
+	}
+	out, err := Encode(codec.CSV(), FailAfter(scanner.FromData(data), 2, injected))
+	if !errors.Is(err, injected) {
+		t.Errorf("FailAfter: expected the injected error, got %v", err)
+	}
+	if string(out) != "column_0\n1\n2\n" {
+		t.Errorf("FailAfter: unexpected output %q", out)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	upper := func(v string, _ scanner.Metadata) tostring.String {
+		return tostring.String{String: strings.ToUpper(v)}
+	}
+	fuzz := func() scanner.Rows { return scanner.Fuzz(1) }
+	// Parquet converts values to the column types, which fuzzed columns mixing all
+	// kinds of values do not have.
+	typed := func() scanner.Rows {
+		data := make([][]any, 200)
+		for i := range data {
+			data[i] = []any{int64(i), float64(i) / 3, strings.Repeat("x", i%7), time.Unix(int64(i)*3600, 0).UTC(), nil}
+		}
+		data[0][4] = "nullable"
+		return scanner.FromData(data)
+	}
+	codecs := map[string]struct {
+		codec   codec.Codec
+		newRows func() scanner.Rows
+	}{
+		"csv":     {codec.CSV(csvcodec.WithCustomType(upper), csvcodec.WithCRLF(true)), fuzz},
+		"json":    {codec.JSON(jsoncodec.WithNewlineDelimited(true), jsoncodec.WithBatchSize(8)), fuzz},
+		"html":    {codec.HTML(htmlcodec.WithCustomType(upper), htmlcodec.WithTypeAlignment(true)), fuzz},
+		"xml":     {codec.XML(xmlcodec.WithCustomType(upper), xmlcodec.WithRowNumbers(true)), fuzz},
+		"parquet": {codec.Parquet(parquetcodec.WithPageSize(256), parquetcodec.WithCompression(parquetcodec.Gzip)), typed},
+		"table":   {codec.Table(tablecodec.WithCustomType(upper), tablecodec.WithStyle(tablecodec.Unicode), tablecodec.WithMaxColumnWidth(20), tablecodec.WithRowNumbers(true)), fuzz},
+	}
+	for name, tc := range codecs {
+		t.Run(name, func(t *testing.T) {
+			Concurrent(t, tc.codec, tc.newRows, 8)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Package exportertest provides helpers for testing codecs, custom mappers and
+// Rows wrappers. This file defines Rows that inject errors in the middle of the stream.
+package exportertest
+
+import "github.com/go-data-exporter/exporter/scanner"
+
+// FailScan wraps rows so that ScanRow returns err for the row after the first n rows.
+func FailScan(rows scanner.Rows, n int, err error) scanner.Rows {
+	return &failingRows{Rows: rows, n: n, err: err, scan: true}
+}
+
+// FailAfter wraps rows so that Next returns false after the first n rows and Err
+// returns err, like a source whose connection breaks while the rows are read.
+func FailAfter(rows scanner.Rows, n int, err error) scanner.Rows {
+	return &failingRows{Rows: rows, n: n, err: err}
+}
+
+// failingRows wraps a Rows and fails after a number of rows.
+type failingRows struct {
+	scanner.Rows
+
+	n      int
+	err    error
+	scan   bool
+	read   int
+	failed bool
+}
+
+// Next reports whether another row is available before the failure.
+func (f *failingRows) Next() bool {
+	if f.read >= f.n {
+		if f.scan && !f.failed {
+			f.failed = true
+			return true // The error is reported by ScanRow.
+		}
+		f.failed = true
+		return false
+	}
+	f.read++
+	return f.Rows.Next()
+}
+
+// ScanRow returns the current row, or the injected error.
+func (f *failingRows) ScanRow() ([]any, error) {
+	if f.failed {
+		return nil, f.err
+	}
+	return f.Rows.ScanRow()
+}
+
+// Err returns the injected error once the rows have failed.
+func (f *failingRows) Err() error {
+	if f.failed {
+		return f.err
+	}
+	return f.Rows.Err()
+}
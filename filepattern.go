@@ -0,0 +1,116 @@
+// This file implements writing exports to files named by a template.
+
+package exporter
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// filePatternVariables are the variables known to WriteFilePattern.
+var filePatternVariables = map[string]bool{
+	"date": true, "time": true, "datetime": true, "unix": true,
+	"driver": true, "rows": true, "bytes": true,
+}
+
+// WriteFilePattern writes the export to a file named by expanding the variables of
+// pattern, e.g. "export_{date}_{driver}_{rows}.csv", and returns the file name:
+//
+//   - {date}: the local date the export started, as 2006-01-02
+//   - {time}: the local time the export started, as 150405
+//   - {datetime}: the local date and time the export started, as 20060102T150405
+//   - {unix}: the Unix time in seconds the export started
+//   - {driver}: the driver of the source rows
+//   - {rows}: the number of rows written
+//   - {bytes}: the number of bytes written
+//
+// The export is written to a temporary file in the target directory, which is
+// renamed once the export has succeeded, so that the final file only appears when
+// it is complete and {rows} and {bytes} are known. The temporary file is removed
+// if the export fails. Unknown variables are reported before anything is written.
+func (cs *Exporter) WriteFilePattern(pattern string) (string, error) {
+	if err := checkFilePattern(pattern); err != nil {
+		return "", err
+	}
+	started := time.Now()
+	driver := strings.NewReplacer("/", "_", string(filepath.Separator), "_").Replace(cs.rows.Driver())
+	name := expandFilePattern(pattern, map[string]string{
+		"date":     started.Format("2006-01-02"),
+		"time":     started.Format("150405"),
+		"datetime": started.Format("20060102T150405"),
+		"unix":     strconv.FormatInt(started.Unix(), 10),
+		"driver":   driver,
+	})
+	f, err := createTemp(filepath.Dir(name))
+	if err != nil {
+		return "", err
+	}
+	stats, summary, err := cs.exportFile(f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	name = expandFilePattern(name, map[string]string{
+		"rows":  strconv.FormatInt(stats.Rows, 10),
+		"bytes": strconv.FormatInt(stats.Bytes, 10),
+	})
+	if err := os.Rename(f.Name(), name); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return name, writeSummary(name, summary)
+}
+
+// createTemp creates a new temporary file in dir. Unlike os.CreateTemp, it creates
+// the file with the permissions of os.Create, subject to the umask, so that the
+// renamed export is readable like a file written by WriteFile.
+func createTemp(dir string) (*os.File, error) {
+	for try := 0; ; try++ {
+		name := filepath.Join(dir, ".export-"+strconv.FormatUint(uint64(rand.Uint32()), 10)+".tmp")
+		f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o666)
+		if os.IsExist(err) && try < 10000 {
+			continue
+		}
+		return f, err
+	}
+}
+
+// checkFilePattern reports the first unknown or unterminated variable of pattern.
+func checkFilePattern(pattern string) error {
+	rest := pattern
+	for {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			return nil
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return fmt.Errorf("exporter: unterminated variable in file pattern %q", pattern)
+		}
+		if name := rest[start+1 : start+end]; !filePatternVariables[name] {
+			return fmt.Errorf("exporter: unknown variable {%s} in file pattern %q", name, pattern)
+		}
+		rest = rest[start+end+1:]
+	}
+}
+
+// expandFilePattern replaces the given variables of pattern with their values,
+// leaving other variables in place.
+func expandFilePattern(pattern string, values map[string]string) string {
+	pairs := make([]string, 0, 2*len(values))
+	for name, value := range values {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(pattern)
+}
@@ -0,0 +1,218 @@
+// Package exportgrpc implements an export service that streams exports over gRPC,
+// so that other services can request exports instead of running a command.
+//
+// The service is defined in exporter.proto. This module does not depend on
+// google.golang.org/grpc: the messages of the service are mirrored by the types of
+// this package, and Server implements the service against the ExportStream
+// interface. To serve it, generate the bindings from exporter.proto with
+// protoc-gen-go and protoc-gen-go-grpc, and implement the generated server
+// interface by calling Server.Export with a stream converting every
+// ExportResponse to its generated message before sending it.
+package exportgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-data-exporter/exporter"
+	"github.com/go-data-exporter/exporter/codec"
+	csvcodec "github.com/go-data-exporter/exporter/codec/csv"
+	jsoncodec "github.com/go-data-exporter/exporter/codec/json"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// Chunk sizes in bytes.
+const (
+	defaultChunkSize = 64 << 10
+	maxChunkSize     = 1 << 20 // Well below the default message limit of gRPC of 4 MiB.
+)
+
+// ExportRequest is the spec of an export job, see exporter.proto.
+type ExportRequest struct {
+	Job       string
+	Format    string
+	Columns   []string
+	Limit     int64
+	Params    map[string]string
+	ChunkSize int32
+}
+
+// Column describes a column of the export.
+type Column struct {
+	Name         string
+	DatabaseType string
+	Kind         string
+	Nullable     bool
+}
+
+// Schema is the first message of a response stream.
+type Schema struct {
+	Columns []Column
+}
+
+// Chunk holds a part of the encoded output.
+type Chunk struct {
+	Index int64
+	Data  []byte
+}
+
+// Stats is the last message of a response stream.
+type Stats struct {
+	Rows      int64
+	Bytes     int64
+	Truncated bool
+}
+
+// ExportResponse is a message of a response stream. Exactly one field is set.
+type ExportResponse struct {
+	Schema *Schema
+	Chunk  *Chunk
+	Stats  *Stats
+}
+
+// ExportStream is the response stream of an Export call, which the generated
+// ExportService_ExportServer satisfies once its messages are converted.
+type ExportStream interface {
+	Context() context.Context
+	Send(*ExportResponse) error
+}
+
+// OpenFunc returns the rows of the job of a request. The rows are closed after
+// the export if they implement io.Closer.
+type OpenFunc func(ctx context.Context, req *ExportRequest) (scanner.Rows, error)
+
+// Option defines a functional option for configuring a Server.
+type Option func(*Server)
+
+// WithFormat registers the codec of a format, overriding a default format. The
+// default formats are csv, tsv, json, ndjson, xml, html and parquet.
+func WithFormat(name string, c codec.Codec) Option {
+	return func(s *Server) {
+		s.formats[strings.ToLower(name)] = c
+	}
+}
+
+// WithExportOptions sets the options of every export, as passed to exporter.New.
+func WithExportOptions(opts ...exporter.Option) Option {
+	return func(s *Server) {
+		s.opts = append(s.opts, opts...)
+	}
+}
+
+// Server implements the export service.
+type Server struct {
+	open    OpenFunc
+	formats map[string]codec.Codec
+	opts    []exporter.Option
+}
+
+// NewServer returns a Server exporting the rows returned by open.
+func NewServer(open OpenFunc, opts ...Option) *Server {
+	s := &Server{
+		open: open,
+		formats: map[string]codec.Codec{
+			"csv":     codec.CSV(),
+			"tsv":     codec.CSV(csvcodec.WithCustomDelimiter('\t')),
+			"json":    codec.JSON(),
+			"ndjson":  codec.JSON(jsoncodec.WithNewlineDelimited(true)),
+			"xml":     codec.XML(),
+			"html":    codec.HTML(),
+			"parquet": codec.Parquet(),
+		},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Export runs the export job of req and streams its schema, the chunks of its
+// output and its statistics to stream. The export stops once the context of the
+// stream is done, e.g. when the client cancels the call. An error before the schema
+// is sent, such as an unknown job or format, leaves the stream without messages; a
+// later error ends the stream after the schema and any chunks sent so far, without
+// statistics.
+func (s *Server) Export(req *ExportRequest, stream ExportStream) error {
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = "csv"
+	}
+	c, ok := s.formats[format]
+	if !ok {
+		return fmt.Errorf("exportgrpc: unknown format %q", req.Format)
+	}
+	chunkSize := int(req.ChunkSize)
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	chunkSize = min(chunkSize, maxChunkSize)
+
+	ctx := stream.Context()
+	rows, err := s.open(ctx, req)
+	if err != nil {
+		return err
+	}
+	if len(req.Columns) != 0 {
+		rows = scanner.Select(rows, req.Columns...)
+	}
+	if req.Limit > 0 {
+		rows = scanner.Limit(rows, req.Limit)
+	}
+	schema, err := schemaOf(rows)
+	if err != nil {
+		return errors.Join(err, scanner.Close(rows))
+	}
+	if err := stream.Send(&ExportResponse{Schema: schema}); err != nil {
+		return errors.Join(err, scanner.Close(rows))
+	}
+	stats, err := exporter.New(rows, c, s.opts...).Upload(ctx, func(_ context.Context, r io.Reader) error {
+		return sendChunks(stream, r, chunkSize)
+	})
+	if err != nil {
+		return err
+	}
+	return stream.Send(&ExportResponse{Stats: &Stats{Rows: stats.Rows, Bytes: stats.Bytes, Truncated: stats.Truncated}})
+}
+
+// schemaOf returns the schema of rows.
+func schemaOf(rows scanner.Rows) (*Schema, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	schema := &Schema{Columns: make([]Column, len(cols))}
+	for i, col := range cols {
+		nullable, ok := col.Nullable()
+		schema.Columns[i] = Column{
+			Name:         col.Name(),
+			DatabaseType: col.DatabaseTypeName(),
+			Kind:         scanner.ColumnKind(col).String(),
+			Nullable:     nullable || !ok,
+		}
+	}
+	return schema, nil
+}
+
+// sendChunks reads r until io.EOF and sends its data in chunks of up to size bytes.
+func sendChunks(stream ExportStream, r io.Reader, size int) error {
+	buf := make([]byte, size)
+	for index := int64(0); ; index++ {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			// The stream may retain the message, so every chunk has its own data.
+			data := append([]byte(nil), buf[:n]...)
+			if sendErr := stream.Send(&ExportResponse{Chunk: &Chunk{Index: index, Data: data}}); sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package exportgrpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// recordingStream records the messages sent to it.
+type recordingStream struct {
+	ctx       context.Context
+	responses []*ExportResponse
+}
+
+func (s *recordingStream) Context() context.Context { return s.ctx }
+
+func (s *recordingStream) Send(r *ExportResponse) error {
+	s.responses = append(s.responses, r)
+	return nil
+}
+
+func TestServerExport(t *testing.T) {
+	data := [][]any{{int64(1), "alice"}, {int64(2), "bob"}, {int64(3), "carol"}}
+	var gotReq *ExportRequest
+	s := NewServer(func(_ context.Context, req *ExportRequest) (scanner.Rows, error) {
+		gotReq = req
+		if req.Job != "users" {
+			return nil, errors.New("unknown job")
+		}
+		return scanner.FromData(data), nil
+	})
+	stream := &recordingStream{ctx: context.Background()}
+	req := &ExportRequest{Job: "users", Columns: []string{"column_1"}, Limit: 2, ChunkSize: 4, Params: map[string]string{"day": "2024-03-01"}}
+	if err := s.Export(req, stream); err != nil {
+		t.Fatal(err)
+	}
+	if gotReq != req {
+		t.Error("the request was not passed to open")
+	}
+	first, last := stream.responses[0], stream.responses[len(stream.responses)-1]
+	if first.Schema == nil || len(first.Schema.Columns) != 1 || first.Schema.Columns[0].Name != "column_1" || first.Schema.Columns[0].Kind != "string" {
+		t.Fatalf("unexpected schema %+v", first.Schema)
+	}
+	var out bytes.Buffer
+	for i, r := range stream.responses[1 : len(stream.responses)-1] {
+		if r.Chunk == nil || r.Chunk.Index != int64(i) || len(r.Chunk.Data) > 4 {
+			t.Fatalf("unexpected chunk %d: %+v", i, r)
+		}
+		out.Write(r.Chunk.Data)
+	}
+	if want := "column_1\nalice\nbob\n"; out.String() != want {
+		t.Errorf("got %q, want %q", out.String(), want)
+	}
+	if last.Stats == nil || last.Stats.Rows != 2 || last.Stats.Bytes != int64(out.Len()) {
+		t.Errorf("unexpected stats %+v", last.Stats)
+	}
+
+	stream = &recordingStream{ctx: context.Background()}
+	if err := s.Export(&ExportRequest{Job: "users", Format: "yaml"}, stream); err == nil || !strings.Contains(err.Error(), "unknown format") {
+		t.Errorf("got %v, want an unknown format error", err)
+	}
+	if err := s.Export(&ExportRequest{Job: "orders"}, stream); err == nil || len(stream.responses) != 0 {
+		t.Errorf("got %v and %d messages for an unknown job", err, len(stream.responses))
+	}
+}
+
+func TestServerExportCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s := NewServer(func(context.Context, *ExportRequest) (scanner.Rows, error) {
+		return scanner.FromData([][]any{{1}, {2}}), nil
+	}, WithFormat("NDJSON", nil))
+	if _, ok := s.formats["ndjson"]; !ok {
+		t.Error("WithFormat did not register the format")
+	}
+	stream := &recordingStream{ctx: ctx}
+	if err := s.Export(&ExportRequest{Format: "json"}, stream); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,198 @@
+// Package highlight declares conditional formatting rules for human-facing
+// codecs, so that the highlighting of a report is configured once, e.g.
+//
+//	highlight.WhenColumn("status").Equals("FAILED").StyleRow(highlight.Red)
+//
+// and applied by every codec that supports it, such as the HTML codec with
+// htmlcodec.WithRules. Codecs meant for machines ignore highlighting.
+package highlight
+
+import (
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Style is the formatting applied by a rule. Colors are hex RGB colors such as
+// "#9c0006", which both CSS and spreadsheet formats understand. Empty fields leave
+// the formatting unchanged.
+type Style struct {
+	Color      string // The text color.
+	Background string // The fill color.
+	Bold       bool
+	Italic     bool
+}
+
+// Bundled styles with the colors of the common spreadsheet presets.
+var (
+	Red    = Style{Color: "#9c0006", Background: "#ffc7ce"}
+	Yellow = Style{Color: "#9c5700", Background: "#ffeb9c"}
+	Green  = Style{Color: "#006100", Background: "#c6efce"}
+)
+
+// Merge returns s overridden by the fields set in o.
+func (s Style) Merge(o Style) Style {
+	if o.Color != "" {
+		s.Color = o.Color
+	}
+	if o.Background != "" {
+		s.Background = o.Background
+	}
+	s.Bold = s.Bold || o.Bold
+	s.Italic = s.Italic || o.Italic
+	return s
+}
+
+// IsZero reports whether the style changes nothing.
+func (s Style) IsZero() bool {
+	return s == Style{}
+}
+
+// CSS returns the style as CSS declarations, e.g. for a style attribute.
+func (s Style) CSS() string {
+	var decls []string
+	if s.Color != "" {
+		decls = append(decls, "color:"+cssValue(s.Color))
+	}
+	if s.Background != "" {
+		decls = append(decls, "background-color:"+cssValue(s.Background))
+	}
+	if s.Bold {
+		decls = append(decls, "font-weight:bold")
+	}
+	if s.Italic {
+		decls = append(decls, "font-style:italic")
+	}
+	return strings.Join(decls, ";")
+}
+
+// cssValue removes the characters that could end a CSS declaration or attribute.
+func cssValue(s string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`;"'<>{}\`, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Value is a cell value as seen by the conditions of a rule.
+type Value struct {
+	Raw    any    // The value as scanned.
+	Text   string // The value as formatted by the codec.
+	IsNULL bool
+}
+
+// Float returns the value as a number: numeric values as they are and text that
+// parses as a number.
+func (v Value) Float() (float64, bool) {
+	if v.IsNULL {
+		return 0, false
+	}
+	rv := reflect.ValueOf(v.Raw)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(v.Text), 64)
+	return f, err == nil
+}
+
+// Condition selects the cells of a column. Its methods return a new condition
+// that additionally requires the given test, so that tests can be chained.
+type Condition struct {
+	column string
+	tests  []func(Value) bool
+}
+
+// WhenColumn starts a condition on the cells of the named column.
+func WhenColumn(name string) *Condition {
+	return &Condition{column: name}
+}
+
+// with returns a copy of c with the additional test.
+func (c *Condition) with(test func(Value) bool) *Condition {
+	return &Condition{column: c.column, tests: append(slices.Clip(c.tests), test)}
+}
+
+// Equals requires the formatted value to equal text.
+func (c *Condition) Equals(text string) *Condition {
+	return c.with(func(v Value) bool { return !v.IsNULL && v.Text == text })
+}
+
+// NotEquals requires the value to be NULL or to differ from text.
+func (c *Condition) NotEquals(text string) *Condition {
+	return c.with(func(v Value) bool { return v.IsNULL || v.Text != text })
+}
+
+// Contains requires the formatted value to contain text.
+func (c *Condition) Contains(text string) *Condition {
+	return c.with(func(v Value) bool { return !v.IsNULL && strings.Contains(v.Text, text) })
+}
+
+// Matches requires the formatted value to match re.
+func (c *Condition) Matches(re *regexp.Regexp) *Condition {
+	return c.with(func(v Value) bool { return !v.IsNULL && re.MatchString(v.Text) })
+}
+
+// GreaterThan requires the value to be a number greater than n.
+func (c *Condition) GreaterThan(n float64) *Condition {
+	return c.with(func(v Value) bool {
+		f, ok := v.Float()
+		return ok && f > n
+	})
+}
+
+// LessThan requires the value to be a number less than n.
+func (c *Condition) LessThan(n float64) *Condition {
+	return c.with(func(v Value) bool {
+		f, ok := v.Float()
+		return ok && f < n
+	})
+}
+
+// IsNULL requires the value to be NULL.
+func (c *Condition) IsNULL() *Condition {
+	return c.with(func(v Value) bool { return v.IsNULL })
+}
+
+// Func requires fn to return true for the value.
+func (c *Condition) Func(fn func(v Value) bool) *Condition {
+	return c.with(fn)
+}
+
+// Style returns a rule applying style to the matching cells.
+func (c *Condition) Style(style Style) Rule {
+	return Rule{Column: c.column, Style: style, tests: c.tests}
+}
+
+// StyleRow returns a rule applying style to the rows of the matching cells.
+func (c *Condition) StyleRow(style Style) Rule {
+	return Rule{Column: c.column, Style: style, Row: true, tests: c.tests}
+}
+
+// Rule is a conditional formatting rule created with WhenColumn. When several
+// rules match, their styles are merged in order, row styles before cell styles.
+type Rule struct {
+	Column string // The column the condition tests.
+	Style  Style
+	Row    bool // Whether the style applies to the whole row rather than the cell.
+
+	tests []func(Value) bool
+}
+
+// Match reports whether the value of the rule's column satisfies the condition.
+func (r Rule) Match(v Value) bool {
+	for _, test := range r.tests {
+		if !test(v) {
+			return false
+		}
+	}
+	return true
+}
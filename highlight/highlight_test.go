@@ -0,0 +1,51 @@
+package highlight
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestRuleMatch(t *testing.T) {
+	tests := []struct {
+		cond *Condition
+		v    Value
+		want bool
+	}{
+		{WhenColumn("a").Equals("FAILED"), Value{Text: "FAILED"}, true},
+		{WhenColumn("a").Equals("FAILED"), Value{Text: "FAILED", IsNULL: true}, false},
+		{WhenColumn("a").NotEquals("OK"), Value{IsNULL: true}, true},
+		{WhenColumn("a").Contains("ERR"), Value{Text: "ERROR 42"}, true},
+		{WhenColumn("a").Matches(regexp.MustCompile(`^\d+$`)), Value{Text: "12a"}, false},
+		{WhenColumn("a").GreaterThan(100), Value{Raw: int64(250), Text: "250"}, true},
+		{WhenColumn("a").GreaterThan(100), Value{Raw: "250", Text: "250"}, true},
+		{WhenColumn("a").GreaterThan(100).LessThan(200), Value{Raw: 250.0}, false},
+		{WhenColumn("a").LessThan(0), Value{Text: "n/a"}, false},
+		{WhenColumn("a").IsNULL(), Value{IsNULL: true}, true},
+		{WhenColumn("a").Func(func(v Value) bool { return len(v.Text) > 3 }), Value{Text: "long"}, true},
+	}
+	for i, tt := range tests {
+		if got := tt.cond.Style(Red).Match(tt.v); got != tt.want {
+			t.Errorf("%d: got %v, want %v", i, got, tt.want)
+		}
+	}
+
+	// Chained conditions do not share their tests.
+	base := WhenColumn("a").Contains("x")
+	both := base.Contains("y")
+	if !base.Style(Red).Match(Value{Text: "x"}) || both.Style(Red).Match(Value{Text: "x"}) {
+		t.Error("chaining changed the base condition")
+	}
+}
+
+func TestStyle(t *testing.T) {
+	s := Red.Merge(Style{Color: "#000000", Bold: true})
+	if got, want := s.CSS(), "color:#000000;background-color:#ffc7ce;font-weight:bold"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got := (Style{Color: `red;"><script>`}).CSS(); got != "color:redscript" {
+		t.Errorf("unsafe CSS: %s", got)
+	}
+	if !(Style{}).IsZero() || Red.IsZero() {
+		t.Error("unexpected IsZero")
+	}
+}
@@ -0,0 +1,144 @@
+// This file implements the integrity footer embedded in exported files.
+
+package exporter
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/go-data-exporter/exporter/codec"
+)
+
+// integrityMarker starts the text of the integrity footer.
+const integrityMarker = "exporter-integrity"
+
+// maxTrailerLength is the longest line Verify considers as the integrity footer.
+const maxTrailerLength = 1024
+
+// integrityText matches the text of the integrity footer within its line.
+var integrityText = regexp.MustCompile(integrityMarker + ` rows=(\d+) sha256=([0-9a-f]{64})`)
+
+// Errors reported by Verify.
+var (
+	ErrNoIntegrityFooter = errors.New("exporter: no integrity footer")
+	ErrIntegrityMismatch = errors.New("exporter: content does not match the integrity footer")
+)
+
+// WithIntegrityFooter ends the output with a line holding the number of rows and
+// the SHA-256 hash of all preceding output, e.g.
+//
+//	# exporter-integrity rows=42 sha256=9f86d0...
+//
+// embedded in the format by the codec, such as a comment in CSV, HTML and XML or
+// a record in newline-delimited JSON, so that recipients can check with Verify
+// that a file is complete without a sidecar file. The codec must implement
+// codec.Trailer; JSON arrays cannot carry a footer. The row count is the number of
+// rows written by the codec, without rows skipped by a preprocessor or beyond a
+// codec limit. With compression, the footer is part of the compressed content.
+func WithIntegrityFooter(footer bool) Option {
+	return func(e *Exporter) {
+		e.integrityFooter = footer
+	}
+}
+
+// Integrity is the content of a verified integrity footer.
+type Integrity struct {
+	Rows   int64  // Number of rows reported by the footer.
+	SHA256 string // Hex-encoded SHA-256 hash of the content before the footer.
+}
+
+// Verify reads a file written with WithIntegrityFooter and checks that its content
+// matches the footer in its last line. It returns ErrNoIntegrityFooter if the last
+// line holds no footer and ErrIntegrityMismatch if the content was changed or
+// truncated. Compressed files must be decompressed first.
+func Verify(r io.Reader) (Integrity, error) {
+	h := sha256.New()
+	br := bufio.NewReaderSize(r, 64<<10)
+	// The last complete line is held back while it may be the footer.
+	var candidate []byte
+	lineStart := true
+	for {
+		chunk, err := br.ReadSlice('\n')
+		if len(chunk) != 0 {
+			h.Write(candidate)
+			candidate = nil
+			if lineStart && len(chunk) <= maxTrailerLength && (err == nil || errors.Is(err, io.EOF)) {
+				candidate = bytes.Clone(chunk)
+			} else {
+				h.Write(chunk)
+			}
+			lineStart = err == nil
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, bufio.ErrBufferFull) {
+			return Integrity{}, err
+		}
+	}
+	match := integrityText.FindSubmatch(candidate)
+	if match == nil {
+		return Integrity{}, ErrNoIntegrityFooter
+	}
+	rows, err := strconv.ParseInt(string(match[1]), 10, 64)
+	if err != nil {
+		return Integrity{}, fmt.Errorf("%w: %w", ErrNoIntegrityFooter, err)
+	}
+	integrity := Integrity{Rows: rows, SHA256: string(match[2])}
+	if hex.EncodeToString(h.Sum(nil)) != integrity.SHA256 {
+		return integrity, ErrIntegrityMismatch
+	}
+	return integrity, nil
+}
+
+// integrityWriter hashes the output and appends the integrity footer.
+type integrityWriter struct {
+	io.Writer
+	hash    hash.Hash
+	trailer codec.Trailer
+	last    byte // The last byte written, 0 if nothing was written.
+}
+
+// newIntegrityWriter returns an integrityWriter for the codec of the export, or
+// an error if the codec cannot embed a footer.
+func newIntegrityWriter(w io.Writer, c codec.Codec) (*integrityWriter, error) {
+	trailer, ok := c.(codec.Trailer)
+	if ok {
+		_, ok = trailer.TrailerLine(integrityMarker)
+	}
+	if !ok {
+		return nil, fmt.Errorf("exporter: codec %T cannot embed an integrity footer", c)
+	}
+	return &integrityWriter{Writer: w, hash: sha256.New(), trailer: trailer}, nil
+}
+
+// Write writes p and adds it to the hash.
+func (w *integrityWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.hash.Write(p[:n])
+	if n > 0 {
+		w.last = p[n-1]
+	}
+	return n, err
+}
+
+// finish ends the content with a line break and writes the footer for rows.
+func (w *integrityWriter) finish(rows int64) error {
+	if w.last != 0 && w.last != '\n' {
+		if _, err := w.Write([]byte("\n")); err != nil {
+			return err
+		}
+	}
+	text := fmt.Sprintf("%s rows=%d sha256=%x", integrityMarker, rows, w.hash.Sum(nil))
+	line, _ := w.trailer.TrailerLine(text)
+	_, err := io.WriteString(w.Writer, line)
+	return err
+}
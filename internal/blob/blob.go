@@ -0,0 +1,85 @@
+// Package blob streams scanner.Blob values into encoded rows. Codecs encode a row
+// with an opaque placeholder in place of every Blob and splice the base64-encoded
+// contents of the Blob into the output in place of the placeholder, so that large
+// objects never have to be held in memory.
+package blob
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strconv"
+)
+
+// Splicer registers the blobs of a row and writes encoded rows with the blobs spliced in.
+// A Splicer is not safe for concurrent use.
+type Splicer struct {
+	prefix string
+	blobs  []io.Reader
+}
+
+// New returns a Splicer with placeholders that are unique to it.
+func New() *Splicer {
+	return &Splicer{prefix: fmt.Sprintf("exporterblob%016x", rand.Uint64())}
+}
+
+// Reset forgets the blobs of the previous row.
+func (s *Splicer) Reset() {
+	clear(s.blobs)
+	s.blobs = s.blobs[:0]
+}
+
+// Len returns the number of blobs registered since the last Reset.
+func (s *Splicer) Len() int {
+	return len(s.blobs)
+}
+
+// Placeholder registers r and returns the alphanumeric placeholder to encode in its place.
+func (s *Splicer) Placeholder(r io.Reader) string {
+	s.blobs = append(s.blobs, r)
+	return s.prefix + strconv.Itoa(len(s.blobs)-1) + "x"
+}
+
+// Write writes data to w, replacing every placeholder with the base64-encoded
+// contents of its blob.
+func (s *Splicer) Write(w io.Writer, data []byte) error {
+	if len(s.blobs) == 0 {
+		_, err := w.Write(data)
+		return err
+	}
+	prefix := []byte(s.prefix)
+	for {
+		start := bytes.Index(data, prefix)
+		if start < 0 {
+			break
+		}
+		end := start + len(prefix)
+		for end < len(data) && '0' <= data[end] && data[end] <= '9' {
+			end++
+		}
+		i, err := strconv.Atoi(string(data[start+len(prefix) : end]))
+		if err != nil || end == len(data) || data[end] != 'x' || i >= len(s.blobs) {
+			// Not a placeholder; write it through.
+			if _, err := w.Write(data[:start+len(prefix)]); err != nil {
+				return err
+			}
+			data = data[start+len(prefix):]
+			continue
+		}
+		if _, err := w.Write(data[:start]); err != nil {
+			return err
+		}
+		enc := base64.NewEncoder(base64.StdEncoding, w)
+		if _, err := io.Copy(enc, s.blobs[i]); err != nil {
+			return fmt.Errorf("failed to stream blob: %w", err)
+		}
+		if err := enc.Close(); err != nil {
+			return err
+		}
+		data = data[end+1:]
+	}
+	_, err := w.Write(data)
+	return err
+}
@@ -0,0 +1,111 @@
+// Package colstats collects statistics about the values of a column in a single
+// pass, shared by the column statistics of the exporter and the codecs.
+package colstats
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-data-exporter/exporter/internal/hll"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// Column accumulates the statistics of the values of a column.
+type Column struct {
+	Count     int64 // Number of non-NULL values.
+	Nulls     int64 // Number of NULL values.
+	MaxLength int   // Maximum length in characters of the string form of the values.
+	Min       any   // Smallest number, time or string value, if any.
+	Max       any   // Largest number, time or string value, if any.
+
+	distinct hll.Sketch
+}
+
+// Distinct returns the estimated number of distinct non-NULL values.
+func (s *Column) Distinct() uint64 {
+	return s.distinct.Estimate()
+}
+
+// Add adds a value to the statistics.
+func (s *Column) Add(v any) {
+	str := tostring.ToString(v)
+	if str.IsNULL {
+		s.Nulls++
+		return
+	}
+	s.Count++
+	s.distinct.AddString(str.String)
+	if n := utf8.RuneCountInString(str.String); n > s.MaxLength {
+		s.MaxLength = n
+	}
+	if s.Min == nil || Less(v, s.Min) {
+		if comparable := Comparable(v); comparable != nil {
+			s.Min = comparable
+		}
+	}
+	if s.Max == nil || Less(s.Max, v) {
+		if comparable := Comparable(v); comparable != nil {
+			s.Max = comparable
+		}
+	}
+}
+
+// Comparable normalizes v to int64, uint64, float64, time.Time or string,
+// or returns nil if v is not ordered.
+func Comparable(v any) any {
+	switch v := v.(type) {
+	case int:
+		return int64(v)
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case uint:
+		return uint64(v)
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uint32:
+		return uint64(v)
+	case uint64:
+		return v
+	case float32:
+		return float64(v)
+	case float64:
+		return v
+	case time.Time:
+		return v
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+	return nil
+}
+
+// Less reports whether a is ordered before b. Values of different kinds are not ordered.
+func Less(a, b any) bool {
+	switch a := Comparable(a).(type) {
+	case int64:
+		b, ok := Comparable(b).(int64)
+		return ok && a < b
+	case uint64:
+		b, ok := Comparable(b).(uint64)
+		return ok && a < b
+	case float64:
+		b, ok := Comparable(b).(float64)
+		return ok && a < b
+	case time.Time:
+		b, ok := Comparable(b).(time.Time)
+		return ok && a.Before(b)
+	case string:
+		b, ok := Comparable(b).(string)
+		return ok && a < b
+	}
+	return false
+}
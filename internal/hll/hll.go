@@ -0,0 +1,70 @@
+// Package hll implements a HyperLogLog sketch for estimating the number of
+// distinct values in a stream with a fixed amount of memory.
+package hll
+
+import (
+	"hash/maphash"
+	"math"
+	"math/bits"
+)
+
+// precision is the number of hash bits used to select a register. 2^14 registers
+// use 16 KiB per sketch and give a standard error of about 0.8%.
+const precision = 14
+
+// registers is the number of registers of a sketch.
+const registers = 1 << precision
+
+// seed is shared by all sketches so that their hashes are comparable.
+var seed = maphash.MakeSeed()
+
+// Sketch estimates the number of distinct byte strings added to it.
+// The zero value is an empty sketch; a Sketch is not safe for concurrent use.
+type Sketch struct {
+	registers []uint8
+}
+
+// Add adds a value to the sketch.
+func (s *Sketch) Add(b []byte) {
+	s.addHash(maphash.Bytes(seed, b))
+}
+
+// AddString adds a value to the sketch.
+func (s *Sketch) AddString(str string) {
+	s.addHash(maphash.String(seed, str))
+}
+
+// addHash records a 64-bit hash.
+func (s *Sketch) addHash(h uint64) {
+	if s.registers == nil {
+		s.registers = make([]uint8, registers)
+	}
+	index := h >> (64 - precision)
+	rank := uint8(bits.LeadingZeros64(h<<precision|1<<(precision-1)) + 1)
+	if rank > s.registers[index] {
+		s.registers[index] = rank
+	}
+}
+
+// Estimate returns the estimated number of distinct values added to the sketch.
+func (s *Sketch) Estimate() uint64 {
+	if s.registers == nil {
+		return 0
+	}
+	sum := 0.0
+	zeros := 0
+	for _, r := range s.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+	m := float64(registers)
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros != 0 {
+		// Linear counting is more accurate for small cardinalities.
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
@@ -0,0 +1,99 @@
+// Package rowbuf provides pooled buffers that codecs use to convert the cells
+// of a row. All cells are appended to one byte buffer and materialized with a
+// single string allocation per row instead of one or more per cell.
+package rowbuf
+
+import (
+	"sync"
+
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// maxPooledSize is the largest buffer capacity returned to the pool, so that
+// a single huge row does not pin memory for the lifetime of the process.
+const maxPooledSize = 64 << 10
+
+var pool = sync.Pool{
+	New: func() any {
+		return &Row{buf: make([]byte, 0, 1024)}
+	},
+}
+
+// Row accumulates the converted cells of a single row.
+// A Row is not safe for concurrent use.
+type Row struct {
+	buf   []byte
+	ends  []int
+	nulls []bool
+}
+
+// Get returns an empty Row from the pool.
+func Get() *Row {
+	r := pool.Get().(*Row)
+	r.Reset()
+	return r
+}
+
+// Put returns r to the pool. r must not be used afterwards.
+func Put(r *Row) {
+	if cap(r.buf) > maxPooledSize {
+		return
+	}
+	pool.Put(r)
+}
+
+// Reset removes all cells from r, keeping the allocated memory.
+func (r *Row) Reset() {
+	r.buf = r.buf[:0]
+	r.ends = r.ends[:0]
+	r.nulls = r.nulls[:0]
+}
+
+// AppendValue converts v with the converter and appends it as the next cell.
+// NULL values are appended as nullValue.
+func (r *Row) AppendValue(c *tostring.Converter, v any, driver, typeName, nullValue string) {
+	var isNULL bool
+	r.buf, isNULL = c.AppendFor(r.buf, v, driver, typeName)
+	if isNULL {
+		r.buf = append(r.buf, nullValue...)
+	}
+	r.end(isNULL)
+}
+
+// AppendString appends s as the next cell. NULL values are appended as nullValue.
+func (r *Row) AppendString(s tostring.String, nullValue string) {
+	if s.IsNULL {
+		r.buf = append(r.buf, nullValue...)
+	} else {
+		r.buf = append(r.buf, s.String...)
+	}
+	r.end(s.IsNULL)
+}
+
+// end finishes the current cell.
+func (r *Row) end(isNULL bool) {
+	r.ends = append(r.ends, len(r.buf))
+	r.nulls = append(r.nulls, isNULL)
+}
+
+// IsNULL reports whether cell i was NULL.
+func (r *Row) IsNULL(i int) bool {
+	return i < len(r.nulls) && r.nulls[i]
+}
+
+// Strings stores the cells into dst, which is grown to the number of cells if
+// needed, and returns it. The strings share a single allocation and remain
+// valid after r is reset or returned to the pool.
+func (r *Row) Strings(dst []string) []string {
+	if cap(dst) < len(r.ends) {
+		dst = make([]string, len(r.ends))
+	}
+	dst = dst[:len(r.ends)]
+	line := string(r.buf)
+	start := 0
+	for i, end := range r.ends {
+		dst[i] = line[start:end]
+		start = end
+	}
+	return dst
+}
@@ -0,0 +1,46 @@
+// Package rowcounter implements the row numbering and limit semantics shared by
+// all codecs: the row ID of a row is its position among the written rows
+// (starting from 1), so a row skipped by a preprocessor passes its ID on to the
+// next row, and the limit applies to the number of rows actually written, so
+// skipped rows do not count towards the limit. Written rows are reported to the
+// source if it implements scanner.WriteTracker.
+package rowcounter
+
+import "github.com/go-data-exporter/exporter/scanner"
+
+// Counter tracks the written rows of a single Write call.
+type Counter struct {
+	rows    scanner.Rows
+	limit   int
+	written int
+}
+
+// New creates a Counter for the rows passed to Write with the given limit. A
+// negative limit means no limit.
+func New(rows scanner.Rows, limit int) *Counter {
+	scanner.TrackWrites(rows)
+	return &Counter{rows: rows, limit: limit}
+}
+
+// Scan records a row read from the source and returns its row ID, the position
+// it takes if it is written.
+func (c *Counter) Scan() int {
+	return c.written + 1
+}
+
+// Write records a written row and reports whether the limit has been reached.
+func (c *Counter) Write() (limitReached bool) {
+	c.written++
+	scanner.RowWritten(c.rows)
+	return c.Done()
+}
+
+// Written returns the number of rows written so far.
+func (c *Counter) Written() int {
+	return c.written
+}
+
+// Done reports whether no more rows may be written.
+func (c *Counter) Done() bool {
+	return c.limit >= 0 && c.written >= c.limit
+}
@@ -0,0 +1,85 @@
+// Package rowiter provides the row iteration used by codecs. Sources that
+// implement scanner.BatchScanner are read in batches; all other sources are
+// read with Next and ScanRow.
+package rowiter
+
+import "github.com/go-data-exporter/exporter/scanner"
+
+// batchSize is the number of rows requested from batch scanners at once.
+const batchSize = 256
+
+// Iter iterates over the rows of a scanner.Rows. It has the same contract as
+// Next, ScanRow and Err of scanner.Rows. When the source is read in batches,
+// up to batchSize rows may be read ahead of the row returned by ScanRow.
+type Iter struct {
+	rows  scanner.Rows
+	batch scanner.BatchScanner
+
+	buf     [][]any
+	n, pos  int
+	current []any
+	err     error
+	failed  bool
+}
+
+// New returns an Iter over rows.
+func New(rows scanner.Rows) *Iter {
+	it := &Iter{rows: rows}
+	if batch, ok := rows.(scanner.BatchScanner); ok {
+		it.batch = batch
+		it.buf = make([][]any, batchSize)
+	}
+	return it
+}
+
+// Next prepares the next row for reading. It returns false when no more rows are available.
+func (it *Iter) Next() bool {
+	if it.batch == nil {
+		return it.rows.Next()
+	}
+	if it.failed {
+		return false
+	}
+	if it.pos < it.n {
+		it.current = it.buf[it.pos]
+		it.pos++
+		return true
+	}
+	if it.err != nil {
+		// Report the batch error through ScanRow.
+		it.current, it.failed = nil, true
+		return true
+	}
+	it.n, it.err = it.batch.ScanBatch(it.buf)
+	it.pos = 0
+	if it.n == 0 && it.err == nil {
+		return false
+	}
+	return it.Next()
+}
+
+// ScanRow returns the current row's values.
+func (it *Iter) ScanRow() ([]any, error) {
+	if it.batch == nil {
+		return it.rows.ScanRow()
+	}
+	if it.failed {
+		return nil, it.err
+	}
+	return it.current, nil
+}
+
+// RawValue returns the raw value of the column at index in the current row if
+// the source implements scanner.RawValuer. It is not available for sources read
+// in batches, whose current row is not the row returned by ScanRow.
+func (it *Iter) RawValue(column int) (any, bool) {
+	if it.batch != nil {
+		return nil, false
+	}
+	return scanner.RawValue(it.rows, column)
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (it *Iter) Err() error {
+	return it.rows.Err()
+}
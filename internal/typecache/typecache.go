@@ -0,0 +1,44 @@
+// Package typecache caches custom type mapper lookups per column. Values of a
+// column almost always share one Go type, so remembering the last type seen per
+// column avoids a map lookup per cell, and registries without mappers skip
+// reflection entirely.
+package typecache
+
+import "reflect"
+
+// Cache resolves mappers registered by Go type for the values of each column.
+// A Cache is not safe for concurrent use; codecs create one per Write call.
+type Cache[F any] struct {
+	mappers map[reflect.Type]F
+	types   []reflect.Type
+	fns     []F
+	found   []bool
+}
+
+// New creates a Cache for the given mappers and number of columns.
+func New[F any](mappers map[reflect.Type]F, columns int) *Cache[F] {
+	c := &Cache[F]{mappers: mappers}
+	if len(mappers) != 0 {
+		c.types = make([]reflect.Type, columns)
+		c.fns = make([]F, columns)
+		c.found = make([]bool, columns)
+	}
+	return c
+}
+
+// Lookup returns the mapper registered for the dynamic type of v, a value of column col.
+func (c *Cache[F]) Lookup(col int, v any) (fn F, ok bool) {
+	if len(c.mappers) == 0 || v == nil {
+		return fn, false
+	}
+	typ := reflect.TypeOf(v)
+	if col >= len(c.types) {
+		fn, ok = c.mappers[typ]
+		return fn, ok
+	}
+	if c.types[col] != typ {
+		c.types[col] = typ
+		c.fns[col], c.found[col] = c.mappers[typ]
+	}
+	return c.fns[col], c.found[col]
+}
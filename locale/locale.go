@@ -0,0 +1,149 @@
+// Package locale provides bundles of the words and number formats used by
+// human-facing codecs such as the HTML codec, so that reports for end users read
+// naturally in their language. Codecs meant for machines do not use locales.
+package locale
+
+import (
+	"fmt"
+
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// Locale holds the words and number format of a language. Custom locales are
+// created as struct literals; empty words fall back to English.
+type Locale struct {
+	Tag string // The BCP 47 language tag, e.g. "de", declared in documents.
+
+	True  string // The word for true.
+	False string // The word for false.
+	NULL  string // The text shown for NULL values.
+
+	DecimalSeparator string // The decimal separator of numbers, "." if empty.
+	GroupSeparator   string // The separator of digit groups, no grouping if empty.
+
+	// Rows formats a row count, e.g. "42 rows", and RowsOf a partial count,
+	// e.g. "42 of 100 rows". Nil functions use English.
+	Rows   func(n int64) string
+	RowsOf func(n, total int64) string
+}
+
+// Bundled locales.
+var (
+	English = &Locale{
+		Tag:              "en",
+		True:             "true",
+		False:            "false",
+		NULL:             "[NULL]",
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		Rows:             func(n int64) string { return fmt.Sprintf("%d rows", n) },
+		RowsOf:           func(n, total int64) string { return fmt.Sprintf("%d of %d rows", n, total) },
+	}
+	German = &Locale{
+		Tag:              "de",
+		True:             "wahr",
+		False:            "falsch",
+		NULL:             "[kein Wert]",
+		DecimalSeparator: ",",
+		GroupSeparator:   ".",
+		Rows:             func(n int64) string { return fmt.Sprintf("%d %s", n, plural(n, "Zeile", "Zeilen")) },
+		RowsOf: func(n, total int64) string {
+			return fmt.Sprintf("%d von %d %s", n, total, plural(total, "Zeile", "Zeilen"))
+		},
+	}
+	French = &Locale{
+		Tag:              "fr",
+		True:             "vrai",
+		False:            "faux",
+		NULL:             "[aucune valeur]",
+		DecimalSeparator: ",",
+		GroupSeparator:   "\u202f", // Narrow no-break space.
+		Rows: func(n int64) string {
+			// French uses the singular for 0 and 1.
+			return fmt.Sprintf("%d %s", n, plural(max(n, 1), "ligne", "lignes"))
+		},
+		RowsOf: func(n, total int64) string {
+			return fmt.Sprintf("%d sur %d %s", n, total, plural(max(total, 1), "ligne", "lignes"))
+		},
+	}
+	Russian = &Locale{
+		Tag:              "ru",
+		True:             "да",
+		False:            "нет",
+		NULL:             "[нет значения]",
+		DecimalSeparator: ",",
+		GroupSeparator:   "\u00a0", // No-break space.
+		Rows:             func(n int64) string { return fmt.Sprintf("%d %s", n, russianRows(n)) },
+		RowsOf: func(n, total int64) string {
+			// "42 из 100 строк" agrees with the total in the genitive plural.
+			return fmt.Sprintf("%d из %d %s", n, total, russianRowsOf(total))
+		},
+	}
+)
+
+// ConverterOptions returns the tostring options of the locale: the bool words
+// and the number separators. Append further options, e.g. a time layout, to build
+// the converter of a codec.
+func (l *Locale) ConverterOptions() []tostring.Option {
+	trueWord, falseWord := l.True, l.False
+	if trueWord == "" {
+		trueWord = English.True
+	}
+	if falseWord == "" {
+		falseWord = English.False
+	}
+	return []tostring.Option{
+		tostring.WithBoolLiterals(trueWord, falseWord),
+		tostring.WithNumberSeparators(l.DecimalSeparator, l.GroupSeparator),
+	}
+}
+
+// NULLText returns the text shown for NULL values.
+func (l *Locale) NULLText() string {
+	if l.NULL == "" {
+		return English.NULL
+	}
+	return l.NULL
+}
+
+// RowCount returns the row count n, or n of total if total is positive.
+func (l *Locale) RowCount(n, total int64) string {
+	if total > 0 {
+		if l.RowsOf != nil {
+			return l.RowsOf(n, total)
+		}
+		return English.RowsOf(n, total)
+	}
+	if l.Rows != nil {
+		return l.Rows(n)
+	}
+	return English.Rows(n)
+}
+
+// plural returns one for n == 1 and other otherwise.
+func plural(n int64, one, other string) string {
+	if n == 1 {
+		return one
+	}
+	return other
+}
+
+// russianRows returns the form of "row" agreeing with n.
+func russianRows(n int64) string {
+	switch mod10, mod100 := n%10, n%100; {
+	case mod10 == 1 && mod100 != 11:
+		return "строка"
+	case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+		return "строки"
+	}
+	return "строк"
+}
+
+// russianRowsOf returns the form of "row" after "из n": the genitive singular
+// for numbers ending in 1 and the genitive plural otherwise.
+func russianRowsOf(n int64) string {
+	if n%10 == 1 && n%100 != 11 {
+		return "строки"
+	}
+	return "строк"
+}
@@ -0,0 +1,50 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+func TestRowCount(t *testing.T) {
+	tests := []struct {
+		locale   *Locale
+		n, total int64
+		want     string
+	}{
+		{English, 42, 0, "42 rows"},
+		{English, 42, 100, "42 of 100 rows"},
+		{German, 1, 0, "1 Zeile"},
+		{German, 5, 21, "5 von 21 Zeilen"},
+		{French, 0, 0, "0 ligne"},
+		{French, 2, 0, "2 lignes"},
+		{Russian, 21, 0, "21 строка"},
+		{Russian, 3, 0, "3 строки"},
+		{Russian, 12, 0, "12 строк"},
+		{Russian, 5, 21, "5 из 21 строки"},
+		{Russian, 5, 100, "5 из 100 строк"},
+		{&Locale{Tag: "xx"}, 7, 0, "7 rows"},
+	}
+	for _, tt := range tests {
+		if got := tt.locale.RowCount(tt.n, tt.total); got != tt.want {
+			t.Errorf("%s: RowCount(%d, %d) = %q, want %q", tt.locale.Tag, tt.n, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestConverterOptions(t *testing.T) {
+	c := tostring.New(German.ConverterOptions()...)
+	if got := c.ToString(1234.5).String; got != "1.234,5" {
+		t.Errorf("got %q, want %q", got, "1.234,5")
+	}
+	if got := c.ToString(true).String; got != "wahr" {
+		t.Errorf("got %q, want %q", got, "wahr")
+	}
+	custom := &Locale{DecimalSeparator: ","}
+	if got := tostring.New(custom.ConverterOptions()...).ToString(false).String; got != "false" {
+		t.Errorf("got %q, want the English word", got)
+	}
+	if got := custom.NULLText(); got != English.NULL {
+		t.Errorf("got %q, want the English NULL text", got)
+	}
+}
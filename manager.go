@@ -0,0 +1,213 @@
+// This file implements a registry of running exports with per-tenant quotas.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Errors returned by Manager.Run.
+var (
+	ErrDuplicateExport = errors.New("exporter: an export with this id is already running")
+	ErrTooManyExports  = errors.New("exporter: tenant has too many running exports")
+	ErrByteQuota       = errors.New("exporter: tenant byte quota exceeded")
+	ErrExportCanceled  = errors.New("exporter: export canceled")
+)
+
+// ManagerOption defines a functional option for configuring a Manager.
+type ManagerOption func(*Manager)
+
+// WithTenantLimits sets the default limits of every tenant: the number of exports
+// running at the same time and the number of bytes all exports of the tenant may
+// write in total. Non-positive values mean no limit (default).
+func WithTenantLimits(maxConcurrent int, maxBytes int64) ManagerOption {
+	return func(m *Manager) {
+		m.defaults = tenantLimits{maxConcurrent: maxConcurrent, maxBytes: maxBytes}
+	}
+}
+
+// WithTenantOverride sets the limits of tenant, overriding WithTenantLimits,
+// e.g. for tenants on a larger plan.
+func WithTenantOverride(tenant string, maxConcurrent int, maxBytes int64) ManagerOption {
+	return func(m *Manager) {
+		m.overrides[tenant] = tenantLimits{maxConcurrent: maxConcurrent, maxBytes: maxBytes}
+	}
+}
+
+// tenantLimits are the quotas of a tenant.
+type tenantLimits struct {
+	maxConcurrent int
+	maxBytes      int64
+}
+
+// Manager tracks the running exports of several tenants by ID, for services that
+// run exports on behalf of users: it enforces the quotas of every tenant and
+// cancels a running export on request. A Manager is safe for concurrent use.
+type Manager struct {
+	defaults  tenantLimits
+	overrides map[string]tenantLimits
+
+	mu      sync.Mutex
+	jobs    map[string]*managedExport
+	running map[string]int   // The number of running exports by tenant.
+	usage   map[string]int64 // The bytes written by tenant.
+}
+
+// RunningExport describes an export running under a Manager.
+type RunningExport struct {
+	ID      string
+	Tenant  string
+	Started time.Time
+	Bytes   int64 // The number of bytes written so far.
+}
+
+// managedExport is a running export with the cancellation of its context.
+type managedExport struct {
+	RunningExport
+	cancel context.CancelCauseFunc
+}
+
+// NewManager returns a Manager without running exports.
+func NewManager(opts ...ManagerOption) *Manager {
+	m := &Manager{
+		overrides: make(map[string]tenantLimits),
+		jobs:      make(map[string]*managedExport),
+		running:   make(map[string]int),
+		usage:     make(map[string]int64),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// limits returns the limits of tenant.
+func (m *Manager) limits(tenant string) tenantLimits {
+	if limits, ok := m.overrides[tenant]; ok {
+		return limits
+	}
+	return m.defaults
+}
+
+// Run writes the export of e to w as the export id of tenant and returns when it
+// ends. It fails without exporting with ErrDuplicateExport if an export with the same
+// id is running, with ErrTooManyExports if the tenant runs as many exports as
+// allowed and with ErrByteQuota if the tenant has used up its byte quota. The
+// export stops with ErrByteQuota when a write would exceed the quota, with
+// ErrExportCanceled when it is canceled with Cancel, and with the error of ctx once
+// it is done; the data written to w up to then is incomplete.
+func (m *Manager) Run(ctx context.Context, id, tenant string, e *Exporter, w io.Writer) (Stats, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	job := &managedExport{RunningExport: RunningExport{ID: id, Tenant: tenant, Started: time.Now()}, cancel: cancel}
+	if err := m.start(job); err != nil {
+		return Stats{}, err
+	}
+	defer m.finish(job)
+	run := *e
+	run.rows = &contextRows{Rows: e.rows, ctx: ctx}
+	stats, err := run.Export(&quotaWriter{Writer: w, manager: m, job: job})
+	if cause := context.Cause(ctx); err != nil && cause != nil {
+		// Report the cancellation or the quota rather than the error it caused.
+		err = cause
+	}
+	return stats, err
+}
+
+// start registers the export job if the quotas of its tenant allow it.
+func (m *Manager) start(job *managedExport) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.jobs[job.ID]; ok {
+		return ErrDuplicateExport
+	}
+	limits := m.limits(job.Tenant)
+	if limits.maxConcurrent > 0 && m.running[job.Tenant] >= limits.maxConcurrent {
+		return ErrTooManyExports
+	}
+	if limits.maxBytes > 0 && m.usage[job.Tenant] >= limits.maxBytes {
+		return ErrByteQuota
+	}
+	m.jobs[job.ID] = job
+	m.running[job.Tenant]++
+	return nil
+}
+
+// finish unregisters the export job.
+func (m *Manager) finish(job *managedExport) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.jobs, job.ID)
+	if m.running[job.Tenant]--; m.running[job.Tenant] == 0 {
+		delete(m.running, job.Tenant)
+	}
+}
+
+// Cancel cancels the running export id, which then fails with ErrExportCanceled. It
+// reports whether such an export was running.
+func (m *Manager) Cancel(id string) bool {
+	m.mu.Lock()
+	job, ok := m.jobs[id]
+	m.mu.Unlock()
+	if ok {
+		job.cancel(ErrExportCanceled)
+	}
+	return ok
+}
+
+// Running returns the running exports ordered by ID.
+func (m *Manager) Running() []RunningExport {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	running := make([]RunningExport, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		running = append(running, job.RunningExport)
+	}
+	slices.SortFunc(running, func(a, b RunningExport) int { return strings.Compare(a.ID, b.ID) })
+	return running
+}
+
+// Usage returns the number of bytes written by the exports of tenant.
+func (m *Manager) Usage(tenant string) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.usage[tenant]
+}
+
+// ResetUsage resets the byte usage of tenant to zero, e.g. at the start of a
+// billing period.
+func (m *Manager) ResetUsage(tenant string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.usage, tenant)
+}
+
+// quotaWriter counts the bytes of an export against the byte quota of its tenant.
+type quotaWriter struct {
+	io.Writer
+	manager *Manager
+	job     *managedExport
+}
+
+// Write writes p unless it would exceed the byte quota of the tenant, in which
+// case the export is canceled with ErrByteQuota.
+func (w *quotaWriter) Write(p []byte) (int, error) {
+	m := w.manager
+	m.mu.Lock()
+	limits := m.limits(w.job.Tenant)
+	if limits.maxBytes > 0 && m.usage[w.job.Tenant]+int64(len(p)) > limits.maxBytes {
+		m.mu.Unlock()
+		w.job.cancel(ErrByteQuota)
+		return 0, ErrByteQuota
+	}
+	m.usage[w.job.Tenant] += int64(len(p))
+	w.job.Bytes += int64(len(p))
+	m.mu.Unlock()
+	return w.Writer.Write(p)
+}
@@ -0,0 +1,25 @@
+// This file implements the masking of sensitive column values.
+
+package exporter
+
+import "github.com/go-data-exporter/exporter/scanner"
+
+// DefaultMask is the text that replaces the values of masked columns in Builder.Mask.
+const DefaultMask = "****"
+
+// WithMaskedColumns replaces every non-NULL value of the named columns with mask,
+// e.g. to hide secrets from an export while keeping its columns. Unlike
+// WithTokenizedColumns, masked values cannot be joined or recovered.
+func WithMaskedColumns(mask string, columnNames ...string) Option {
+	fn := func(v any, _ scanner.Metadata) (any, error) {
+		if v == nil {
+			return nil, nil
+		}
+		return mask, nil
+	}
+	return func(e *Exporter) {
+		for _, name := range columnNames {
+			e.transforms = append(e.transforms, transform{column: name, fn: fn})
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// This file implements the metadata describing an export.
+
+package exporter
+
+import (
+	"maps"
+	"strconv"
+	"time"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// Keys of the metadata that the Exporter provides by default.
+const (
+	MetadataDriver      = "driver"       // The driver of the source rows.
+	MetadataGeneratedAt = "generated_at" // The start of the export in RFC 3339 format, in UTC.
+	MetadataRows        = "rows"         // The estimated total number of rows, if known.
+)
+
+// WithMetadata adds entries to the metadata describing the export, such as the
+// query name or the applied filters. Codecs that support it, like the HTML codec
+// with WithMetadataBlock, render the metadata alongside the data; they obtain it
+// with scanner.Describe. The metadata always includes MetadataDriver, MetadataRows
+// if the source can estimate its size, and MetadataGeneratedAt unless the output is
+// deterministic; entries given here take precedence. Multiple calls are merged.
+func WithMetadata(metadata map[string]string) Option {
+	return func(e *Exporter) {
+		if e.metadata == nil {
+			e.metadata = make(map[string]string, len(metadata))
+		}
+		maps.Copy(e.metadata, metadata)
+	}
+}
+
+// describe returns the metadata of an export of rows started at now.
+func (cs *Exporter) describe(rows scanner.Rows, now time.Time) map[string]string {
+	metadata := map[string]string{MetadataDriver: rows.Driver()}
+	if !cs.deterministic {
+		metadata[MetadataGeneratedAt] = now.UTC().Format(time.RFC3339)
+	}
+	if n, ok := scanner.EstimateRows(rows); ok {
+		metadata[MetadataRows] = strconv.FormatInt(n, 10)
+	}
+	maps.Copy(metadata, scanner.Describe(rows))
+	maps.Copy(metadata, cs.metadata)
+	return metadata
+}
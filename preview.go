@@ -0,0 +1,60 @@
+// This file implements previews of an export.
+
+package exporter
+
+import (
+	"github.com/go-data-exporter/exporter/codec"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// PreviewResult holds a rendered sample of an export.
+type PreviewResult struct {
+	Data          []byte           // The first rows rendered with the codec.
+	Rows          int64            // Number of rows rendered.
+	Columns       []scanner.Column // Column metadata of the source.
+	EstimatedRows int64            // Estimated total number of rows, valid if HasEstimate is set.
+	HasEstimate   bool             // Whether the source could estimate its total row count.
+}
+
+// Preview renders the first nRows rows of rows with the codec, together with the
+// column metadata and, if the source can provide it cheaply, an estimate of the
+// total number of rows. It is meant for UIs showing a sample before a full export.
+// Only the previewed rows are read from the source.
+func Preview(rows scanner.Rows, c codec.Codec, nRows int, opts ...Option) (*PreviewResult, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	result := &PreviewResult{Columns: cols}
+	result.EstimatedRows, result.HasEstimate = scanner.EstimateRows(rows)
+	e := New(&limitRows{Rows: rows, remaining: nRows}, c, opts...)
+	buf := &limitedBuffer{limit: e.maxBufferSize}
+	stats, err := e.Export(buf)
+	if buf.err != nil {
+		return nil, buf.err
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Data = buf.Bytes()
+	result.Rows = stats.Rows
+	return result, nil
+}
+
+// limitRows wraps a Rows and stops after a fixed number of rows.
+type limitRows struct {
+	scanner.Rows
+	remaining int
+}
+
+// Next reports whether another row is available within the limit.
+func (l *limitRows) Next() bool {
+	if l.remaining <= 0 {
+		return false
+	}
+	if !l.Rows.Next() {
+		return false
+	}
+	l.remaining--
+	return true
+}
@@ -0,0 +1,277 @@
+// Package quote quotes SQL identifiers and formats values as SQL literals for the
+// dialects of the databases the exporter writes to, e.g. to build INSERT, COPY or
+// CREATE TABLE statements. Postgres, MySQL, SQLite, ClickHouse and BigQuery are
+// registered by default; further dialects can be registered with Register.
+package quote
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// Dialect describes how a database quotes identifiers and literals. Custom
+// dialects are created as struct literals and may reuse the functions of the
+// bundled ones.
+type Dialect struct {
+	Name string // The name the dialect is registered under, e.g. "postgres".
+
+	// Ident quotes a table or column identifier.
+	Ident func(name string) string
+	// String formats s as a string literal.
+	String func(s string) string
+	// Bytes formats b as a binary literal.
+	Bytes func(b []byte) string
+	// Time formats t as a timestamp literal.
+	Time func(t time.Time) string
+
+	True  string // The literal for true.
+	False string // The literal for false.
+}
+
+// Bundled dialects.
+var (
+	Postgres = &Dialect{
+		Name:   "postgres",
+		Ident:  DoubleQuotes,
+		String: SingleQuotes,
+		Bytes:  func(b []byte) string { return `'\x` + hex.EncodeToString(b) + `'` },
+		Time:   timeLiteral("", "2006-01-02 15:04:05.999999-07:00", SingleQuotes),
+		True:   "TRUE",
+		False:  "FALSE",
+	}
+	MySQL = &Dialect{
+		Name:   "mysql",
+		Ident:  Backticks,
+		String: backslashString(mysqlEscaper),
+		Bytes:  hexBytes,
+		Time:   timeLiteral("", "2006-01-02 15:04:05.999999", SingleQuotes),
+		True:   "TRUE",
+		False:  "FALSE",
+	}
+	SQLite = &Dialect{
+		Name:   "sqlite",
+		Ident:  DoubleQuotes,
+		String: SingleQuotes,
+		Bytes:  hexBytes,
+		Time:   timeLiteral("", "2006-01-02 15:04:05.999-07:00", SingleQuotes),
+		True:   "1",
+		False:  "0",
+	}
+	ClickHouse = &Dialect{
+		Name:   "clickhouse",
+		Ident:  backslashIdent,
+		String: backslashString(backslashEscaper),
+		Bytes:  escapedBytes(""),
+		Time:   timeLiteral("", "2006-01-02 15:04:05.999999", SingleQuotes),
+		True:   "true",
+		False:  "false",
+	}
+	BigQuery = &Dialect{
+		Name:   "bigquery",
+		Ident:  backslashIdent,
+		String: backslashString(backslashEscaper),
+		Bytes:  escapedBytes("b"),
+		Time:   timeLiteral("TIMESTAMP ", "2006-01-02 15:04:05.999999-07:00", SingleQuotes),
+		True:   "TRUE",
+		False:  "FALSE",
+	}
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]*Dialect{}
+)
+
+func init() {
+	for _, d := range []*Dialect{Postgres, MySQL, SQLite, ClickHouse, BigQuery} {
+		Register(d)
+	}
+}
+
+// Register makes the dialect available by its name for Lookup, replacing a dialect
+// registered under the same name. Names are case-insensitive.
+func Register(d *Dialect) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[strings.ToLower(d.Name)] = d
+}
+
+// Lookup returns the dialect registered under name.
+func Lookup(name string) (*Dialect, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	d, ok := registry[strings.ToLower(name)]
+	return d, ok
+}
+
+// Names returns the sorted names of the registered dialects.
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// QualifiedIdent quotes every part of a qualified name, e.g. a schema and a table,
+// and joins them with dots.
+func (d *Dialect) QualifiedIdent(parts ...string) string {
+	quoted := make([]string, len(parts))
+	for i, part := range parts {
+		quoted[i] = d.Ident(part)
+	}
+	return strings.Join(quoted, ".")
+}
+
+// Literal formats v as a literal of the dialect. NULL values, including nil
+// pointers and invalid sql.Null* values, are formatted as NULL, numbers as they
+// are, and non-finite floats, which have no numeric literal, as strings. Values of
+// other types are converted with tostring and formatted as string literals.
+func (d *Dialect) Literal(v any) string {
+	if valuer, ok := v.(driver.Valuer); ok {
+		value, err := valuer.Value()
+		if err != nil {
+			return d.String(tostring.ToString(v).String)
+		}
+		v = value
+	}
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return d.String(v)
+	case []byte:
+		if v == nil {
+			return "NULL"
+		}
+		return d.Bytes(v)
+	case bool:
+		if v {
+			return d.True
+		}
+		return d.False
+	case time.Time:
+		return d.Time(v)
+	case float32:
+		return d.float(float64(v), 32)
+	case float64:
+		return d.float(v, 64)
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return "NULL"
+		}
+		return d.Literal(rv.Elem().Interface())
+	}
+	s := tostring.ToString(v)
+	if s.IsNULL {
+		return "NULL"
+	}
+	return d.String(s.String)
+}
+
+// float formats a float literal with the shortest representation.
+func (d *Dialect) float(f float64, bitSize int) string {
+	s := strconv.FormatFloat(f, 'g', -1, bitSize)
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return d.String(s)
+	}
+	return s
+}
+
+// DoubleQuotes quotes an identifier with double quotes as in standard SQL.
+func DoubleQuotes(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// Backticks quotes an identifier with backticks as in MySQL.
+func Backticks(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// SingleQuotes formats a string literal in single quotes as in standard SQL.
+func SingleQuotes(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// backslashEscaper escapes the special characters of string literals and quoted
+// identifiers with backslash escapes as in ClickHouse and BigQuery.
+var backslashEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"'", `\'`,
+	"`", "\\`",
+	"\x00", `\x00`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+// mysqlEscaper escapes the special characters of MySQL string literals.
+var mysqlEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"'", `\'`,
+	"\x00", `\0`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"\x1a", `\Z`,
+)
+
+// backslashString returns a function formatting string literals in single quotes
+// with the backslash escapes of escaper.
+func backslashString(escaper *strings.Replacer) func(s string) string {
+	return func(s string) string {
+		return "'" + escaper.Replace(s) + "'"
+	}
+}
+
+// backslashIdent quotes an identifier with backticks and backslash escapes.
+func backslashIdent(name string) string {
+	return "`" + backslashEscaper.Replace(name) + "`"
+}
+
+// hexBytes formats a binary literal as X'...'.
+func hexBytes(b []byte) string {
+	return "X'" + hex.EncodeToString(b) + "'"
+}
+
+// escapedBytes returns a function formatting binary literals as strings with a
+// \x escape for every byte and the given prefix, e.g. b'\x00\x01'.
+func escapedBytes(prefix string) func(b []byte) string {
+	return func(b []byte) string {
+		const digits = "0123456789abcdef"
+		out := make([]byte, 0, len(prefix)+2+4*len(b))
+		out = append(out, prefix...)
+		out = append(out, '\'')
+		for _, c := range b {
+			out = append(out, '\\', 'x', digits[c>>4], digits[c&0xf])
+		}
+		return string(append(out, '\''))
+	}
+}
+
+// timeLiteral returns a function formatting times with layout as string literals
+// after the given type prefix.
+func timeLiteral(prefix, layout string, str func(string) string) func(t time.Time) string {
+	return func(t time.Time) string {
+		return prefix + str(t.Format(layout))
+	}
+}
@@ -0,0 +1,96 @@
+package quote
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIdent(t *testing.T) {
+	tests := []struct {
+		dialect *Dialect
+		want    string
+	}{
+		{Postgres, `"my ""table"""`},
+		{MySQL, "`my \"table\"`"},
+		{SQLite, `"my ""table"""`},
+		{ClickHouse, "`my \"table\"`"},
+		{BigQuery, "`my \"table\"`"},
+	}
+	for _, tt := range tests {
+		if got := tt.dialect.Ident(`my "table"`); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.dialect.Name, got, tt.want)
+		}
+	}
+	if got := MySQL.Ident("a`b"); got != "`a``b`" {
+		t.Errorf("got %s", got)
+	}
+	if got := BigQuery.Ident("a`b"); got != "`a\\`b`" {
+		t.Errorf("got %s", got)
+	}
+	if got := Postgres.QualifiedIdent("public", "users"); got != `"public"."users"` {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestLiteral(t *testing.T) {
+	ts := time.Date(2024, 3, 4, 5, 6, 7, 500000000, time.FixedZone("", 3600))
+	tests := []struct {
+		dialect *Dialect
+		value   any
+		want    string
+	}{
+		{Postgres, "it's", `'it''s'`},
+		{Postgres, `a\b`, `'a\b'`},
+		{MySQL, "it's\n\\", `'it\'s\n\\'`},
+		{ClickHouse, "it's\x00", `'it\'s\x00'`},
+		{BigQuery, "it's", `'it\'s'`},
+		{Postgres, []byte{0xde, 0xad}, `'\xdead'`},
+		{MySQL, []byte{0xde, 0xad}, `X'dead'`},
+		{ClickHouse, []byte{0xde, 0xad}, `'\xde\xad'`},
+		{BigQuery, []byte{0xde, 0xad}, `b'\xde\xad'`},
+		{SQLite, true, "1"},
+		{Postgres, false, "FALSE"},
+		{Postgres, nil, "NULL"},
+		{Postgres, sql.NullInt64{}, "NULL"},
+		{Postgres, sql.NullInt64{Int64: 42, Valid: true}, "42"},
+		{Postgres, (*int)(nil), "NULL"},
+		{Postgres, uint8(7), "7"},
+		{Postgres, -1.5, "-1.5"},
+		{Postgres, math.Inf(1), "'+Inf'"},
+		{Postgres, ts, "'2024-03-04 05:06:07.5+01:00'"},
+		{MySQL, ts, "'2024-03-04 05:06:07.5'"},
+		{BigQuery, ts, "TIMESTAMP '2024-03-04 05:06:07.5+01:00'"},
+	}
+	for _, tt := range tests {
+		if got := tt.dialect.Literal(tt.value); got != tt.want {
+			t.Errorf("%s: Literal(%#v) = %s, want %s", tt.dialect.Name, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	if d, ok := Lookup("Postgres"); !ok || d != Postgres {
+		t.Errorf("got %v, %v for postgres", d, ok)
+	}
+	oracle := &Dialect{
+		Name:   "oracle",
+		Ident:  DoubleQuotes,
+		String: SingleQuotes,
+		Bytes:  func(b []byte) string { return "HEXTORAW('" + hex.EncodeToString(b) + "')" },
+		Time:   func(t time.Time) string { return "TIMESTAMP '" + t.Format("2006-01-02 15:04:05") + "'" },
+		True:   "1",
+		False:  "0",
+	}
+	Register(oracle)
+	if d, ok := Lookup("oracle"); !ok || d.Literal(true) != "1" {
+		t.Errorf("registered dialect not found: %v, %v", d, ok)
+	}
+	want := []string{"bigquery", "clickhouse", "mysql", "oracle", "postgres", "sqlite"}
+	if got := Names(); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got names %v, want %v", got, want)
+	}
+}
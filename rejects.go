@@ -0,0 +1,145 @@
+// This file implements writing rows that fail conversion to a separate rejects output.
+
+package exporter
+
+import (
+	"errors"
+	"io"
+	"reflect"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// WithRejects writes rows that fail with a *scanner.RowError, such as a failed
+// column converter or a Cast conversion error, to writer instead of aborting the
+// export. The rejected rows are encoded with the same codec and carry their source
+// values and an additional column named errorColumn holding the error message.
+// They are left out of the main output and counted in Stats.Rejected.
+func WithRejects(writer io.Writer, errorColumn string) Option {
+	return func(e *Exporter) {
+		e.rejects = writer
+		e.rejectsColumn = errorColumn
+	}
+}
+
+// rejectRows wraps a Rows and diverts rows failing with a *scanner.RowError to a
+// second codec, which reads them from a Rows fed like a shard.
+type rejectRows struct {
+	scanner.Rows
+
+	exporter *Exporter
+	columns  []scanner.Column
+	rejects  *shardRows
+	done     chan error
+	rejected int64
+
+	current []any
+	err     error
+}
+
+// Columns returns the column metadata and starts the codec of the rejects output.
+func (r *rejectRows) Columns() ([]scanner.Column, error) {
+	if r.columns != nil {
+		return r.columns, nil
+	}
+	cols, err := r.Rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	rejectCols := append(cols[:len(cols):len(cols)], &errorColumn{index: len(cols), name: r.exporter.rejectsColumn})
+	r.rejects = newShardRows(rejectCols, r.Rows.Driver(), true)
+	r.done = make(chan error, 1)
+	go func() {
+		defer close(r.rejects.done)
+		r.done <- r.exporter.codec.Write(r.exporter.withNullPolicy(r.rejects), r.exporter.rejects)
+	}()
+	r.columns = cols
+	return cols, nil
+}
+
+// Next advances to the next row that does not fail, rejecting the failing rows.
+func (r *rejectRows) Next() bool {
+	if r.columns == nil {
+		if _, err := r.Columns(); err != nil {
+			r.err = err
+			return true // The error is reported by ScanRow.
+		}
+	}
+	for r.Rows.Next() {
+		row, err := r.Rows.ScanRow()
+		var rowErr *scanner.RowError
+		if errors.As(err, &rowErr) {
+			r.rejected++
+			r.rejects.send(append(scanner.CloneRow(rowErr.Row), err.Error()))
+			continue
+		}
+		r.current, r.err = row, err
+		return true
+	}
+	return false
+}
+
+// ScanRow returns the current row.
+func (r *rejectRows) ScanRow() ([]any, error) {
+	return r.current, r.err
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (r *rejectRows) RawValue(column int) (any, bool) {
+	return scanner.RawValue(r.Rows, column)
+}
+
+// EstimateRows returns the row estimate of the underlying rows.
+func (r *rejectRows) EstimateRows() (int64, bool) {
+	return scanner.EstimateRows(r.Rows)
+}
+
+// finish ends the rejects output and returns the error of its codec.
+func (r *rejectRows) finish() error {
+	if r.rejects == nil {
+		return nil
+	}
+	close(r.rejects.rows)
+	return <-r.done
+}
+
+// errorColumn describes the error message column of the rejects output.
+type errorColumn struct {
+	index int
+	name  string
+}
+
+// Index returns the column index.
+func (c *errorColumn) Index() int {
+	return c.index
+}
+
+// Name returns the column name.
+func (c *errorColumn) Name() string {
+	return c.name
+}
+
+// Length returns 0 and false, indicating unknown length.
+func (c *errorColumn) Length() (int64, bool) {
+	return 0, false
+}
+
+// DecimalSize returns 0 and false, as the column is not a decimal.
+func (c *errorColumn) DecimalSize() (int64, int64, bool) {
+	return 0, 0, false
+}
+
+// ScanType returns the string type.
+func (c *errorColumn) ScanType() reflect.Type {
+	return reflect.TypeOf("")
+}
+
+// Nullable returns false, as every rejected row has an error message.
+func (c *errorColumn) Nullable() (bool, bool) {
+	return false, true
+}
+
+// DatabaseTypeName returns TEXT.
+func (c *errorColumn) DatabaseTypeName() string {
+	return "TEXT"
+}
@@ -0,0 +1,168 @@
+// Package report composes several exports into a single HTML report with a
+// title page, a table of contents and one section per data source, e.g. for a
+// weekly operations report. Section tables are written by the HTML codec.
+package report
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"time"
+
+	htmlcodec "github.com/go-data-exporter/exporter/codec/html"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// Report is an HTML report of several sections.
+type Report struct {
+	title        string
+	description  string
+	generatedAt  time.Time
+	contents     bool
+	tableOptions []htmlcodec.Option
+	sections     []section
+}
+
+// section is a titled table of a report.
+type section struct {
+	title string
+	rows  scanner.Rows
+	opts  []htmlcodec.Option
+}
+
+// Option defines a functional option for configuring a Report.
+type Option func(*Report)
+
+// New creates a report with the given title and options. By default the report
+// has a table of contents.
+func New(title string, opts ...Option) *Report {
+	r := &Report{title: title, contents: true}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// WithDescription adds a paragraph of text below the title.
+func WithDescription(text string) Option {
+	return func(r *Report) {
+		r.description = text
+	}
+}
+
+// WithGeneratedAt shows the time the report was generated below the title.
+func WithGeneratedAt(t time.Time) Option {
+	return func(r *Report) {
+		r.generatedAt = t
+	}
+}
+
+// WithTableOfContents controls whether a table of contents linking to the
+// sections follows the title (default true).
+func WithTableOfContents(contents bool) Option {
+	return func(r *Report) {
+		r.contents = contents
+	}
+}
+
+// WithTableOptions sets HTML codec options for the tables of all sections and the
+// style of the report, e.g. htmlcodec.WithTheme. Options of AddSection are applied
+// after them. The report is always written in UTF-8, so htmlcodec.WithCharset must
+// not be used.
+func WithTableOptions(opts ...htmlcodec.Option) Option {
+	return func(r *Report) {
+		r.tableOptions = opts
+	}
+}
+
+// AddSection adds a section with the given title showing the table of rows.
+// The options apply to its table only, e.g. htmlcodec.WithLimit. Rows are closed
+// once the report is written if they implement io.Closer.
+func (r *Report) AddSection(title string, rows scanner.Rows, opts ...htmlcodec.Option) *Report {
+	r.sections = append(r.sections, section{title: title, rows: rows, opts: opts})
+	return r
+}
+
+// Write writes the report as a single HTML document. The rows of all sections are
+// closed, including those not written because of an error.
+func (r *Report) Write(w io.Writer) (err error) {
+	defer func() {
+		for _, s := range r.sections {
+			if closeErr := scanner.Close(s.rows); err == nil {
+				err = closeErr
+			}
+		}
+	}()
+	if _, err := io.WriteString(w, r.head()); err != nil {
+		return err
+	}
+	for i, s := range r.sections {
+		_, err := fmt.Fprintf(w, `<section id="%s"><h2>%s</h2>`, sectionID(i), html.EscapeString(s.title))
+		if err != nil {
+			return err
+		}
+		opts := append(append(r.tableOptions[:len(r.tableOptions):len(r.tableOptions)], s.opts...), htmlcodec.WithFragment(true))
+		if err := htmlcodec.New(opts...).Write(s.rows, w); err != nil {
+			return fmt.Errorf("report: section %q: %w", s.title, err)
+		}
+		if _, err := io.WriteString(w, `</section>`); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(w, `</body></html>`)
+	return err
+}
+
+// WriteFile writes the report to the named file. The file is removed if writing fails.
+func (r *Report) WriteFile(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	err = r.Write(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Join(err, os.Remove(filename))
+	}
+	return nil
+}
+
+// head returns the beginning of the document up to the first section: the style,
+// the title page and the table of contents.
+func (r *Report) head() string {
+	out := `<!DOCTYPE html><html><head><meta charset="utf-8"><title>` + html.EscapeString(r.title) +
+		`</title><style> ` + htmlcodec.Stylesheet(r.tableOptions...) + ` ` + reportCSS + ` </style></head><body>`
+	out += `<header class="report-title"><h1>` + html.EscapeString(r.title) + `</h1>`
+	if r.description != "" {
+		out += `<p>` + html.EscapeString(r.description) + `</p>`
+	}
+	if !r.generatedAt.IsZero() {
+		out += `<p class="generated">Generated ` + r.generatedAt.Format(time.RFC1123) + `</p>`
+	}
+	out += `</header>`
+	if r.contents && len(r.sections) != 0 {
+		out += `<nav class="contents"><h2>Contents</h2><ol>`
+		for i, s := range r.sections {
+			out += fmt.Sprintf(`<li><a href="#%s">%s</a></li>`, sectionID(i), html.EscapeString(s.title))
+		}
+		out += `</ol></nav>`
+	}
+	return out
+}
+
+// sectionID returns the anchor of the i-th section.
+func sectionID(i int) string {
+	return fmt.Sprintf("section-%d", i+1)
+}
+
+// reportCSS styles the title page, the table of contents and the section headings.
+const reportCSS = `header.report-title { padding: 40px 15px 20px; } ` +
+	`header.report-title h1 { font-size: 28px; margin-bottom: 10px; } ` +
+	`header.report-title p { color: #555; margin-top: 5px; } ` +
+	`nav.contents { padding: 0 15px 20px; } nav.contents ol { margin-left: 25px; } ` +
+	`nav.contents li { padding: 2px 0; } ` +
+	`section h2 { padding: 30px 15px 10px; font-size: 20px; }`
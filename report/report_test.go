@@ -0,0 +1,43 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	htmlcodec "github.com/go-data-exporter/exporter/codec/html"
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+func TestReport(t *testing.T) {
+	generated := time.Date(2024, 5, 6, 7, 0, 0, 0, time.UTC)
+	r := New("Weekly <ops>", WithDescription("Incidents and deploys"), WithGeneratedAt(generated),
+		WithTableOptions(htmlcodec.WithTheme(htmlcodec.ThemeStriped)))
+	r.AddSection("Incidents", scanner.FromData([][]any{{"db down"}})).
+		AddSection("Deploys", scanner.FromData([][]any{{"v1"}, {"v2"}}), htmlcodec.WithLimit(1))
+	var buf bytes.Buffer
+	if err := r.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+	for _, want := range []string{
+		`<title>Weekly &lt;ops&gt;</title>`,
+		`<p>Incidents and deploys</p>`,
+		`Generated Mon, 06 May 2024 07:00:00 UTC`,
+		`<li><a href="#section-1">Incidents</a></li><li><a href="#section-2">Deploys</a></li>`,
+		`<section id="section-1"><h2>Incidents</h2><table`,
+		`<td>db down</td>`,
+		`<td>v1</td>`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("report does not contain %s:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "v2") {
+		t.Error("section options were not applied")
+	}
+	if strings.Count(output, "<html>") != 1 || !strings.HasSuffix(output, "</section></body></html>") {
+		t.Errorf("report is not a single document:\n%s", output)
+	}
+}
@@ -0,0 +1,170 @@
+// This file implements a destination that retries failed deliveries from a local spool file.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Default retry policy of RetryDestination.
+const (
+	defaultDeliveryRetries = 3
+	defaultDeliveryBackoff = time.Second
+)
+
+// RetryOption defines a functional option for configuring RetryDestination.
+type RetryOption func(*retryDestination)
+
+// WithDeliveryRetries sets how often RetryDestination retries a failed delivery
+// (default 3), waiting backoff before the first retry (default 1s) and twice as
+// long before every further retry.
+func WithDeliveryRetries(retries int, backoff time.Duration) RetryOption {
+	return func(d *retryDestination) {
+		d.retries = retries
+		d.backoff = backoff
+	}
+}
+
+// WithSpoolDir sets the directory of the spool file of RetryDestination (default os.TempDir).
+func WithSpoolDir(dir string) RetryOption {
+	return func(d *retryDestination) {
+		d.dir = dir
+	}
+}
+
+// RetryDestination returns a Destination that delivers the export to the
+// destination returned by open, such as an upload, and survives its failure
+// without re-running the export. The export is written to the destination as it
+// is produced and spooled to a local temporary file at the same time. If opening,
+// writing to or closing the destination fails, the destination is aborted, the
+// rest of the export is only spooled, and once the export is complete the delivery
+// is retried from the spool file as configured with WithDeliveryRetries: every
+// retry opens a new destination with open and delivers the complete export, since
+// destinations cannot resume a partial delivery. Waiting between retries stops
+// when ctx is done. The spool file is removed on Close and Abort.
+func RetryDestination(ctx context.Context, open func() (Destination, error), opts ...RetryOption) (Destination, error) {
+	d := &retryDestination{
+		ctx:     ctx,
+		open:    open,
+		retries: defaultDeliveryRetries,
+		backoff: defaultDeliveryBackoff,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	f, err := os.CreateTemp(d.dir, "exporter-spool-*")
+	if err != nil {
+		return nil, err
+	}
+	d.spool = f
+	if d.dest, d.failure = open(); d.failure != nil {
+		d.dest = nil
+	}
+	return d, nil
+}
+
+// retryDestination writes an export to a destination and a spool file, and
+// redelivers the spool file if the destination fails.
+type retryDestination struct {
+	ctx     context.Context
+	open    func() (Destination, error)
+	retries int
+	backoff time.Duration
+	dir     string
+
+	spool   *os.File
+	dest    Destination // The destination receiving the export, nil after a failure.
+	failure error       // The error of the first failed delivery.
+}
+
+// Write spools p and writes it to the destination unless it failed before. Only a
+// failure of the spool file fails the write.
+func (d *retryDestination) Write(p []byte) (int, error) {
+	if _, err := d.spool.Write(p); err != nil {
+		return 0, fmt.Errorf("exporter: failed to spool export: %w", err)
+	}
+	if d.dest != nil {
+		n, err := d.dest.Write(p)
+		if err == nil && n != len(p) {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			d.fail(err)
+		}
+	}
+	return len(p), nil
+}
+
+// fail aborts the destination after a failed delivery.
+func (d *retryDestination) fail(err error) {
+	abort(d.dest, err)
+	d.dest, d.failure = nil, err
+}
+
+// abort aborts dest if it implements Aborter and closes it otherwise.
+func abort(dest Destination, err error) error {
+	if aborter, ok := dest.(Aborter); ok {
+		return aborter.Abort(err)
+	}
+	return dest.Close()
+}
+
+// Close finalizes the destination, or retries the delivery from the spool file if
+// the destination failed, and removes the spool file.
+func (d *retryDestination) Close() error {
+	defer os.Remove(d.spool.Name())
+	defer d.spool.Close()
+	if d.dest != nil {
+		err := d.dest.Close()
+		if err == nil {
+			return nil
+		}
+		d.dest, d.failure = nil, err
+	}
+	backoff := d.backoff
+	for attempt := 1; attempt <= d.retries; attempt++ {
+		select {
+		case <-time.After(backoff):
+		case <-d.ctx.Done():
+			return errors.Join(d.failure, d.ctx.Err())
+		}
+		backoff *= 2
+		if err := d.redeliver(); err != nil {
+			d.failure = err
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("exporter: delivery failed after %d retries: %w", d.retries, d.failure)
+}
+
+// redeliver delivers the spool file to a new destination.
+func (d *retryDestination) redeliver() error {
+	if _, err := d.spool.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	dest, err := d.open()
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dest, d.spool); err != nil {
+		return errors.Join(err, abort(dest, err))
+	}
+	return dest.Close()
+}
+
+// Abort aborts the destination and removes the spool file.
+func (d *retryDestination) Abort(err error) error {
+	var abortErr error
+	if d.dest != nil {
+		abortErr = abort(d.dest, err)
+		d.dest = nil
+	}
+	d.spool.Close()
+	return errors.Join(abortErr, os.Remove(d.spool.Name()))
+}
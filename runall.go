@@ -0,0 +1,250 @@
+// This file implements the concurrent execution of many export jobs.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// Job is a single export of RunAll, e.g. one table of a nightly batch.
+type Job struct {
+	// Name identifies the job in the report and in errors.
+	Name string
+
+	// Open returns the export of the job once it is started, so that queries are
+	// only opened while a worker is available. The builder must have a codec and
+	// destinations; the context is the context of the run.
+	Open func(ctx context.Context) (*Builder, error)
+}
+
+// JobResult is the outcome of a single job of RunAll.
+type JobResult struct {
+	Name     string
+	Stats    Stats
+	Err      error         // The error of the job, nil on success.
+	Skipped  bool          // Whether the job was not started because the run was stopped.
+	Duration time.Duration // Time from opening the job to finalizing its destinations.
+}
+
+// RunReport aggregates the results of RunAll.
+type RunReport struct {
+	Jobs []JobResult // Results in the order of the jobs.
+
+	Rows     int64 // Total rows of all jobs.
+	Bytes    int64 // Total bytes of all jobs.
+	Rejected int64 // Total rejected rows of all jobs.
+	Failed   int   // Number of failed jobs.
+	Skipped  int   // Number of jobs that were not started.
+
+	Duration time.Duration
+}
+
+// JobError reports the failure of a single job of RunAll.
+type JobError struct {
+	Name string // Name of the job.
+	Err  error  // The error returned by the job.
+}
+
+// Error implements the error interface.
+func (e *JobError) Error() string {
+	return fmt.Sprintf("job %s: %s", e.Name, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *JobError) Unwrap() error {
+	return e.Err
+}
+
+// RunOption configures RunAll.
+type RunOption func(*runner)
+
+// WithFailFast stops the run on the first failed job: the context of the running
+// jobs is canceled and the remaining jobs are skipped. By default the other jobs
+// continue and every failure is reported.
+func WithFailFast(failFast bool) RunOption {
+	return func(r *runner) {
+		r.failFast = failFast
+	}
+}
+
+// WithRowRate limits the rows read by all jobs together to rowsPerSecond, to keep
+// the load on shared databases predictable however many jobs run at once. A
+// non-positive rate means no limit (default).
+func WithRowRate(rowsPerSecond float64) RunOption {
+	return func(r *runner) {
+		r.limiter = nil
+		if rowsPerSecond > 0 {
+			r.limiter = &rowLimiter{interval: time.Duration(float64(time.Second) / rowsPerSecond)}
+		}
+	}
+}
+
+// runner holds the configuration of RunAll.
+type runner struct {
+	failFast bool
+	limiter  *rowLimiter
+}
+
+// RunAll executes jobs with up to parallelism jobs at a time, starting them in
+// order, and reports the results of all of them. A non-positive parallelism runs
+// one job at a time. The returned error joins a *JobError for every failed job, and
+// the error of ctx if it stopped the run; the report is returned in any case.
+func RunAll(ctx context.Context, jobs []Job, parallelism int, opts ...RunOption) (*RunReport, error) {
+	r := &runner{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	start := time.Now()
+	runCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	report := &RunReport{Jobs: make([]JobResult, len(jobs))}
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range min(max(parallelism, 1), len(jobs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				if runCtx.Err() != nil {
+					report.Jobs[i] = JobResult{Name: jobs[i].Name, Skipped: true}
+					continue
+				}
+				result := r.run(runCtx, jobs[i])
+				if result.Err != nil && r.failFast {
+					cancel(&JobError{Name: result.Name, Err: result.Err})
+				}
+				report.Jobs[i] = result
+			}
+		}()
+	}
+	for i, job := range jobs {
+		if runCtx.Err() == nil {
+			select {
+			case next <- i:
+				continue
+			case <-runCtx.Done():
+			}
+		}
+		report.Jobs[i] = JobResult{Name: job.Name, Skipped: true}
+	}
+	close(next)
+	wg.Wait()
+
+	var errs []error
+	for _, result := range report.Jobs {
+		switch {
+		case result.Skipped:
+			report.Skipped++
+		case result.Err != nil:
+			report.Failed++
+			errs = append(errs, &JobError{Name: result.Name, Err: result.Err})
+		}
+		report.Rows += result.Stats.Rows
+		report.Bytes += result.Stats.Bytes
+		report.Rejected += result.Stats.Rejected
+	}
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	report.Duration = time.Since(start)
+	return report, errors.Join(errs...)
+}
+
+// run opens and executes a single job.
+func (r *runner) run(ctx context.Context, job Job) JobResult {
+	start := time.Now()
+	result := JobResult{Name: job.Name}
+	b, err := job.Open(ctx)
+	if err == nil {
+		rows := b.rows
+		if r.limiter != nil {
+			rows = &rateRows{Rows: rows, ctx: ctx, limiter: r.limiter}
+		}
+		result.Stats, err = b.run(ctx, rows)
+	}
+	result.Err = err
+	result.Duration = time.Since(start)
+	return result
+}
+
+// minRateWait is the shortest wait of rowLimiter. Shorter waits are deferred and
+// added up, so that high rates do not arm a timer for every row.
+const minRateWait = 10 * time.Millisecond
+
+// rowLimiter spaces rows evenly, shared by all jobs of a run.
+type rowLimiter struct {
+	interval time.Duration // Time per row.
+
+	mu   sync.Mutex
+	next time.Time // Time at which the next row may be read.
+}
+
+// wait blocks until the next row may be read or ctx is done.
+func (l *rowLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	at := l.next
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+	d := at.Sub(now)
+	if d < minRateWait {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// rateRows wraps a Rows and waits for a rowLimiter before every row.
+type rateRows struct {
+	scanner.Rows
+	ctx     context.Context
+	limiter *rowLimiter
+	err     error
+}
+
+// Next waits for the limiter and advances to the next row.
+func (r *rateRows) Next() bool {
+	if r.err = r.limiter.wait(r.ctx); r.err != nil {
+		return false
+	}
+	return r.Rows.Next()
+}
+
+// Err returns the error of the underlying rows, or the error of the context if
+// it ended a wait.
+func (r *rateRows) Err() error {
+	if err := r.Rows.Err(); err != nil {
+		return err
+	}
+	return r.err
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (r *rateRows) RawValue(column int) (any, bool) {
+	return scanner.RawValue(r.Rows, column)
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (r *rateRows) Close() error {
+	return scanner.Close(r.Rows)
+}
+
+// EstimateRows returns the row estimate of the underlying rows if they implement Counter.
+func (r *rateRows) EstimateRows() (int64, bool) {
+	return scanner.EstimateRows(r.Rows)
+}
@@ -0,0 +1,87 @@
+// This file implements cell truncation and sanitization of text values.
+
+package exporter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// WithMaxCellLength limits string and []byte values to n characters (runes).
+// Longer values are cut and the ellipsis is appended, so that the result including
+// the ellipsis does not exceed n characters. A non-positive n disables the limit.
+// []byte values that are not valid UTF-8, such as binary data, are not cut.
+func WithMaxCellLength(n int, ellipsis string) Option {
+	return func(e *Exporter) {
+		e.maxCellLength = n
+		e.ellipsis = ellipsis
+	}
+}
+
+// WithSanitize cleans string and []byte values before they reach the codec.
+// If stripControlChars is true, control characters other than tab, line feed and
+// carriage return are removed. If normalizeNewlines is true, CRLF and CR line
+// endings are converted to LF. Sanitization runs before truncation. []byte values
+// that are not valid UTF-8, such as binary data, are left unchanged.
+func WithSanitize(stripControlChars, normalizeNewlines bool) Option {
+	return func(e *Exporter) {
+		e.stripControlChars = stripControlChars
+		e.normalizeNewlines = normalizeNewlines
+	}
+}
+
+// cleanCell sanitizes and truncates text values; other values, including []byte
+// values that are not valid UTF-8, are returned unchanged.
+func (cs *Exporter) cleanCell(v any, _ scanner.Metadata) (any, error) {
+	switch s := v.(type) {
+	case string:
+		return cs.cleanString(s), nil
+	case []byte:
+		if !utf8.Valid(s) {
+			return v, nil
+		}
+		if cleaned := cs.cleanString(string(s)); len(cleaned) != len(s) || cleaned != string(s) {
+			return []byte(cleaned), nil
+		}
+	}
+	return v, nil
+}
+
+// hasCellCleaning reports whether any sanitization or truncation option is enabled.
+func (cs *Exporter) hasCellCleaning() bool {
+	return cs.maxCellLength > 0 || cs.stripControlChars || cs.normalizeNewlines
+}
+
+// cleanString applies the configured sanitization and truncation to s.
+func (cs *Exporter) cleanString(s string) string {
+	if cs.normalizeNewlines && strings.Contains(s, "\r") {
+		s = strings.ReplaceAll(s, "\r\n", "\n")
+		s = strings.ReplaceAll(s, "\r", "\n")
+	}
+	if cs.stripControlChars {
+		s = strings.Map(func(r rune) rune {
+			if unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r' {
+				return -1
+			}
+			return r
+		}, s)
+	}
+	if cs.maxCellLength > 0 && utf8.RuneCountInString(s) > cs.maxCellLength {
+		keep := cs.maxCellLength - utf8.RuneCountInString(cs.ellipsis)
+		ellipsis := cs.ellipsis
+		if keep < 0 {
+			keep, ellipsis = cs.maxCellLength, ""
+		}
+		n := 0
+		for i := range s {
+			if n == keep {
+				return s[:i] + ellipsis
+			}
+			n++
+		}
+	}
+	return s
+}
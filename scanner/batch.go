@@ -0,0 +1,18 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines the optional batch scanning interface.
+package scanner
+
+// BatchScanner is an optional interface implemented by Rows that can read several
+// rows at once, amortizing the per-row interface calls of Next and ScanRow.
+// Consumers check for it with a type assertion and fall back to Next and ScanRow.
+type BatchScanner interface {
+	Rows
+
+	// ScanBatch advances over up to len(dst) rows and stores their values in dst,
+	// reusing the existing slices in dst when they have the right length.
+	// It returns the number of rows read; 0 with a nil error means that no more
+	// rows are available and Err should be checked. If an error is returned, the
+	// first n rows of dst are still valid. ScanBatch must not be mixed with Next
+	// and ScanRow during the same iteration.
+	ScanBatch(dst [][]any) (n int, err error)
+}
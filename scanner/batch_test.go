@@ -0,0 +1,35 @@
+package scanner
+
+import "testing"
+
+func TestScanBatch(t *testing.T) {
+	rows := FromData([][]any{{1, "a"}, {2, "b"}, {3, "c"}}).(BatchScanner)
+	dst := make([][]any, 2)
+
+	n, err := rows.ScanBatch(dst)
+	if err != nil || n != 2 {
+		t.Fatalf("first batch: n = %d, err = %v", n, err)
+	}
+	if dst[0][0] != 1 || dst[1][1] != "b" {
+		t.Errorf("unexpected first batch %v", dst)
+	}
+	n, err = rows.ScanBatch(dst)
+	if err != nil || n != 1 || dst[0][0] != 3 {
+		t.Fatalf("second batch: n = %d, err = %v, rows %v", n, err, dst[:n])
+	}
+	if n, err = rows.ScanBatch(dst); err != nil || n != 0 {
+		t.Fatalf("exhausted: n = %d, err = %v", n, err)
+	}
+}
+
+func TestScanBatchError(t *testing.T) {
+	rows := FromData([][]any{{1, "a"}, {2}}).(BatchScanner)
+	dst := make([][]any, 4)
+	n, err := rows.ScanBatch(dst)
+	if err == nil {
+		t.Fatal("expected an error for a short row")
+	}
+	if n != 1 || dst[0][0] != 1 {
+		t.Errorf("expected the valid row before the error, got n = %d", n)
+	}
+}
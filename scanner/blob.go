@@ -0,0 +1,16 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines the cell value used to stream large objects.
+package scanner
+
+import "io"
+
+// Blob is a cell value holding a large object, such as a BLOB attachment, that is
+// streamed instead of being loaded into memory. Scanners return a Blob rather than
+// a []byte for values too large to buffer; NULL values are still returned as nil.
+//
+// The reader is consumed at most once, before the next call to Next. The CSV and
+// JSON codecs stream it as base64; codecs that cannot stream read it fully, and
+// exporter.WithBlobFiles writes it to a file of its own.
+type Blob struct {
+	io.Reader
+}
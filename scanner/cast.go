@@ -0,0 +1,331 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines a Rows wrapper that casts string-ish values to declared target types.
+package scanner
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TargetType identifies the Go type a column is converted to by Cast.
+type TargetType int
+
+const (
+	// TypeString converts values to string.
+	TypeString TargetType = iota
+	// TypeInt64 converts values to int64.
+	TypeInt64
+	// TypeFloat64 converts values to float64.
+	TypeFloat64
+	// TypeTime converts values to time.Time using the configured layouts.
+	TypeTime
+	// TypeBool converts values to bool using the configured literals.
+	TypeBool
+)
+
+// String returns the name of the target type.
+func (t TargetType) String() string {
+	switch t {
+	case TypeString:
+		return "string"
+	case TypeInt64:
+		return "int64"
+	case TypeFloat64:
+		return "float64"
+	case TypeTime:
+		return "time"
+	case TypeBool:
+		return "bool"
+	}
+	return fmt.Sprintf("TargetType(%d)", int(t))
+}
+
+// reflectType returns the Go type produced by casting to t.
+func (t TargetType) reflectType() reflect.Type {
+	switch t {
+	case TypeInt64:
+		return reflect.TypeOf(int64(0))
+	case TypeFloat64:
+		return reflect.TypeOf(float64(0))
+	case TypeTime:
+		return reflect.TypeOf(time.Time{})
+	case TypeBool:
+		return reflect.TypeOf(false)
+	}
+	return reflect.TypeOf("")
+}
+
+// CastErrorPolicy defines what Cast does with a value that cannot be converted.
+type CastErrorPolicy int
+
+const (
+	// CastErrorFail returns the conversion error from ScanRow as a *RowError (default).
+	CastErrorFail CastErrorPolicy = iota
+	// CastErrorNULL replaces values that cannot be converted with NULL.
+	CastErrorNULL
+	// CastErrorKeep passes values that cannot be converted through unchanged.
+	CastErrorKeep
+)
+
+// CastOption defines a functional option for configuring Cast.
+type CastOption func(*castRowsScanner)
+
+// WithTimeLayouts sets the layouts tried, in order, when parsing TypeTime values.
+// The default layouts are time.RFC3339Nano, time.DateTime and time.DateOnly.
+func WithTimeLayouts(layouts ...string) CastOption {
+	return func(c *castRowsScanner) {
+		c.timeLayouts = layouts
+	}
+}
+
+// WithTimeLocation sets the location used for time values without zone information.
+// The default location is time.UTC.
+func WithTimeLocation(loc *time.Location) CastOption {
+	return func(c *castRowsScanner) {
+		c.location = loc
+	}
+}
+
+// WithBoolLiterals sets the case-insensitive literals recognized as true and false
+// when casting to TypeBool. The literals accepted by strconv.ParseBool are always recognized.
+func WithBoolLiterals(trueValues, falseValues []string) CastOption {
+	return func(c *castRowsScanner) {
+		for _, v := range trueValues {
+			c.boolLiterals[strings.ToLower(v)] = true
+		}
+		for _, v := range falseValues {
+			c.boolLiterals[strings.ToLower(v)] = false
+		}
+	}
+}
+
+// WithCastErrorPolicy sets how values that cannot be converted are handled.
+func WithCastErrorPolicy(policy CastErrorPolicy) CastOption {
+	return func(c *castRowsScanner) {
+		c.errorPolicy = policy
+	}
+}
+
+// castRowsScanner wraps a Rows and converts the values of selected columns
+// into their declared target types.
+type castRowsScanner struct {
+	Rows
+
+	schema       map[string]TargetType
+	timeLayouts  []string
+	location     *time.Location
+	boolLiterals map[string]bool
+	errorPolicy  CastErrorPolicy
+
+	columns []Column
+	targets []*TargetType
+	row     []any
+	raw     []any
+	rowID   int
+}
+
+// Cast wraps rows so that the values of the columns named in schema are converted
+// to the given target types. It is intended for sources that deliver string-ish
+// values (CSV, Hive) feeding codecs that need correctly typed values.
+//
+// Empty strings are converted to NULL for every target type except TypeString.
+// Columns not present in schema are passed through unchanged.
+func Cast(rows Rows, schema map[string]TargetType, opts ...CastOption) Rows {
+	c := &castRowsScanner{
+		Rows:         rows,
+		schema:       schema,
+		timeLayouts:  []string{time.RFC3339Nano, time.DateTime, time.DateOnly},
+		location:     time.UTC,
+		boolLiterals: make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Columns returns the column metadata of the underlying rows. Cast columns
+// report the Go type of their target type as ScanType.
+func (c *castRowsScanner) Columns() ([]Column, error) {
+	if c.columns != nil {
+		return c.columns, nil
+	}
+	cols, err := c.Rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool, len(c.schema))
+	c.targets = make([]*TargetType, len(cols))
+	c.columns = make([]Column, len(cols))
+	for i, col := range cols {
+		c.columns[i] = col
+		if target, ok := c.schema[col.Name()]; ok {
+			c.targets[i] = &target
+			c.columns[i] = &castColumn{Column: col, target: target}
+			found[col.Name()] = true
+		}
+	}
+	for name := range c.schema {
+		if !found[name] {
+			c.columns, c.targets = nil, nil
+			return nil, fmt.Errorf("cast: unknown column %q", name)
+		}
+	}
+	return c.columns, nil
+}
+
+// ScanRow returns the current row with the declared columns converted.
+// The returned slice is reused between calls.
+func (c *castRowsScanner) ScanRow() ([]any, error) {
+	if c.targets == nil {
+		if _, err := c.Columns(); err != nil {
+			return nil, err
+		}
+	}
+	values, err := c.Rows.ScanRow()
+	if err != nil {
+		return nil, err
+	}
+	c.rowID++
+	c.raw = values
+	if len(c.row) != len(values) {
+		c.row = make([]any, len(values))
+	}
+	for i, v := range values {
+		c.row[i] = v
+		if i >= len(c.targets) || c.targets[i] == nil || v == nil {
+			continue
+		}
+		converted, err := c.convert(v, *c.targets[i])
+		if err == nil {
+			c.row[i] = converted
+			continue
+		}
+		switch c.errorPolicy {
+		case CastErrorNULL:
+			c.row[i] = nil
+		case CastErrorKeep:
+		default:
+			return nil, &RowError{Row: values, Err: fmt.Errorf("cast column %q row %d: %w", c.columns[i].Name(), c.rowID, err)}
+		}
+	}
+	return c.row, nil
+}
+
+// RawValue returns the raw value of the underlying rows if they have one, and the
+// value before the cast otherwise.
+func (c *castRowsScanner) RawValue(column int) (any, bool) {
+	if v, ok := RawValue(c.Rows, column); ok {
+		return v, true
+	}
+	if column < 0 || column >= len(c.raw) {
+		return nil, false
+	}
+	return c.raw[column], true
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (c *castRowsScanner) Close() error {
+	return Close(c.Rows)
+}
+
+// EstimateRows returns the row estimate of the underlying rows if they implement Counter.
+func (c *castRowsScanner) EstimateRows() (int64, bool) {
+	return EstimateRows(c.Rows)
+}
+
+// convert converts a single non-nil value to the target type.
+func (c *castRowsScanner) convert(v any, target TargetType) (any, error) {
+	switch target {
+	case TypeString:
+		return castToString(v), nil
+	case TypeInt64:
+		switch v := v.(type) {
+		case int64:
+			return v, nil
+		case int:
+			return int64(v), nil
+		case int32:
+			return int64(v), nil
+		case float64:
+			if v != float64(int64(v)) {
+				return nil, fmt.Errorf("%v is not an integer", v)
+			}
+			return int64(v), nil
+		}
+		s := strings.TrimSpace(castToString(v))
+		if s == "" {
+			return nil, nil
+		}
+		return strconv.ParseInt(s, 10, 64)
+	case TypeFloat64:
+		switch v := v.(type) {
+		case float64:
+			return v, nil
+		case float32:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case int:
+			return float64(v), nil
+		}
+		s := strings.TrimSpace(castToString(v))
+		if s == "" {
+			return nil, nil
+		}
+		return strconv.ParseFloat(s, 64)
+	case TypeTime:
+		if t, ok := v.(time.Time); ok {
+			return t, nil
+		}
+		s := strings.TrimSpace(castToString(v))
+		if s == "" {
+			return nil, nil
+		}
+		for _, layout := range c.timeLayouts {
+			if t, err := time.ParseInLocation(layout, s, c.location); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("cannot parse %q as time", s)
+	case TypeBool:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+		s := strings.TrimSpace(castToString(v))
+		if s == "" {
+			return nil, nil
+		}
+		if b, ok := c.boolLiterals[strings.ToLower(s)]; ok {
+			return b, nil
+		}
+		return strconv.ParseBool(s)
+	}
+	return nil, errors.New("unsupported target type " + target.String())
+}
+
+// castToString returns the textual form of a source value.
+func castToString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+	return fmt.Sprint(v)
+}
+
+// castColumn overrides the ScanType of a column converted by Cast.
+type castColumn struct {
+	Column
+	target TargetType
+}
+
+// ScanType returns the Go type of the cast target.
+func (c *castColumn) ScanType() reflect.Type {
+	return c.target.reflectType()
+}
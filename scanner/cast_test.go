@@ -0,0 +1,107 @@
+package scanner
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCast(t *testing.T) {
+	data := [][]any{
+		{"1", "2.5", "2024-01-02 03:04:05", "yes", "x"},
+		{"", "", "", "", "y"},
+	}
+	rows := Cast(FromData(data), map[string]TargetType{
+		"column_0": TypeInt64,
+		"column_1": TypeFloat64,
+		"column_2": TypeTime,
+		"column_3": TypeBool,
+	}, WithBoolLiterals([]string{"yes"}, []string{"no"}))
+
+	cols, err := rows.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typ := cols[0].ScanType().String(); typ != "int64" {
+		t.Errorf("unexpected scan type %s", typ)
+	}
+
+	if !rows.Next() {
+		t.Fatal("expected a row")
+	}
+	row, err := rows.ScanRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []any{int64(1), 2.5, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), true, "x"}
+	for i := range want {
+		if row[i] != want[i] {
+			t.Errorf("column %d: got %#v, want %#v", i, row[i], want[i])
+		}
+	}
+	if data[0][0] != "1" {
+		t.Error("source row was modified")
+	}
+
+	if !rows.Next() {
+		t.Fatal("expected a row")
+	}
+	row, err = rows.ScanRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range 4 {
+		if row[i] != nil {
+			t.Errorf("column %d: empty string should be NULL, got %#v", i, row[i])
+		}
+	}
+}
+
+func TestCastErrorPolicy(t *testing.T) {
+	data := [][]any{{"abc"}}
+	schema := map[string]TargetType{"column_0": TypeInt64}
+
+	rows := Cast(FromData(data), schema)
+	rows.Next()
+	var rowErr *RowError
+	if _, err := rows.ScanRow(); !errors.As(err, &rowErr) || rowErr.Row[0] != "abc" {
+		t.Errorf("expected a RowError with the source row, got %v", err)
+	}
+
+	rows = Cast(FromData(data), schema, WithCastErrorPolicy(CastErrorNULL))
+	rows.Next()
+	if row, err := rows.ScanRow(); err != nil || row[0] != nil {
+		t.Errorf("expected NULL, got %#v, %v", row, err)
+	}
+
+	rows = Cast(FromData(data), schema, WithCastErrorPolicy(CastErrorKeep))
+	rows.Next()
+	if row, err := rows.ScanRow(); err != nil || row[0] != "abc" {
+		t.Errorf("expected raw value, got %#v, %v", row, err)
+	}
+
+	rows = Cast(FromData(data), map[string]TargetType{"missing": TypeInt64})
+	if _, err := rows.Columns(); err == nil {
+		t.Error("expected unknown column error")
+	}
+}
+
+func TestCastRawValue(t *testing.T) {
+	rows := Select(Cast(FromData([][]any{{"1", "0.10000000000000000001"}}), map[string]TargetType{"column_1": TypeFloat64}), "column_1")
+	if !rows.Next() {
+		t.Fatal("expected a row")
+	}
+	row, err := rows.ScanRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row[0] != 0.1 {
+		t.Errorf("got %v, want the cast value", row[0])
+	}
+	if raw, ok := RawValue(rows, 0); !ok || raw != "0.10000000000000000001" {
+		t.Errorf("got raw value %v, %v, want the original text", raw, ok)
+	}
+	if _, ok := RawValue(FromData(nil), 0); ok {
+		t.Error("FromData reports raw values")
+	}
+}
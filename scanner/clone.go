@@ -0,0 +1,51 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines a Rows wrapper that hands out rows owned by the caller.
+package scanner
+
+// cloneRowsScanner wraps a Rows and returns a fresh copy of every row.
+type cloneRowsScanner struct {
+	Rows
+}
+
+// CloneRows wraps rows so that every row returned by ScanRow is a new slice owned
+// by the caller, which remains valid after subsequent calls to Next. It is meant
+// for wrappers that hold references to rows, such as prefetching or buffering
+// wrappers, on top of scanners that reuse their row slice.
+func CloneRows(rows Rows) Rows {
+	return &cloneRowsScanner{Rows: rows}
+}
+
+// ScanRow returns a copy of the current row of the underlying rows.
+func (c *cloneRowsScanner) ScanRow() ([]any, error) {
+	row, err := c.Rows.ScanRow()
+	if err != nil {
+		return nil, err
+	}
+	return CloneRow(row), nil
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (c *cloneRowsScanner) Close() error {
+	return Close(c.Rows)
+}
+
+// EstimateRows returns the row estimate of the underlying rows if they implement Counter.
+func (c *cloneRowsScanner) EstimateRows() (int64, bool) {
+	return EstimateRows(c.Rows)
+}
+
+// CloneRow returns a copy of row. Byte slices are copied as well, since drivers
+// may reuse their backing arrays; other values are copied shallowly.
+func CloneRow(row []any) []any {
+	if row == nil {
+		return nil
+	}
+	clone := make([]any, len(row))
+	for i, v := range row {
+		if b, ok := v.([]byte); ok && b != nil {
+			v = append([]byte(nil), b...)
+		}
+		clone[i] = v
+	}
+	return clone
+}
@@ -0,0 +1,37 @@
+package scanner
+
+import "testing"
+
+func TestCloneRows(t *testing.T) {
+	// reusingRows returns the same slice for every row, like the SQL scanner.
+	src := &reusingRows{Rows: FromData([][]any{{1, []byte("a")}, {2, []byte("b")}})}
+	rows := CloneRows(src)
+	var kept [][]any
+	for rows.Next() {
+		row, err := rows.ScanRow()
+		if err != nil {
+			t.Fatal(err)
+		}
+		kept = append(kept, row)
+	}
+	if kept[0][0] != 1 || string(kept[0][1].([]byte)) != "a" {
+		t.Errorf("first row was overwritten: %v", kept[0])
+	}
+}
+
+type reusingRows struct {
+	Rows
+	row []any
+}
+
+func (r *reusingRows) ScanRow() ([]any, error) {
+	row, err := r.Rows.ScanRow()
+	if err != nil {
+		return nil, err
+	}
+	if r.row == nil {
+		r.row = make([]any, len(row))
+	}
+	copy(r.row, row)
+	return r.row, nil
+}
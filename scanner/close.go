@@ -0,0 +1,15 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines the optional resource cleanup of Rows.
+package scanner
+
+import "io"
+
+// Close closes rows if it implements io.Closer, releasing the resources held by
+// the source such as SQL result sets or Hive cursors, and returns nil otherwise.
+// Rows wrappers in this package forward Close to the rows they wrap.
+func Close(rows Rows) error {
+	if closer, ok := rows.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -66,6 +66,11 @@ func (c *mockColumn) Nullable() (nullable, ok bool) {
 	return false, false
 }
 
+// CanonicalType returns the canonical type of the column's Go type.
+func (c *mockColumn) CanonicalType() Kind {
+	return goTypes[c.goType]
+}
+
 // DatabaseTypeName returns the string representation of the column's Go type.
 func (c *mockColumn) DatabaseTypeName() string {
 	return c.goType
@@ -0,0 +1,195 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines extended column metadata and its lookup in information_schema.
+package scanner
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// ColumnInfo holds extended metadata of a column that is not part of Column.
+// Fields are empty if the source does not provide them.
+type ColumnInfo struct {
+	Table      string // The name of the table the column belongs to.
+	Comment    string // The comment or description of the column.
+	Default    string // The default value expression, if HasDefault is set.
+	HasDefault bool   // Whether the column has a default value.
+	PrimaryKey bool   // Whether the column is part of the primary key.
+	Unique     bool   // Whether a unique constraint on this column alone makes its values unique.
+}
+
+// InfoColumn is an optional interface implemented by columns that provide
+// extended metadata.
+type InfoColumn interface {
+	Info() ColumnInfo
+}
+
+// Info returns the extended metadata of col if it implements InfoColumn.
+func Info(col Column) (ColumnInfo, bool) {
+	if c, ok := col.(InfoColumn); ok {
+		return c.Info(), true
+	}
+	return ColumnInfo{}, false
+}
+
+// infoRows attaches extended metadata to the columns of a Rows.
+type infoRows struct {
+	Rows
+	info    map[string]ColumnInfo
+	columns []Column
+}
+
+// WithColumnInfo wraps rows so that their columns implement InfoColumn, reporting
+// the metadata of info by column name, e.g. as returned by LookupColumnInfo.
+// Columns without an entry report an empty ColumnInfo.
+func WithColumnInfo(rows Rows, info map[string]ColumnInfo) Rows {
+	return &infoRows{Rows: rows, info: info}
+}
+
+// Columns returns the columns of the underlying rows with their metadata.
+func (r *infoRows) Columns() ([]Column, error) {
+	if r.columns != nil {
+		return r.columns, nil
+	}
+	cols, err := r.Rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	r.columns = make([]Column, len(cols))
+	for i, col := range cols {
+		r.columns[i] = &infoColumn{Column: col, info: r.info[col.Name()]}
+	}
+	return r.columns, nil
+}
+
+// EstimateRows returns the row estimate of the underlying rows.
+func (r *infoRows) EstimateRows() (int64, bool) {
+	return EstimateRows(r.Rows)
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (r *infoRows) RawValue(column int) (any, bool) {
+	return RawValue(r.Rows, column)
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (r *infoRows) Close() error {
+	return Close(r.Rows)
+}
+
+// infoColumn is a column with extended metadata.
+type infoColumn struct {
+	Column
+	info ColumnInfo
+}
+
+// Info returns the extended metadata of the column.
+func (c *infoColumn) Info() ColumnInfo {
+	return c.info
+}
+
+// CanonicalType returns the canonical type of the underlying column.
+func (c *infoColumn) CanonicalType() Kind {
+	return ColumnKind(c.Column)
+}
+
+// LookupColumnInfo queries information_schema for the columns of table in schema
+// and returns their metadata by column name: the default value, primary key and
+// single-column unique constraints and, for Postgres and MySQL, the comment. The
+// driver name selects the placeholder syntax and the comment lookup, as for
+// NormalizeTypeName. An empty schema matches the table in any schema; if tables of
+// that name exist in several schemas, LookupColumnInfo fails and a schema must be
+// given.
+func LookupColumnInfo(ctx context.Context, db *sql.DB, driver, schema, table string) (map[string]ColumnInfo, error) {
+	family := driverFamily(driver)
+	placeholder := func(i int) string {
+		switch family {
+		case "postgres":
+			return fmt.Sprintf("$%d", i)
+		case "sqlserver":
+			return fmt.Sprintf("@p%d", i)
+		}
+		return "?"
+	}
+	comment := "''"
+	switch family {
+	case "postgres":
+		comment = "COALESCE(col_description(format('%I.%I', c.table_schema, c.table_name)::regclass, c.ordinal_position), '')"
+	case "mysql":
+		comment = "c.column_comment"
+	}
+	// An empty schema matches any schema.
+	query := `SELECT c.table_schema, c.column_name, c.column_default, ` + comment + `
+		FROM information_schema.columns c
+		WHERE c.table_name = ` + placeholder(1) + ` AND (` + placeholder(2) + ` = '' OR c.table_schema = ` + placeholder(3) + `)`
+	rows, err := db.QueryContext(ctx, query, table, schema, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	info := make(map[string]ColumnInfo)
+	found := ""
+	for rows.Next() {
+		var tableSchema, name, comment string
+		var def sql.NullString
+		if err := rows.Scan(&tableSchema, &name, &def, &comment); err != nil {
+			return nil, err
+		}
+		if found != "" && tableSchema != found {
+			return nil, fmt.Errorf("column info: table %q exists in schemas %q and %q, a schema is required", table, found, tableSchema)
+		}
+		found = tableSchema
+		info[name] = ColumnInfo{Table: table, Comment: comment, Default: def.String, HasDefault: def.Valid}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if found == "" {
+		return info, nil
+	}
+
+	// The constraints are looked up in the schema of the columns.
+	query = `SELECT k.constraint_name, k.column_name, t.constraint_type
+		FROM information_schema.table_constraints t
+		JOIN information_schema.key_column_usage k
+			ON k.constraint_name = t.constraint_name AND k.table_schema = t.table_schema AND k.table_name = t.table_name
+		WHERE t.table_name = ` + placeholder(1) + ` AND t.table_schema = ` + placeholder(2) + `
+			AND t.constraint_type IN ('PRIMARY KEY', 'UNIQUE')`
+	keys, err := db.QueryContext(ctx, query, table, found)
+	if err != nil {
+		return nil, err
+	}
+	defer keys.Close()
+	// The columns of every unique constraint, since only a constraint on a single
+	// column makes the column unique by itself.
+	unique := make(map[string][]string)
+	for keys.Next() {
+		var constraintName, name, constraint string
+		if err := keys.Scan(&constraintName, &name, &constraint); err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(constraint, "PRIMARY KEY") {
+			if col, ok := info[name]; ok {
+				col.PrimaryKey = true
+				info[name] = col
+			}
+			continue
+		}
+		unique[constraintName] = append(unique[constraintName], name)
+	}
+	if err := keys.Err(); err != nil {
+		return nil, err
+	}
+	for _, columns := range unique {
+		if len(columns) != 1 {
+			continue
+		}
+		if col, ok := info[columns[0]]; ok {
+			col.Unique = true
+			info[columns[0]] = col
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,30 @@
+package scanner
+
+import "testing"
+
+func TestWithColumnInfo(t *testing.T) {
+	info := map[string]ColumnInfo{
+		"column_0": {Table: "users", PrimaryKey: true},
+		"column_1": {Table: "users", Comment: "Display name", Default: "''", HasDefault: true},
+	}
+	rows := WithColumnInfo(FromData([][]any{{int64(1), "a", 2.5}}), info)
+	cols, err := rows.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, col := range cols {
+		got, ok := Info(col)
+		if !ok {
+			t.Fatalf("column %d does not implement InfoColumn", i)
+		}
+		if got != info[col.Name()] {
+			t.Errorf("column %d: got %+v, want %+v", i, got, info[col.Name()])
+		}
+	}
+	if kind := ColumnKind(cols[0]); kind != KindInt64 {
+		t.Errorf("got kind %v, want %v", kind, KindInt64)
+	}
+	if _, ok := Info(&mockColumn{}); ok {
+		t.Error("mockColumn reports column info")
+	}
+}
@@ -0,0 +1,42 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines the optional estimation of the total number of rows.
+package scanner
+
+// Counter is an optional interface implemented by Rows that can cheaply estimate
+// the total number of rows of the source, e.g. for progress percentages and row
+// count summaries. EstimateRows reports false if no estimate is available.
+type Counter interface {
+	EstimateRows() (n int64, ok bool)
+}
+
+// EstimateRows returns the estimated total number of rows of rows if it implements
+// Counter, and false otherwise.
+func EstimateRows(rows Rows) (int64, bool) {
+	if counter, ok := rows.(Counter); ok {
+		return counter.EstimateRows()
+	}
+	return 0, false
+}
+
+// estimatedRows attaches a known row count to a Rows.
+type estimatedRows struct {
+	Rows
+	n int64
+}
+
+// WithRowEstimate wraps rows so that they report n as their estimated total number of
+// rows, e.g. the result of a COUNT query or the row count from table statistics run
+// before the export query.
+func WithRowEstimate(rows Rows, n int64) Rows {
+	return &estimatedRows{Rows: rows, n: n}
+}
+
+// EstimateRows returns the attached row count.
+func (e *estimatedRows) EstimateRows() (int64, bool) {
+	return e.n, true
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (e *estimatedRows) Close() error {
+	return Close(e.Rows)
+}
@@ -0,0 +1,121 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines a scanner reading CSV input.
+package scanner
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// CSVOption defines a functional option for configuring FromCSV.
+type CSVOption func(*csvRowsScanner)
+
+// WithCSVComma sets the field delimiter (default ',').
+func WithCSVComma(comma rune) CSVOption {
+	return func(s *csvRowsScanner) {
+		s.reader.Comma = comma
+	}
+}
+
+// WithCSVHeader controls whether the first record holds the column names (default).
+// Without a header, the columns are named column_0, column_1, and so on.
+func WithCSVHeader(header bool) CSVOption {
+	return func(s *csvRowsScanner) {
+		s.header = header
+	}
+}
+
+// csvRowsScanner implements the Rows interface for CSV input.
+type csvRowsScanner struct {
+	reader *csv.Reader
+	header bool
+
+	columns []Column
+	first   []string // First data record, read to count the columns without a header.
+	record  []string
+	row     []any
+	err     error
+}
+
+// FromCSV creates a Rows reading CSV records from r. All values are strings;
+// wrap the rows with Cast to convert columns to other types. Every record must
+// have as many fields as there are columns.
+func FromCSV(r io.Reader, opts ...CSVOption) Rows {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+	s := &csvRowsScanner{reader: reader, header: true}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Driver returns a string identifying the data source as CSV.
+func (s *csvRowsScanner) Driver() string {
+	return "csv"
+}
+
+// Columns returns the columns named by the header, reading it on the first call.
+func (s *csvRowsScanner) Columns() ([]Column, error) {
+	if s.columns != nil || s.err != nil {
+		return s.columns, s.err
+	}
+	record, err := s.reader.Read()
+	if err != nil && !errors.Is(err, io.EOF) {
+		s.err = err
+		return nil, err
+	}
+	s.columns = make([]Column, len(record))
+	for i, field := range record {
+		name := field
+		if !s.header {
+			name = fmt.Sprintf("column_%d", i)
+		}
+		s.columns[i] = &mockColumn{index: i, name: name, goType: "string"}
+	}
+	if !s.header && record != nil {
+		s.first = append([]string(nil), record...)
+	}
+	return s.columns, nil
+}
+
+// Next reads the next record.
+func (s *csvRowsScanner) Next() bool {
+	if s.columns == nil {
+		if _, err := s.Columns(); err != nil {
+			return false
+		}
+	}
+	if s.first != nil {
+		s.record, s.first = s.first, nil
+		return true
+	}
+	record, err := s.reader.Read()
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			s.err = err
+		}
+		return false
+	}
+	s.record = record
+	return true
+}
+
+// ScanRow returns the current record as strings. The returned slice is reused between calls.
+func (s *csvRowsScanner) ScanRow() ([]any, error) {
+	if s.record == nil {
+		return nil, errors.New("csv: scan called without calling Next")
+	}
+	s.row = s.row[:0]
+	for _, field := range s.record {
+		s.row = append(s.row, field)
+	}
+	return s.row, nil
+}
+
+// Err returns the error encountered while reading, if any.
+func (s *csvRowsScanner) Err() error {
+	return s.err
+}
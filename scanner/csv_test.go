@@ -0,0 +1,55 @@
+package scanner
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFromCSV(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		opts  []CSVOption
+		cols  []string
+		rows  [][]any
+	}{
+		{"id,name\n1,alice\n2,\"b,ob\"\n", nil, []string{"id", "name"}, [][]any{{"1", "alice"}, {"2", "b,ob"}}},
+		{"1\talice\n2\tbob\n", []CSVOption{WithCSVComma('\t'), WithCSVHeader(false)}, []string{"column_0", "column_1"}, [][]any{{"1", "alice"}, {"2", "bob"}}},
+		{"id,name\n", nil, []string{"id", "name"}, nil},
+		{"", nil, []string{}, nil},
+	} {
+		rows := FromCSV(strings.NewReader(tc.input), tc.opts...)
+		cols, err := rows.Columns()
+		if err != nil {
+			t.Fatal(err)
+		}
+		names := []string{}
+		for _, col := range cols {
+			names = append(names, col.Name())
+		}
+		if !reflect.DeepEqual(names, tc.cols) {
+			t.Fatalf("%q: got columns %v, want %v", tc.input, names, tc.cols)
+		}
+		var got [][]any
+		for rows.Next() {
+			row, err := rows.ScanRow()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, append([]any(nil), row...))
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tc.rows) {
+			t.Fatalf("%q: got rows %v, want %v", tc.input, got, tc.rows)
+		}
+	}
+
+	rows := FromCSV(strings.NewReader("a,b\n1\n"))
+	for rows.Next() {
+	}
+	if rows.Err() == nil {
+		t.Fatal("expected an error for a record with a wrong number of fields")
+	}
+}
@@ -0,0 +1,18 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines the optional description of an export.
+package scanner
+
+// Describer is an optional interface implemented by Rows that carry metadata
+// describing the export, such as the query name, the driver, the generation time
+// or the applied filters, for codecs that render it alongside the data.
+type Describer interface {
+	Describe() map[string]string
+}
+
+// Describe returns the metadata of rows if it implements Describer, and nil otherwise.
+func Describe(rows Rows) map[string]string {
+	if describer, ok := rows.(Describer); ok {
+		return describer.Describe()
+	}
+	return nil
+}
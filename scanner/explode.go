@@ -0,0 +1,251 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines a Rows wrapper that unnests array values into multiple rows.
+package scanner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ExplodeOption defines a functional option for configuring Explode.
+type ExplodeOption func(*explodeRowsScanner)
+
+// WithKeepEmpty controls whether rows whose array is NULL or empty are kept as a
+// single row with a NULL element, like LATERAL VIEW OUTER, instead of being dropped
+// (default).
+func WithKeepEmpty(keepEmpty bool) ExplodeOption {
+	return func(e *explodeRowsScanner) {
+		e.keepEmpty = keepEmpty
+	}
+}
+
+// explodeRowsScanner wraps a Rows and emits one row per element of an array column.
+type explodeRowsScanner struct {
+	Rows
+
+	column    string
+	keepEmpty bool
+
+	columns  []Column
+	index    int
+	postgres bool
+
+	values   []any
+	elements []any
+	next     int
+	row      []any
+	err      error
+}
+
+// Explode wraps rows so that every row is emitted once per element of the array in
+// column, with the element in place of the array and the other columns duplicated.
+//
+// Arrays are recognized in slices and arrays other than []byte, in JSON arrays held
+// in string, []byte and json.RawMessage values, and in Postgres array literals such
+// as {1,2,"a b",NULL} for columns whose database type name starts with "_" or ends
+// with "[]". Other values are emitted as a single element.
+func Explode(rows Rows, column string, opts ...ExplodeOption) Rows {
+	e := &explodeRowsScanner{Rows: rows, column: column, index: -1}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Columns returns the column metadata with the array column describing its elements.
+func (e *explodeRowsScanner) Columns() ([]Column, error) {
+	if e.columns != nil {
+		return e.columns, nil
+	}
+	cols, err := e.Rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]Column, len(cols))
+	copy(columns, cols)
+	for i, col := range cols {
+		if col.Name() == e.column {
+			e.index = i
+			typeName := col.DatabaseTypeName()
+			e.postgres = strings.HasPrefix(typeName, "_") || strings.HasSuffix(typeName, "[]")
+			columns[i] = &explodeColumn{Column: col}
+		}
+	}
+	if e.index < 0 {
+		return nil, fmt.Errorf("explode: unknown column %q", e.column)
+	}
+	e.columns = columns
+	return e.columns, nil
+}
+
+// Next prepares the next element of the current row, reading source rows as needed.
+func (e *explodeRowsScanner) Next() bool {
+	if e.err != nil {
+		return false
+	}
+	if e.columns == nil {
+		if _, err := e.Columns(); err != nil {
+			e.err = err
+			return true // The error is reported by ScanRow.
+		}
+	}
+	e.next++
+	for e.next >= len(e.elements) {
+		if !e.Rows.Next() {
+			return false
+		}
+		values, err := e.Rows.ScanRow()
+		if err != nil {
+			e.err = err
+			return true
+		}
+		if e.index >= len(values) {
+			e.err = fmt.Errorf("explode: row has %d values, column %q is at %d", len(values), e.column, e.index)
+			return true
+		}
+		e.values, e.next = values, 0
+		if e.elements, err = e.arrayElements(values[e.index]); err != nil {
+			e.err = fmt.Errorf("explode column %q: %w", e.column, err)
+			return true
+		}
+		if len(e.elements) == 0 && e.keepEmpty {
+			e.elements = []any{nil}
+		}
+	}
+	return true
+}
+
+// ScanRow returns the current row with the current element in place of the array.
+// The returned slice is reused between calls.
+func (e *explodeRowsScanner) ScanRow() ([]any, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	if e.values == nil {
+		return nil, errors.New("explode: scan called without calling Next")
+	}
+	e.row = append(e.row[:0], e.values...)
+	e.row[e.index] = e.elements[e.next]
+	return e.row, nil
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (e *explodeRowsScanner) Close() error {
+	return Close(e.Rows)
+}
+
+// arrayElements returns the elements of an array value.
+func (e *explodeRowsScanner) arrayElements(v any) ([]any, error) {
+	var data []byte
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		return v, nil
+	case json.RawMessage:
+		data = v
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return []any{v}, nil
+		}
+		elements := make([]any, rv.Len())
+		for i := range elements {
+			elements[i] = rv.Index(i).Interface()
+		}
+		return elements, nil
+	}
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case e.postgres && len(trimmed) != 0 && trimmed[0] == '{':
+		return parsePostgresArray(string(trimmed))
+	case len(trimmed) != 0 && trimmed[0] == '[':
+		var elements []any
+		dec := json.NewDecoder(bytes.NewReader(trimmed))
+		dec.UseNumber()
+		if err := dec.Decode(&elements); err != nil {
+			return nil, err
+		}
+		return elements, nil
+	}
+	return []any{v}, nil
+}
+
+// parsePostgresArray parses a one-dimensional Postgres array literal such as
+// {1,2,"a b",NULL} into its elements. Unquoted NULL elements are returned as nil.
+func parsePostgresArray(s string) ([]any, error) {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("invalid array literal %q", s)
+	}
+	body := s[1 : len(s)-1]
+	if body == "" {
+		return nil, nil
+	}
+	var elements []any
+	for i := 0; i <= len(body); {
+		if i < len(body) && body[i] == '"' {
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(body) && body[j] != '"'; j++ {
+				if body[j] == '\\' && j+1 < len(body) {
+					j++
+				}
+				sb.WriteByte(body[j])
+			}
+			if j >= len(body) {
+				return nil, fmt.Errorf("unterminated quoted element in %q", s)
+			}
+			elements = append(elements, sb.String())
+			i = j + 1
+		} else {
+			j := strings.IndexByte(body[i:], ',')
+			if j < 0 {
+				j = len(body) - i
+			}
+			element := strings.TrimSpace(body[i : i+j])
+			if strings.HasPrefix(element, "{") {
+				return nil, fmt.Errorf("multi-dimensional arrays are not supported: %q", s)
+			}
+			if strings.EqualFold(element, "NULL") {
+				elements = append(elements, nil)
+			} else {
+				elements = append(elements, element)
+			}
+			i += j
+		}
+		if i < len(body) && body[i] != ',' {
+			return nil, fmt.Errorf("invalid array literal %q", s)
+		}
+		i++
+	}
+	return elements, nil
+}
+
+// explodeColumn describes the elements of an exploded array column.
+type explodeColumn struct {
+	Column
+}
+
+// ScanType returns the element type of the source column if it is a slice or array type.
+func (c *explodeColumn) ScanType() reflect.Type {
+	typ := c.Column.ScanType()
+	if typ != nil && (typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array) && typ.Elem().Kind() != reflect.Uint8 {
+		return typ.Elem()
+	}
+	return nil
+}
+
+// DatabaseTypeName returns the element type name of Postgres array types, e.g. INT4 for _INT4.
+func (c *explodeColumn) DatabaseTypeName() string {
+	name := c.Column.DatabaseTypeName()
+	name = strings.TrimPrefix(name, "_")
+	return strings.TrimSuffix(name, "[]")
+}
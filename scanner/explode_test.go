@@ -0,0 +1,60 @@
+package scanner
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExplode(t *testing.T) {
+	data := [][]any{
+		{1, []string{"a", "b"}},
+		{2, nil},
+		{3, `["c", 4]`},
+		{4, []string{}},
+	}
+	for _, keepEmpty := range []bool{false, true} {
+		rows := Explode(FromData(data), "column_1", WithKeepEmpty(keepEmpty))
+		if _, err := rows.Columns(); err != nil {
+			t.Fatal(err)
+		}
+		var got [][]any
+		for rows.Next() {
+			row, err := rows.ScanRow()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, CloneRow(row))
+		}
+		want := [][]any{{1, "a"}, {1, "b"}, {3, "c"}, {3, "4"}}
+		if keepEmpty {
+			want = [][]any{{1, "a"}, {1, "b"}, {2, nil}, {3, "c"}, {3, "4"}, {4, nil}}
+		}
+		if len(got) != len(want) {
+			t.Fatalf("keepEmpty=%v: got %v, want %v", keepEmpty, got, want)
+		}
+		for i := range want {
+			if got[i][0] != want[i][0] || (got[i][1] == nil) != (want[i][1] == nil) ||
+				(got[i][1] != nil && reflect.ValueOf(got[i][1]).String() != want[i][1]) {
+				t.Errorf("keepEmpty=%v: row %d = %v, want %v", keepEmpty, i, got[i], want[i])
+			}
+		}
+	}
+
+	if _, err := Explode(FromData(data), "missing").Columns(); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+}
+
+func TestParsePostgresArray(t *testing.T) {
+	got, err := parsePostgresArray(`{1,"a b","say \"hi\"",NULL,"NULL"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []any{"1", "a b", `say "hi"`, nil, "NULL"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+	if _, err := parsePostgresArray(`{{1,2},{3,4}}`); err == nil {
+		t.Error("expected an error for a multi-dimensional array")
+	}
+}
@@ -0,0 +1,335 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines a Rows wrapper that expands nested values into additional columns.
+package scanner
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// defaultFlattenSample is the number of rows read to discover nested columns.
+const defaultFlattenSample = 1000
+
+// FlattenOption defines a functional option for configuring Flatten.
+type FlattenOption func(*flattenRowsScanner)
+
+// WithFlattenSample sets the number of rows read ahead to discover the nested keys
+// of every column (default 1000). Keys that only appear after the sample are dropped.
+func WithFlattenSample(n int) FlattenOption {
+	return func(f *flattenRowsScanner) {
+		f.sample = n
+	}
+}
+
+// WithFlattenColumns declares the flattened columns instead of discovering them,
+// as paths joined with the separator, e.g. "address.city". Source columns that no
+// path refers to are passed through unchanged. No rows are read ahead.
+func WithFlattenColumns(paths ...string) FlattenOption {
+	return func(f *flattenRowsScanner) {
+		f.declared = paths
+	}
+}
+
+// flattenRowsScanner wraps a Rows and expands nested cell values into leaf columns.
+type flattenRowsScanner struct {
+	Rows
+
+	separator string
+	maxDepth  int
+	sample    int
+	declared  []string
+
+	columns []Column
+	names   []string   // Names of the source columns.
+	leaves  [][]string // Leaf paths of every source column; nil if passed through.
+	err     error
+
+	buffered [][]any
+	current  []any
+	row      []any
+}
+
+// Flatten wraps rows so that map, struct and JSON object values are expanded into
+// one column per nested key, named by joining the keys with separator, e.g.
+// "address.city". Nesting deeper than maxDepth levels is kept as a single value;
+// a non-positive maxDepth flattens all levels.
+//
+// The nested keys are discovered from the first rows (see WithFlattenSample) or
+// declared with WithFlattenColumns. Columns without nested values are passed through.
+// Cells without a value for a key are NULL, as are all nested columns of a cell that
+// does not hold an object. JSON objects are recognized in
+// string, []byte and json.RawMessage values; driver.Valuer values are never expanded.
+func Flatten(rows Rows, separator string, maxDepth int, opts ...FlattenOption) Rows {
+	f := &flattenRowsScanner{
+		Rows:      rows,
+		separator: separator,
+		maxDepth:  maxDepth,
+		sample:    defaultFlattenSample,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Columns returns the flattened column metadata. Unless the columns are declared,
+// the first call reads the sample rows to discover the nested keys.
+func (f *flattenRowsScanner) Columns() ([]Column, error) {
+	if f.columns != nil || f.err != nil {
+		return f.columns, f.err
+	}
+	cols, err := f.Rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	f.leaves = make([][]string, len(cols))
+	for _, col := range cols {
+		f.names = append(f.names, col.Name())
+	}
+	if f.declared != nil {
+		f.declare(cols)
+	} else if err := f.discover(cols); err != nil {
+		f.err = err
+		return nil, err
+	}
+	for i, col := range cols {
+		if f.leaves[i] == nil {
+			f.columns = append(f.columns, &flatColumn{Column: col, index: len(f.columns), name: col.Name()})
+			continue
+		}
+		for _, leaf := range f.leaves[i] {
+			f.columns = append(f.columns, &flatColumn{index: len(f.columns), name: leaf})
+		}
+	}
+	return f.columns, nil
+}
+
+// declare assigns the declared paths to their source columns.
+func (f *flattenRowsScanner) declare(cols []Column) {
+	for _, path := range f.declared {
+		for i, col := range cols {
+			if strings.HasPrefix(path, col.Name()+f.separator) {
+				f.leaves[i] = append(f.leaves[i], path)
+				break
+			}
+		}
+	}
+}
+
+// discover reads the sample rows and collects the leaf paths of every column.
+func (f *flattenRowsScanner) discover(cols []Column) error {
+	found := make([]map[string]bool, len(cols))
+	for len(f.buffered) < f.sample && f.Rows.Next() {
+		values, err := f.Rows.ScanRow()
+		if err != nil {
+			return err
+		}
+		f.buffered = append(f.buffered, CloneRow(values))
+		for i, v := range values {
+			if i >= len(cols) {
+				break
+			}
+			obj, ok := nestedObject(v)
+			if !ok {
+				continue
+			}
+			if found[i] == nil {
+				found[i] = make(map[string]bool)
+			}
+			for path := range f.flatten(cols[i].Name(), obj, 1) {
+				found[i][path] = true
+			}
+		}
+	}
+	for i, paths := range found {
+		for path := range paths {
+			f.leaves[i] = append(f.leaves[i], path)
+		}
+		slices.Sort(f.leaves[i])
+	}
+	return nil
+}
+
+// flatten returns the leaf values of obj keyed by their paths below prefix.
+func (f *flattenRowsScanner) flatten(prefix string, obj map[string]any, depth int) map[string]any {
+	leaves := make(map[string]any, len(obj))
+	for key, v := range obj {
+		path := prefix + f.separator + key
+		if nested, ok := v.(map[string]any); ok && (f.maxDepth <= 0 || depth < f.maxDepth) {
+			for p, leaf := range f.flatten(path, nested, depth+1) {
+				leaves[p] = leaf
+			}
+			continue
+		}
+		leaves[path] = v
+	}
+	return leaves
+}
+
+// Next prepares the next row, replaying the sample rows first.
+func (f *flattenRowsScanner) Next() bool {
+	if f.columns == nil && f.err == nil {
+		if _, err := f.Columns(); err != nil {
+			return true // The error is reported by ScanRow.
+		}
+	}
+	if f.err != nil {
+		return false
+	}
+	if len(f.buffered) != 0 {
+		f.current, f.buffered = f.buffered[0], f.buffered[1:]
+		return true
+	}
+	f.current = nil
+	return f.Rows.Next()
+}
+
+// ScanRow returns the current row with the nested values expanded.
+// The returned slice is reused between calls.
+func (f *flattenRowsScanner) ScanRow() ([]any, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	values := f.current
+	if values == nil {
+		var err error
+		if values, err = f.Rows.ScanRow(); err != nil {
+			return nil, err
+		}
+	}
+	f.row = f.row[:0]
+	for i, v := range values {
+		if i >= len(f.leaves) || f.leaves[i] == nil {
+			f.row = append(f.row, v)
+			continue
+		}
+		var leaves map[string]any
+		if obj, ok := nestedObject(v); ok {
+			leaves = f.flatten(f.names[i], obj, 1)
+		}
+		for _, path := range f.leaves[i] {
+			f.row = append(f.row, leaves[path])
+		}
+	}
+	return f.row, nil
+}
+
+// Err returns the error of the sampling pass or of the underlying rows.
+func (f *flattenRowsScanner) Err() error {
+	if f.err != nil {
+		return f.err
+	}
+	return f.Rows.Err()
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (f *flattenRowsScanner) Close() error {
+	return Close(f.Rows)
+}
+
+// nestedObject returns v as a JSON-like object if it is a map, a struct or a JSON object.
+func nestedObject(v any) (map[string]any, bool) {
+	var data []byte
+	switch v := v.(type) {
+	case nil, driver.Valuer:
+		return nil, false
+	case map[string]any:
+		return v, true
+	case json.RawMessage:
+		data = v
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		rv := reflect.Indirect(reflect.ValueOf(v))
+		if rv.Kind() != reflect.Map && rv.Kind() != reflect.Struct {
+			return nil, false
+		}
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil, false
+		}
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return nil, false
+	}
+	var obj map[string]any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return nil, false
+	}
+	return obj, true
+}
+
+// flatColumn is a column of a flattened Rows. Passed-through columns keep the
+// metadata of their source column; nested columns have no type information.
+type flatColumn struct {
+	Column
+	index int
+	name  string
+}
+
+// Index returns the column index in the flattened rows.
+func (c *flatColumn) Index() int {
+	return c.index
+}
+
+// Name returns the column name.
+func (c *flatColumn) Name() string {
+	return c.name
+}
+
+// Length returns the length of the source column if passed through.
+func (c *flatColumn) Length() (int64, bool) {
+	if c.Column == nil {
+		return 0, false
+	}
+	return c.Column.Length()
+}
+
+// DecimalSize returns the decimal size of the source column if passed through.
+func (c *flatColumn) DecimalSize() (int64, int64, bool) {
+	if c.Column == nil {
+		return 0, 0, false
+	}
+	return c.Column.DecimalSize()
+}
+
+// ScanType returns the scan type of the source column if passed through.
+func (c *flatColumn) ScanType() reflect.Type {
+	if c.Column == nil {
+		return nil
+	}
+	return c.Column.ScanType()
+}
+
+// Nullable returns true for nested columns, as keys may be missing.
+func (c *flatColumn) Nullable() (bool, bool) {
+	if c.Column == nil {
+		return true, true
+	}
+	return c.Column.Nullable()
+}
+
+// CanonicalType returns the canonical type of the source column if passed through.
+func (c *flatColumn) CanonicalType() Kind {
+	if c.Column == nil {
+		return KindUnknown
+	}
+	return ColumnKind(c.Column)
+}
+
+// DatabaseTypeName returns the type name of the source column if passed through.
+func (c *flatColumn) DatabaseTypeName() string {
+	if c.Column == nil {
+		return ""
+	}
+	return c.Column.DatabaseTypeName()
+}
@@ -0,0 +1,78 @@
+package scanner
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	type address struct {
+		City string `json:"city"`
+		Zip  string `json:"zip"`
+	}
+	data := [][]any{
+		{1, map[string]any{"name": "a", "meta": map[string]any{"x": 1, "y": map[string]any{"z": 2}}}, address{"Berlin", "10115"}},
+		{2, `{"name": "b", "extra": true}`, nil},
+	}
+	rows := Flatten(FromData(data), ".", 2)
+	cols, err := rows.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, col := range cols {
+		names = append(names, col.Name())
+	}
+	want := []string{"column_0", "column_1.extra", "column_1.meta.x", "column_1.meta.y", "column_1.name", "column_2.city", "column_2.zip"}
+	if len(names) != len(want) {
+		t.Fatalf("got columns %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("got columns %v, want %v", names, want)
+		}
+	}
+
+	var got [][]any
+	for rows.Next() {
+		row, err := rows.ScanRow()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, CloneRow(row))
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d rows, want 2", len(got))
+	}
+	if got[0][0] != 1 || got[0][1] != nil || got[0][4] != "a" || got[0][5] != "Berlin" {
+		t.Errorf("unexpected first row %v", got[0])
+	}
+	if y, ok := got[0][3].(map[string]any); !ok || y["z"] != 2 {
+		t.Errorf("nesting below maxDepth was expanded: %#v", got[0][3])
+	}
+	if got[1][1] != true || got[1][4] != "b" || got[1][5] != nil {
+		t.Errorf("unexpected second row %v", got[1])
+	}
+}
+
+func TestFlattenDeclaredColumns(t *testing.T) {
+	data := [][]any{{json.RawMessage(`{"a": {"b": 1}, "c": 2}`), "x"}}
+	rows := Flatten(FromData(data), "_", 0, WithFlattenColumns("column_0_a_b"))
+	cols, err := rows.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 2 || cols[0].Name() != "column_0_a_b" || cols[1].Name() != "column_1" {
+		t.Fatalf("unexpected columns %v", cols)
+	}
+	if !rows.Next() {
+		t.Fatal("expected a row")
+	}
+	row, err := rows.ScanRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, ok := row[0].(json.Number); !ok || n.String() != "1" || row[1] != "x" {
+		t.Errorf("unexpected row %#v", row)
+	}
+}
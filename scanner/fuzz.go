@@ -0,0 +1,128 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines a source of adversarial values for fuzz testing codecs.
+package scanner
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+const (
+	// fuzzRows is the number of rows produced by Fuzz.
+	fuzzRows = 64
+	// fuzzColumns is the number of columns produced by Fuzz.
+	fuzzColumns = 6
+	// fuzzHugeString is the length of the huge strings produced by Fuzz.
+	fuzzHugeString = 64 << 10
+)
+
+// fuzzStrings are strings that commonly break encoders.
+var fuzzStrings = []string{
+	"",
+	" ",
+	"\x00",
+	"\t\r\n",
+	"line\r\nbreak",
+	"\x01\x02\x1b[31m\x7f",
+	"\xff\xfe\xfd",               // Invalid UTF-8.
+	"\xed\xa0\x80",               // Encoded UTF-16 surrogate.
+	"\uFFFE\uFFFF",               // Noncharacters.
+	"\uFEFFBOM",                  // Byte order mark.
+	"a\u2028b\u2029c",            // Unicode line separators.
+	"\u202eRTL override",         // Right-to-left override.
+	"\U0001F469\u200D\U0001F467", // Emoji sequence with a zero-width joiner.
+	"<tag attr=\"x\">&amp;</tag>",
+	"]]><![CDATA[",
+	"<!-- -->",
+	`"quoted", 'single'`,
+	`back\slash\`,
+	",;|\t",
+	"=cmd|' /C calc'!A0",
+	"NULL",
+	"null",
+}
+
+// fuzzTimes are extreme points in time.
+var fuzzTimes = []time.Time{
+	{},
+	time.Unix(0, 0).UTC(),
+	time.Date(1, 1, 1, 0, 0, 0, 1, time.UTC),
+	time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC),
+	time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(-1, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2024, 2, 29, 12, 0, 0, 0, time.FixedZone("", -(12*3600+34*60+56))),
+	time.Date(2024, 3, 31, 2, 30, 0, 0, time.FixedZone("+14", 14*3600)),
+}
+
+// fuzzNumbers are extreme numbers.
+var fuzzNumbers = []any{
+	0, math.Copysign(0, -1), math.NaN(), math.Inf(1), math.Inf(-1),
+	math.MaxFloat64, math.SmallestNonzeroFloat64, float32(math.MaxFloat32),
+	int64(math.MinInt64), int64(math.MaxInt64), uint64(math.MaxUint64),
+	int8(math.MinInt8), 1e21, 1e-7, 0.1 + 0.2,
+}
+
+// Fuzz returns in-memory rows filled with adversarial values generated
+// deterministically from seed: control characters, invalid UTF-8, markup and
+// delimiters, huge strings, NaN and infinite floats, extreme integers and times,
+// binary data, nested values and NULLs. The columns have no declared type and
+// mix all kinds of values, so it is suited to check that codecs produce valid
+// documents for any input.
+func Fuzz(seed uint64) Rows {
+	r := rand.New(rand.NewPCG(seed, seed^0x5deece66d))
+	data := make([][]any, fuzzRows)
+	for i := range data {
+		row := make([]any, fuzzColumns)
+		for j := range row {
+			row[j] = fuzzValue(r)
+		}
+		data[i] = row
+	}
+	return FromData(data)
+}
+
+// fuzzValue returns a random adversarial value.
+func fuzzValue(r *rand.Rand) any {
+	switch r.IntN(10) {
+	case 0:
+		return nil
+	case 1:
+		return fuzzTimes[r.IntN(len(fuzzTimes))]
+	case 2:
+		return fuzzNumbers[r.IntN(len(fuzzNumbers))]
+	case 3:
+		b := make([]byte, r.IntN(32))
+		for i := range b {
+			b[i] = byte(r.Uint32())
+		}
+		return b
+	case 4:
+		return r.IntN(2) == 0
+	case 5:
+		if r.IntN(8) == 0 {
+			return strings.Repeat(fuzzStrings[r.IntN(len(fuzzStrings))]+"x", fuzzHugeString/8)
+		}
+		fallthrough
+	case 6:
+		var b strings.Builder
+		for range r.IntN(4) + 1 {
+			b.WriteString(fuzzStrings[r.IntN(len(fuzzStrings))])
+		}
+		return b.String()
+	case 7:
+		s := make([]rune, r.IntN(16))
+		for i := range s {
+			s[i] = rune(r.IntN(0x110000))
+		}
+		return string(s)
+	case 8:
+		return map[string]any{
+			fuzzStrings[r.IntN(len(fuzzStrings))]: fuzzStrings[r.IntN(len(fuzzStrings))],
+			"n":                                   r.Float64(),
+		}
+	}
+	return json.RawMessage(`{"nested":[1,"` + "\\u0000" + `",null]}`)
+}
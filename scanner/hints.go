@@ -0,0 +1,135 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines per-column hints for codecs with tunable binary layouts.
+package scanner
+
+import "maps"
+
+// Well-known hint keys. Codecs document the keys and values they support and
+// ignore all other hints, so that the same hints can be passed to any codec.
+const (
+	HintEncoding    = "encoding"     // The value encoding, e.g. "dictionary", "delta" or "plain".
+	HintCompression = "compression"  // The compression codec of the column, e.g. "zstd" or "snappy".
+	HintBloomFilter = "bloom_filter" // "true" to write a bloom filter for the column.
+	HintSort        = "sort"         // "asc" or "desc" if the rows are sorted by the column.
+)
+
+// HintedColumn is an optional interface implemented by columns that carry hints
+// for the codec, such as the encoding or compression of the column in binary
+// formats. Hints are a tuning aid: codecs may ignore them.
+type HintedColumn interface {
+	Hints() map[string]string
+}
+
+// Hint returns the value of the hint key of col if it implements HintedColumn
+// and has the hint.
+func Hint(col Column, key string) (string, bool) {
+	c, ok := col.(HintedColumn)
+	if !ok {
+		return "", false
+	}
+	value, ok := c.Hints()[key]
+	return value, ok
+}
+
+// hintRows attaches hints to the columns of a Rows.
+type hintRows struct {
+	Rows
+	hints   map[string]map[string]string
+	columns []Column
+}
+
+// hintBatchRows is a hintRows over a source implementing BatchScanner.
+type hintBatchRows struct {
+	*hintRows
+	batch BatchScanner
+}
+
+// WithColumnHints wraps rows so that their columns implement HintedColumn,
+// reporting the hints of hints by column name in addition to the hints of the
+// underlying columns, which they override. The wrapper keeps batch reads, row
+// estimates and descriptions of rows.
+func WithColumnHints(rows Rows, hints map[string]map[string]string) Rows {
+	r := &hintRows{Rows: rows, hints: hints}
+	if batch, ok := rows.(BatchScanner); ok {
+		return &hintBatchRows{hintRows: r, batch: batch}
+	}
+	return r
+}
+
+// Columns returns the columns of the underlying rows with their hints.
+func (r *hintRows) Columns() ([]Column, error) {
+	if r.columns != nil {
+		return r.columns, nil
+	}
+	cols, err := r.Rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	r.columns = make([]Column, len(cols))
+	for i, col := range cols {
+		hints := make(map[string]string)
+		if c, ok := col.(HintedColumn); ok {
+			maps.Copy(hints, c.Hints())
+		}
+		maps.Copy(hints, r.hints[col.Name()])
+		r.columns[i] = &hintColumn{Column: col, hints: hints}
+	}
+	return r.columns, nil
+}
+
+// EstimateRows returns the row estimate of the underlying rows.
+func (r *hintRows) EstimateRows() (int64, bool) {
+	return EstimateRows(r.Rows)
+}
+
+// Describe returns the metadata of the underlying rows.
+func (r *hintRows) Describe() map[string]string {
+	return Describe(r.Rows)
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (r *hintRows) RawValue(column int) (any, bool) {
+	return RawValue(r.Rows, column)
+}
+
+// TrackWrites forwards the write tracking to the underlying rows.
+func (r *hintRows) TrackWrites() {
+	TrackWrites(r.Rows)
+}
+
+// RowWritten reports a written row to the underlying rows.
+func (r *hintRows) RowWritten() {
+	RowWritten(r.Rows)
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (r *hintRows) Close() error {
+	return Close(r.Rows)
+}
+
+// ScanBatch reads a batch of rows from the underlying rows.
+func (r *hintBatchRows) ScanBatch(dst [][]any) (int, error) {
+	return r.batch.ScanBatch(dst)
+}
+
+// hintColumn is a column with codec hints.
+type hintColumn struct {
+	Column
+	hints map[string]string
+}
+
+// Hints returns the hints of the column.
+func (c *hintColumn) Hints() map[string]string {
+	return c.hints
+}
+
+// CanonicalType returns the canonical type of the underlying column.
+func (c *hintColumn) CanonicalType() Kind {
+	return ColumnKind(c.Column)
+}
+
+// Info returns the extended metadata of the underlying column, if any.
+func (c *hintColumn) Info() ColumnInfo {
+	info, _ := Info(c.Column)
+	return info
+}
@@ -0,0 +1,35 @@
+package scanner
+
+import "testing"
+
+func TestWithColumnHints(t *testing.T) {
+	rows := WithColumnHints(FromData([][]any{{int64(1), "a"}}), map[string]map[string]string{
+		"column_1": {HintEncoding: "plain", HintBloomFilter: "true"},
+	})
+	rows = WithColumnHints(rows, map[string]map[string]string{
+		"column_1": {HintEncoding: "dictionary"},
+	})
+	cols, err := rows.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := Hint(cols[1], HintEncoding); !ok || v != "dictionary" {
+		t.Errorf("got encoding %q, %v, want the overriding hint", v, ok)
+	}
+	if v, ok := Hint(cols[1], HintBloomFilter); !ok || v != "true" {
+		t.Errorf("got bloom filter %q, %v, want the underlying hint", v, ok)
+	}
+	if _, ok := Hint(cols[0], HintEncoding); ok {
+		t.Error("column_0 reports an encoding hint")
+	}
+	if kind := ColumnKind(cols[0]); kind != KindInt64 {
+		t.Errorf("got kind %v, want %v", kind, KindInt64)
+	}
+	selected, err := Select(rows, "column_1").Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := Hint(selected[0], HintEncoding); v != "dictionary" {
+		t.Errorf("selected column lost its hints, got %q", v)
+	}
+}
@@ -16,14 +16,22 @@ type hiveRowsScanner struct {
 	cursor         *gohive.Cursor
 	ctx            context.Context
 	columns        []Column
+	complex        []int // Indexes of the columns of complex types to decode.
+	rawComplex     bool
 	currentRow     []any
 	currentRowPtrs []any
 }
 
 // FromHiveCursor wraps a gohive.Cursor and returns a Rows-compatible scanner.
-// The context is used for cancellation and timeout control.
-func FromHiveCursor(cursor *gohive.Cursor, ctx context.Context) Rows {
-	return &hiveRowsScanner{cursor: cursor, ctx: ctx}
+// The context is used for cancellation and timeout control. Values of ARRAY,
+// MAP, STRUCT and UNION columns are decoded into []any and map[string]any
+// values unless WithHiveRawComplexTypes is given.
+func FromHiveCursor(cursor *gohive.Cursor, ctx context.Context, opts ...HiveQueryOption) Rows {
+	q := &hiveQuery{}
+	for _, opt := range opts {
+		opt(q)
+	}
+	return &hiveRowsScanner{cursor: cursor, ctx: ctx, rawComplex: q.rawComplex}
 }
 
 // Next advances the cursor to the next row, returning true if another row is available.
@@ -48,9 +56,26 @@ func (h *hiveRowsScanner) ScanRow() ([]any, error) {
 	if h.cursor.Err != nil {
 		return nil, h.cursor.Err
 	}
+	h.decodeComplex(h.currentRow)
 	return h.currentRow, nil
 }
 
+// ScanBatch reads up to len(dst) rows from the Hive cursor into dst.
+// It implements the BatchScanner interface.
+func (h *hiveRowsScanner) ScanBatch(dst [][]any) (int, error) {
+	for n := range dst {
+		if !h.cursor.HasMore(h.ctx) {
+			return n, nil
+		}
+		dst[n] = h.cursor.RowSlice(h.ctx)
+		if h.cursor.Err != nil {
+			return n, h.cursor.Err
+		}
+		h.decodeComplex(dst[n])
+	}
+	return len(dst), nil
+}
+
 // Columns retrieves metadata about the result set's columns from the Hive cursor.
 func (h *hiveRowsScanner) Columns() ([]Column, error) {
 	if h.columns != nil {
@@ -68,17 +93,35 @@ func (h *hiveRowsScanner) Columns() ([]Column, error) {
 			col.name = c[0]
 			col.hiveType = c[1]
 		}
-		_, colName, ok := strings.Cut(col.name, ".")
+		table, colName, ok := strings.Cut(col.name, ".")
 		if ok {
-			col.name = colName
+			col.table, col.name = table, colName
 		}
 		col.hiveType = strings.TrimSuffix(col.hiveType, "_TYPE")
 		col.index = i
+		if !h.rawComplex && isHiveComplexType(col.hiveType) {
+			h.complex = append(h.complex, len(h.columns))
+		}
 		h.columns = append(h.columns, &col)
 	}
 	return h.columns, nil
 }
 
+// decodeComplex replaces the JSON text of complex values in row with the decoded
+// values. Values that cannot be decoded are left as they are.
+func (h *hiveRowsScanner) decodeComplex(row []any) {
+	for _, i := range h.complex {
+		if i >= len(row) {
+			continue
+		}
+		if text, ok := row[i].(string); ok {
+			if v, ok := decodeHiveComplex(text); ok {
+				row[i] = v
+			}
+		}
+	}
+}
+
 // Driver returns the name of the data source, which is "gohive" in this case.
 func (h *hiveRowsScanner) Driver() string {
 	return "gohive"
@@ -89,10 +132,17 @@ func (h *hiveRowsScanner) Err() error {
 	return h.cursor.Error()
 }
 
+// Close closes the Hive cursor and the operation it executed.
+func (h *hiveRowsScanner) Close() error {
+	h.cursor.Close()
+	return h.cursor.Error()
+}
+
 // hiveColumn represents metadata about a Hive column.
 type hiveColumn struct {
 	index    int
 	name     string
+	table    string
 	hiveType string
 }
 
@@ -126,6 +176,16 @@ func (c *hiveColumn) Nullable() (nullable, ok bool) {
 	return false, false
 }
 
+// CanonicalType returns the canonical type of the Hive type of the column.
+func (c *hiveColumn) CanonicalType() Kind {
+	return NormalizeTypeName("hive", c.hiveType)
+}
+
+// Info returns the table of the column, as reported by Hive in the column name.
+func (c *hiveColumn) Info() ColumnInfo {
+	return ColumnInfo{Table: c.table}
+}
+
 // DatabaseTypeName returns the Hive-specific type name for the column.
 func (c *hiveColumn) DatabaseTypeName() string {
 	return c.hiveType
@@ -0,0 +1,96 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file decodes the values of Hive complex types.
+package scanner
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
+
+// isHiveComplexType reports whether values of the Hive type are delivered as JSON text.
+func isHiveComplexType(hiveType string) bool {
+	switch strings.ToUpper(hiveType) {
+	case "ARRAY", "MAP", "STRUCT", "UNION":
+		return true
+	}
+	return false
+}
+
+// decodeHiveComplex decodes the JSON text of an ARRAY, MAP or STRUCT value into
+// []any and map[string]any values with numbers as json.Number. Hive writes the keys
+// of maps with non-string keys unquoted, e.g. {1:"a"}; such keys are decoded as
+// strings. It returns false if text is not a valid value.
+func decodeHiveComplex(text string) (any, bool) {
+	v, err := decodeJSONValue(text)
+	if err != nil {
+		v, err = decodeJSONValue(quoteBareKeys(text))
+	}
+	return v, err == nil
+}
+
+// decodeJSONValue decodes a single JSON value with numbers as json.Number.
+func decodeJSONValue(text string) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(text))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("hive: unexpected data after value")
+	}
+	return v, nil
+}
+
+// quoteBareKeys quotes the unquoted object keys of text.
+func quoteBareKeys(text string) string {
+	var b strings.Builder
+	var objects []bool // Whether each open container is an object.
+	inString, escaped, expectKey := false, false, false
+	for i := 0; i < len(text); i++ {
+		ch := text[i]
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case ch == '\\':
+				escaped = true
+			case ch == '"':
+				inString = false
+			}
+			b.WriteByte(ch)
+			continue
+		}
+		switch ch {
+		case '"':
+			inString, expectKey = true, false
+		case '{', '[':
+			objects = append(objects, ch == '{')
+			expectKey = ch == '{'
+		case '}', ']':
+			if len(objects) != 0 {
+				objects = objects[:len(objects)-1]
+			}
+			expectKey = false
+		case ',':
+			expectKey = len(objects) != 0 && objects[len(objects)-1]
+		case ' ', '\t', '\r', '\n':
+		default:
+			if expectKey {
+				end := strings.IndexByte(text[i:], ':')
+				if end < 0 {
+					end = len(text) - i
+				}
+				key, _ := json.Marshal(strings.TrimSpace(text[i : i+end]))
+				b.Write(key)
+				i += end - 1
+				expectKey = false
+				continue
+			}
+		}
+		b.WriteByte(ch)
+	}
+	return b.String()
+}
@@ -0,0 +1,31 @@
+package scanner
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeHiveComplex(t *testing.T) {
+	tests := []struct {
+		text string
+		want any
+	}{
+		{`["a","b",null]`, []any{"a", "b", nil}},
+		{`{"k":1.5,"n":{"x":[1]}}`, map[string]any{"k": json.Number("1.5"), "n": map[string]any{"x": []any{json.Number("1")}}}},
+		{`{1:"a",-2:"b,c:d"}`, map[string]any{"1": "a", "-2": "b,c:d"}},
+		{`[{true:{1:[2]}},{}]`, []any{map[string]any{"true": map[string]any{"1": []any{json.Number("2")}}}, map[string]any{}}},
+		{`{"a\":{1":2}`, map[string]any{`a":{1`: json.Number("2")}},
+	}
+	for _, tt := range tests {
+		got, ok := decodeHiveComplex(tt.text)
+		if !ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("decodeHiveComplex(%q) = %#v, %v, want %#v", tt.text, got, ok, tt.want)
+		}
+	}
+	for _, text := range []string{"", "[1", `{"a":1} x`, "not json"} {
+		if _, ok := decodeHiveComplex(text); ok {
+			t.Errorf("decodeHiveComplex(%q) succeeded", text)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file provides a helper that executes a Hive query and manages its cursor.
+package scanner
+
+import (
+	"context"
+
+	"github.com/go-data-exporter/gohive"
+)
+
+// HiveQueryOption defines a functional option for configuring HiveQuery and FromHiveCursor.
+type HiveQueryOption func(*hiveQuery)
+
+// hiveQuery holds the configuration of HiveQuery.
+type hiveQuery struct {
+	logs       func(lines []string)
+	rawComplex bool
+}
+
+// WithHiveRawComplexTypes keeps the values of ARRAY, MAP, STRUCT and UNION columns
+// as the JSON text delivered by Hive instead of decoding them.
+func WithHiveRawComplexTypes(raw bool) HiveQueryOption {
+	return func(q *hiveQuery) {
+		q.rawComplex = raw
+	}
+}
+
+// WithHiveLogs sets a function that receives the Hive execution log lines while the
+// query is running, e.g. to report the progress of MapReduce or Tez jobs. The query
+// is executed asynchronously and polled until it completes.
+func WithHiveLogs(fn func(lines []string)) HiveQueryOption {
+	return func(q *hiveQuery) {
+		q.logs = fn
+	}
+}
+
+// HiveQuery creates a cursor on conn, executes query and returns the result set as
+// Rows, together with a function that closes the cursor. The close function must
+// be called once the rows have been consumed; the connection itself stays open.
+func HiveQuery(ctx context.Context, conn *gohive.Connection, query string, opts ...HiveQueryOption) (Rows, func() error, error) {
+	q := &hiveQuery{}
+	for _, opt := range opts {
+		opt(q)
+	}
+	cursor := conn.Cursor()
+	if q.logs != nil {
+		logs := make(chan []string)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for lines := range logs {
+				if len(lines) != 0 {
+					q.logs(lines)
+				}
+			}
+		}()
+		cursor.Logs = logs
+		cursor.Exec(ctx, query)
+		cursor.Logs = nil
+		close(logs)
+		<-done
+	} else {
+		cursor.Exec(ctx, query)
+	}
+	if err := cursor.Error(); err != nil {
+		cursor.Close()
+		return nil, nil, err
+	}
+	closeCursor := func() error {
+		cursor.Close()
+		return cursor.Error()
+	}
+	return FromHiveCursor(cursor, ctx, opts...), closeCursor, nil
+}
@@ -0,0 +1,110 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file implements string interning for rows held in memory.
+package scanner
+
+// defaultMaxDistinct is the number of distinct values up to which a column is
+// interned if NewInterner is called with a non-positive limit.
+const defaultMaxDistinct = 4096
+
+// Interner deduplicates the string and byte slice values of low-cardinality
+// columns, so that wrappers holding many rows in memory, such as the old source
+// of a diff, keep a single copy of every repeated value, e.g. a status or country
+// column. A column is interned until it has more than the configured number of
+// distinct values; from then on its values are kept as they are, so that
+// high-cardinality columns do not grow the dictionary. An Interner is not safe for
+// concurrent use.
+type Interner struct {
+	maxDistinct int
+	strings     []map[string]string // By column, nil once the column is not interned.
+	bytes       []map[string][]byte
+	stopped     []bool
+}
+
+// NewInterner returns an Interner that interns columns with up to maxDistinct
+// distinct values (default 4096 if not positive).
+func NewInterner(maxDistinct int) *Interner {
+	if maxDistinct <= 0 {
+		maxDistinct = defaultMaxDistinct
+	}
+	return &Interner{maxDistinct: maxDistinct}
+}
+
+// Intern replaces the string and byte slice values of row in place with the
+// shared copies of equal values seen before in the same column. Interned byte
+// slices are shared between rows, so they must not be modified.
+func (in *Interner) Intern(row []any) {
+	for len(in.stopped) < len(row) {
+		in.strings = append(in.strings, nil)
+		in.bytes = append(in.bytes, nil)
+		in.stopped = append(in.stopped, false)
+	}
+	for i, v := range row {
+		if in.stopped[i] {
+			continue
+		}
+		switch v := v.(type) {
+		case string:
+			if in.strings[i] == nil {
+				in.strings[i] = make(map[string]string)
+			}
+			if shared, ok := in.strings[i][v]; ok {
+				row[i] = shared
+			} else {
+				in.strings[i][v] = v
+			}
+		case []byte:
+			if v == nil {
+				continue
+			}
+			if in.bytes[i] == nil {
+				in.bytes[i] = make(map[string][]byte)
+			}
+			if shared, ok := in.bytes[i][string(v)]; ok {
+				row[i] = shared
+			} else {
+				in.bytes[i][string(v)] = v
+			}
+		default:
+			continue
+		}
+		if len(in.strings[i])+len(in.bytes[i]) > in.maxDistinct {
+			// Already stored values stay shared; new values are no longer tracked.
+			in.strings[i], in.bytes[i], in.stopped[i] = nil, nil, true
+		}
+	}
+}
+
+// internRowsScanner wraps a Rows and returns copies of its rows with interned values.
+type internRowsScanner struct {
+	Rows
+	interner *Interner
+}
+
+// InternRows wraps rows like CloneRows, so that every row returned by ScanRow is a
+// new slice owned by the caller, and interns the values of the copies with an
+// Interner for up to maxDistinct distinct values per column. It is meant for
+// wrappers that hold many rows of a source with repeated values in memory.
+func InternRows(rows Rows, maxDistinct int) Rows {
+	return &internRowsScanner{Rows: rows, interner: NewInterner(maxDistinct)}
+}
+
+// ScanRow returns an interned copy of the current row of the underlying rows.
+func (c *internRowsScanner) ScanRow() ([]any, error) {
+	row, err := c.Rows.ScanRow()
+	if err != nil {
+		return nil, err
+	}
+	row = CloneRow(row)
+	c.interner.Intern(row)
+	return row, nil
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (c *internRowsScanner) Close() error {
+	return Close(c.Rows)
+}
+
+// EstimateRows returns the row estimate of the underlying rows if they implement Counter.
+func (c *internRowsScanner) EstimateRows() (int64, bool) {
+	return EstimateRows(c.Rows)
+}
@@ -0,0 +1,46 @@
+package scanner
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestInterner(t *testing.T) {
+	in := NewInterner(2)
+	a := []any{string([]byte("active")), []byte("DE"), 1, "x1"}
+	b := []any{string([]byte("active")), []byte("DE"), 2, "x2"}
+	c := []any{"inactive", []byte("FR"), 3, "x3"}
+	d := []any{"active", nil, 4, "x4"}
+	for _, row := range [][]any{a, b, c, d} {
+		in.Intern(row)
+	}
+	if unsafe.StringData(a[0].(string)) != unsafe.StringData(b[0].(string)) {
+		t.Error("repeated strings were not shared")
+	}
+	if &a[1].([]byte)[0] != &b[1].([]byte)[0] {
+		t.Error("repeated byte slices were not shared")
+	}
+	if b[2] != 2 || c[0] != "inactive" || string(c[1].([]byte)) != "FR" {
+		t.Errorf("values changed: %v %v", b, c)
+	}
+	// The fourth column exceeded two distinct values and is no longer interned.
+	if !in.stopped[3] || in.stopped[0] {
+		t.Errorf("unexpected stopped columns %v", in.stopped)
+	}
+}
+
+func TestInternRows(t *testing.T) {
+	src := &reusingRows{Rows: FromData([][]any{{"a"}, {"a"}, {"b"}})}
+	rows := InternRows(src, 0)
+	var kept [][]any
+	for rows.Next() {
+		row, err := rows.ScanRow()
+		if err != nil {
+			t.Fatal(err)
+		}
+		kept = append(kept, row)
+	}
+	if kept[0][0] != "a" || kept[1][0] != "a" || kept[2][0] != "b" {
+		t.Errorf("unexpected rows %v", kept)
+	}
+}
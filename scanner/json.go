@@ -0,0 +1,196 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines a scanner reading JSON objects.
+package scanner
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// jsonRowsScanner implements the Rows interface for a stream of JSON objects.
+type jsonRowsScanner struct {
+	input *bufio.Reader
+	dec   *json.Decoder
+
+	started bool
+	columns []Column
+	index   map[string]int
+	first   map[string]any
+	row     []any
+	current map[string]any
+	err     error
+}
+
+// FromJSON creates a Rows reading JSON objects from r, either as a JSON array of
+// objects or as newline-delimited JSON (JSON Lines). The columns are the keys of the
+// first object in their order of appearance; keys missing from an object are NULL
+// and keys not present in the first object are ignored. Numbers are returned as
+// json.Number, nested objects and arrays as map[string]any and []any.
+func FromJSON(r io.Reader) Rows {
+	input := bufio.NewReader(r)
+	return &jsonRowsScanner{input: input, dec: json.NewDecoder(input)}
+}
+
+// Driver returns a string identifying the data source as JSON.
+func (s *jsonRowsScanner) Driver() string {
+	return "json"
+}
+
+// Columns returns the keys of the first object, reading it on the first call.
+func (s *jsonRowsScanner) Columns() ([]Column, error) {
+	if s.columns != nil || s.err != nil {
+		return s.columns, s.err
+	}
+	s.columns = []Column{}
+	s.index = make(map[string]int)
+	raw, err := s.nextObject()
+	if err != nil || raw == nil {
+		s.err = err
+		return s.columns, err
+	}
+	keys, err := objectKeys(raw)
+	if err != nil {
+		s.err = err
+		return nil, err
+	}
+	for i, key := range keys {
+		s.index[key] = i
+		s.columns = append(s.columns, &mockColumn{index: i, name: key})
+	}
+	if s.first, err = decodeObject(raw); err != nil {
+		s.err = err
+		return nil, err
+	}
+	return s.columns, nil
+}
+
+// Next reads the next object.
+func (s *jsonRowsScanner) Next() bool {
+	if s.columns == nil {
+		if _, err := s.Columns(); err != nil {
+			return false
+		}
+	}
+	if s.err != nil {
+		return false
+	}
+	if s.first != nil {
+		s.current, s.first = s.first, nil
+		return true
+	}
+	raw, err := s.nextObject()
+	if err == nil && raw != nil {
+		s.current, err = decodeObject(raw)
+	}
+	if err != nil || raw == nil {
+		s.err = err
+		return false
+	}
+	return true
+}
+
+// ScanRow returns the values of the current object in column order.
+// The returned slice is reused between calls.
+func (s *jsonRowsScanner) ScanRow() ([]any, error) {
+	if s.current == nil {
+		return nil, errors.New("json: scan called without calling Next")
+	}
+	if len(s.row) != len(s.columns) {
+		s.row = make([]any, len(s.columns))
+	}
+	clear(s.row)
+	for key, v := range s.current {
+		if i, ok := s.index[key]; ok {
+			s.row[i] = v
+		}
+	}
+	return s.row, nil
+}
+
+// Err returns the error encountered while reading, if any.
+func (s *jsonRowsScanner) Err() error {
+	return s.err
+}
+
+// nextObject returns the next object of the stream, or nil at the end.
+func (s *jsonRowsScanner) nextObject() (json.RawMessage, error) {
+	if !s.started {
+		s.started = true
+		first, err := s.firstByte()
+		if err != nil {
+			return nil, err
+		}
+		if first == '[' {
+			if _, err := s.dec.Token(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !s.dec.More() {
+		return nil, nil
+	}
+	var raw json.RawMessage
+	if err := s.dec.Decode(&raw); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return raw, nil
+}
+
+// firstByte returns the first byte of the input that is not white space without
+// consuming it, or 0 if the input is empty.
+func (s *jsonRowsScanner) firstByte() (byte, error) {
+	for {
+		b, err := s.input.ReadByte()
+		if errors.Is(err, io.EOF) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		if b != ' ' && b != '\t' && b != '\r' && b != '\n' {
+			return b, s.input.UnreadByte()
+		}
+	}
+}
+
+// objectKeys returns the keys of a JSON object in their order of appearance.
+func objectKeys(raw json.RawMessage) ([]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, fmt.Errorf("json: expected an object, got %.32s", raw)
+	}
+	var keys []string
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, tok.(string))
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// decodeObject decodes a JSON object with numbers as json.Number.
+func decodeObject(raw json.RawMessage) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("json: expected an object, got %.32s", raw)
+	}
+	return obj, nil
+}
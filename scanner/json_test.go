@@ -0,0 +1,61 @@
+package scanner
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFromJSON(t *testing.T) {
+	want := [][]any{
+		{json.Number("1"), "alice", map[string]any{"admin": true}},
+		{json.Number("2.5"), nil, nil},
+	}
+	for _, input := range []string{
+		` [{"id": 1, "name": "alice", "tags": {"admin": true}}, {"id": 2.5, "extra": "ignored"}]`,
+		"{\"id\": 1, \"name\": \"alice\", \"tags\": {\"admin\": true}}\n{\"id\": 2.5, \"extra\": \"ignored\"}\n",
+	} {
+		rows := FromJSON(strings.NewReader(input))
+		cols, err := rows.Columns()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, col := range cols {
+			names = append(names, col.Name())
+		}
+		if !reflect.DeepEqual(names, []string{"id", "name", "tags"}) {
+			t.Fatalf("%q: got columns %v", input, names)
+		}
+		var got [][]any
+		for rows.Next() {
+			row, err := rows.ScanRow()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, append([]any(nil), row...))
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("%q: got rows %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"", "[]", " \n"} {
+		rows := FromJSON(strings.NewReader(input))
+		if rows.Next() || rows.Err() != nil {
+			t.Fatalf("%q: expected no rows and no error, got %v", input, rows.Err())
+		}
+	}
+	for _, input := range []string{`[1, 2]`, `{"id": 1} {"id":`} {
+		rows := FromJSON(strings.NewReader(input))
+		for rows.Next() {
+		}
+		if rows.Err() == nil {
+			t.Fatalf("%q: expected an error", input)
+		}
+	}
+}
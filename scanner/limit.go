@@ -0,0 +1,41 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines a Rows wrapper that stops after a number of rows.
+package scanner
+
+// limitRowsScanner wraps a Rows and stops after n rows.
+type limitRowsScanner struct {
+	Rows
+	n    int64
+	read int64
+}
+
+// Limit wraps rows so that they end after the first n rows. The remaining rows of
+// the source are not read.
+func Limit(rows Rows, n int64) Rows {
+	return &limitRowsScanner{Rows: rows, n: n}
+}
+
+// Next advances to the next row unless the limit has been reached.
+func (l *limitRowsScanner) Next() bool {
+	if l.read >= l.n || !l.Rows.Next() {
+		return false
+	}
+	l.read++
+	return true
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (l *limitRowsScanner) RawValue(column int) (any, bool) {
+	return RawValue(l.Rows, column)
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (l *limitRowsScanner) Close() error {
+	return Close(l.Rows)
+}
+
+// EstimateRows returns the row estimate of the underlying rows, capped at the limit.
+func (l *limitRowsScanner) EstimateRows() (int64, bool) {
+	n, ok := EstimateRows(l.Rows)
+	return min(n, l.n), ok
+}
@@ -0,0 +1,17 @@
+package scanner
+
+import "testing"
+
+func TestLimit(t *testing.T) {
+	rows := Limit(FromData([][]any{{1}, {2}, {3}}), 2)
+	if n, ok := EstimateRows(rows); !ok || n != 2 {
+		t.Errorf("got estimate %d, %v, want 2, true", n, ok)
+	}
+	count := 0
+	for rows.Next() {
+		count++
+	}
+	if count != 2 {
+		t.Errorf("got %d rows, want 2", count)
+	}
+}
@@ -0,0 +1,98 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines the optional NULL rendering policy of an export.
+package scanner
+
+// NullPolicy selects how codecs render NULL values, so that a single setting
+// applies to every output format. Each codec interprets it in its own terms.
+type NullPolicy int
+
+const (
+	// NullDefault leaves the rendering of NULL values to the options of the codec.
+	NullDefault NullPolicy = iota
+
+	// NullEmpty renders NULL values as empty: empty CSV fields and HTML cells,
+	// JSON null and omitted XML elements.
+	NullEmpty
+
+	// NullMarked renders NULL values distinguishable from empty strings where the
+	// format allows it: empty CSV fields, JSON null, HTML cells with a styled
+	// span holding the NULL text of the codec, and XML elements with xsi:nil="true".
+	NullMarked
+)
+
+// NullPolicyProvider is an optional interface implemented by Rows that carry the
+// NULL policy of the export.
+type NullPolicyProvider interface {
+	NullPolicy() NullPolicy
+}
+
+// NullPolicyOf returns the NULL policy of rows if it implements NullPolicyProvider,
+// and NullDefault otherwise.
+func NullPolicyOf(rows Rows) NullPolicy {
+	if provider, ok := rows.(NullPolicyProvider); ok {
+		return provider.NullPolicy()
+	}
+	return NullDefault
+}
+
+// nullPolicyRows attaches a NULL policy to a Rows.
+type nullPolicyRows struct {
+	Rows
+	policy NullPolicy
+}
+
+// nullPolicyBatchRows is a nullPolicyRows over a source implementing BatchScanner.
+type nullPolicyBatchRows struct {
+	*nullPolicyRows
+	batch BatchScanner
+}
+
+// WithNullPolicy wraps rows so that codecs render their NULL values according to
+// policy. The wrapper keeps batch reads, row estimates and descriptions of rows.
+func WithNullPolicy(rows Rows, policy NullPolicy) Rows {
+	r := &nullPolicyRows{Rows: rows, policy: policy}
+	if batch, ok := rows.(BatchScanner); ok {
+		return &nullPolicyBatchRows{nullPolicyRows: r, batch: batch}
+	}
+	return r
+}
+
+// NullPolicy returns the attached NULL policy.
+func (r *nullPolicyRows) NullPolicy() NullPolicy {
+	return r.policy
+}
+
+// EstimateRows returns the row estimate of the underlying rows.
+func (r *nullPolicyRows) EstimateRows() (int64, bool) {
+	return EstimateRows(r.Rows)
+}
+
+// Describe returns the metadata of the underlying rows.
+func (r *nullPolicyRows) Describe() map[string]string {
+	return Describe(r.Rows)
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (r *nullPolicyRows) RawValue(column int) (any, bool) {
+	return RawValue(r.Rows, column)
+}
+
+// TrackWrites forwards the write tracking to the underlying rows.
+func (r *nullPolicyRows) TrackWrites() {
+	TrackWrites(r.Rows)
+}
+
+// RowWritten reports a written row to the underlying rows.
+func (r *nullPolicyRows) RowWritten() {
+	RowWritten(r.Rows)
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (r *nullPolicyRows) Close() error {
+	return Close(r.Rows)
+}
+
+// ScanBatch reads a batch of rows from the underlying rows.
+func (r *nullPolicyBatchRows) ScanBatch(dst [][]any) (int, error) {
+	return r.batch.ScanBatch(dst)
+}
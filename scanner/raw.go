@@ -0,0 +1,23 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines access to the values of a row before their conversion by a scanner.
+package scanner
+
+// RawValuer is an optional interface implemented by Rows that keep the values of
+// the current row as they were before a lossy conversion, e.g. the original text
+// of a value converted to float64 by Cast. Custom mappers receive them in
+// Metadata.Raw, so that they can format such values with their exact precision.
+type RawValuer interface {
+	// RawValue returns the raw value of the column at index in the row last
+	// returned by ScanRow, and whether it is available. Like the row, it is only
+	// valid until the next call to Next.
+	RawValue(column int) (any, bool)
+}
+
+// RawValue returns the raw value of the column at index in the current row of
+// rows if rows implements RawValuer and has it.
+func RawValue(rows Rows, column int) (any, bool) {
+	if r, ok := rows.(RawValuer); ok {
+		return r.RawValue(column)
+	}
+	return nil, false
+}
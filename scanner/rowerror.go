@@ -0,0 +1,21 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines the error returned for rows that cannot be converted.
+package scanner
+
+// RowError is returned by ScanRow when a single row cannot be converted, e.g. by
+// Cast, while the following rows can still be read. Callers may skip or reject the
+// row and continue with Next instead of aborting.
+type RowError struct {
+	Row []any // The values of the row as read from the source, valid until the next call to Next.
+	Err error // The conversion error.
+}
+
+// Error implements the error interface.
+func (e *RowError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
@@ -5,11 +5,19 @@ package scanner
 
 // Rows represents an abstract data source that provides tabular data
 // one row at a time. It is similar in spirit to sql.Rows but is generalized.
+//
+// Rows that hold resources may also implement io.Closer; the exporter closes
+// them once the export has finished or failed.
 type Rows interface {
 	// Next prepares the next row for reading. It returns false when no more rows are available.
 	Next() bool
 
 	// ScanRow returns the current row's values as a slice of interface{}.
+	//
+	// The returned slice is owned by the Rows and is only valid until the next
+	// call to Next: implementations may reuse it for the following rows. Callers
+	// that keep rows, such as buffering or prefetching wrappers, must copy them,
+	// for example by reading through CloneRows.
 	ScanRow() ([]any, error)
 
 	// Columns returns metadata about the columns in the result set.
@@ -28,4 +36,17 @@ type Metadata struct {
 	RowID  int    // The row number (starting from 1).
 	Driver string // The name of the driver or data source.
 	Column Column // Metadata about the column.
+
+	// Raw is the value of the cell before its conversion by the scanner, if the
+	// rows implement RawValuer and have it, and nil otherwise.
+	Raw any
+}
+
+// DatabaseTypeName returns the database type name of the column,
+// or an empty string if no column metadata is available.
+func (m Metadata) DatabaseTypeName() string {
+	if m.Column == nil {
+		return ""
+	}
+	return m.Column.DatabaseTypeName()
 }
@@ -0,0 +1,113 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines a serializable snapshot of the column metadata of a Rows source.
+package scanner
+
+import "fmt"
+
+// TableSchema is a serializable description of the columns of a Rows source.
+// It can be encoded with encoding/json or any YAML library honoring yaml tags,
+// recorded, and compared between runs with Diff.
+type TableSchema struct {
+	Driver  string         `json:"driver" yaml:"driver"`
+	Columns []ColumnSchema `json:"columns" yaml:"columns"`
+}
+
+// ColumnSchema describes a single column. Optional properties are nil
+// when the source does not report them.
+type ColumnSchema struct {
+	Name         string `json:"name" yaml:"name"`
+	DatabaseType string `json:"database_type" yaml:"database_type"`
+	GoType       string `json:"go_type,omitempty" yaml:"go_type,omitempty"`
+	Nullable     *bool  `json:"nullable,omitempty" yaml:"nullable,omitempty"`
+	Length       *int64 `json:"length,omitempty" yaml:"length,omitempty"`
+	Precision    *int64 `json:"precision,omitempty" yaml:"precision,omitempty"`
+	Scale        *int64 `json:"scale,omitempty" yaml:"scale,omitempty"`
+}
+
+// Schema returns a snapshot of the column metadata of rows.
+// It does not read any rows.
+func Schema(rows Rows) (*TableSchema, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	s := &TableSchema{
+		Driver:  rows.Driver(),
+		Columns: make([]ColumnSchema, len(cols)),
+	}
+	for i, col := range cols {
+		c := ColumnSchema{
+			Name:         col.Name(),
+			DatabaseType: col.DatabaseTypeName(),
+		}
+		if typ := col.ScanType(); typ != nil {
+			c.GoType = typ.String()
+		}
+		if nullable, ok := col.Nullable(); ok {
+			c.Nullable = &nullable
+		}
+		if length, ok := col.Length(); ok {
+			c.Length = &length
+		}
+		if precision, scale, ok := col.DecimalSize(); ok {
+			c.Precision, c.Scale = &precision, &scale
+		}
+		s.Columns[i] = c
+	}
+	return s, nil
+}
+
+// Diff compares s with other and returns a human-readable description of every
+// difference, or nil if the schemas are equivalent. Columns are matched by name.
+func (s *TableSchema) Diff(other *TableSchema) []string {
+	var diffs []string
+	old := make(map[string]int, len(s.Columns))
+	for i, c := range s.Columns {
+		old[c.Name] = i
+	}
+	seen := make(map[string]bool, len(other.Columns))
+	for i, c := range other.Columns {
+		seen[c.Name] = true
+		j, ok := old[c.Name]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("column %q added", c.Name))
+			continue
+		}
+		prev := s.Columns[j]
+		if i != j {
+			diffs = append(diffs, fmt.Sprintf("column %q moved from position %d to %d", c.Name, j, i))
+		}
+		if prev.DatabaseType != c.DatabaseType {
+			diffs = append(diffs, fmt.Sprintf("column %q database type changed from %q to %q", c.Name, prev.DatabaseType, c.DatabaseType))
+		}
+		if prev.GoType != c.GoType {
+			diffs = append(diffs, fmt.Sprintf("column %q Go type changed from %q to %q", c.Name, prev.GoType, c.GoType))
+		}
+		diffs = appendPtrDiff(diffs, c.Name, "nullable", prev.Nullable, c.Nullable)
+		diffs = appendPtrDiff(diffs, c.Name, "length", prev.Length, c.Length)
+		diffs = appendPtrDiff(diffs, c.Name, "precision", prev.Precision, c.Precision)
+		diffs = appendPtrDiff(diffs, c.Name, "scale", prev.Scale, c.Scale)
+	}
+	for _, c := range s.Columns {
+		if !seen[c.Name] {
+			diffs = append(diffs, fmt.Sprintf("column %q removed", c.Name))
+		}
+	}
+	return diffs
+}
+
+// appendPtrDiff appends a description of a changed optional property.
+func appendPtrDiff[T comparable](diffs []string, column, property string, prev, next *T) []string {
+	if (prev == nil) == (next == nil) && (prev == nil || *prev == *next) {
+		return diffs
+	}
+	return append(diffs, fmt.Sprintf("column %q %s changed from %s to %s", column, property, ptrString(prev), ptrString(next)))
+}
+
+// ptrString formats an optional property.
+func ptrString[T any](v *T) string {
+	if v == nil {
+		return "unknown"
+	}
+	return fmt.Sprint(*v)
+}
@@ -0,0 +1,41 @@
+package scanner
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSchema(t *testing.T) {
+	s, err := Schema(FromData([][]any{{1, "a"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"driver":"go-slice","columns":[{"name":"column_0","database_type":"int"},{"name":"column_1","database_type":"string"}]}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+
+	var decoded TableSchema
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if diffs := s.Diff(&decoded); diffs != nil {
+		t.Errorf("expected no differences, got %v", diffs)
+	}
+
+	next, _ := Schema(FromData([][]any{{"x", 2.5}}))
+	next.Columns[1].Name = "column_2"
+	want2 := []string{
+		`column "column_0" database type changed from "int" to "string"`,
+		`column "column_2" added`,
+		`column "column_1" removed`,
+	}
+	if diffs := s.Diff(next); !reflect.DeepEqual(diffs, want2) {
+		t.Errorf("got %q", diffs)
+	}
+}
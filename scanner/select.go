@@ -0,0 +1,120 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines a Rows wrapper that projects a subset of the columns.
+package scanner
+
+import "fmt"
+
+// selectRowsScanner wraps a Rows and returns the selected columns only.
+type selectRowsScanner struct {
+	Rows
+	names   []string
+	fields  []int
+	columns []Column
+	row     []any
+}
+
+// Select wraps rows so that they return only the named columns, in the given order.
+// Columns reports an error if a name does not match a column of rows.
+func Select(rows Rows, columns ...string) Rows {
+	return &selectRowsScanner{Rows: rows, names: columns}
+}
+
+// Columns returns the selected columns, indexed by their new position.
+func (s *selectRowsScanner) Columns() ([]Column, error) {
+	if s.columns != nil {
+		return s.columns, nil
+	}
+	cols, err := s.Rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int, len(cols))
+	for i, col := range cols {
+		index[col.Name()] = i
+	}
+	fields := make([]int, len(s.names))
+	columns := make([]Column, len(s.names))
+	for i, name := range s.names {
+		j, ok := index[name]
+		if !ok {
+			return nil, fmt.Errorf("select: unknown column %q", name)
+		}
+		fields[i] = j
+		columns[i] = &selectColumn{Column: cols[j], index: i}
+	}
+	s.fields, s.columns = fields, columns
+	return s.columns, nil
+}
+
+// ScanRow returns the values of the selected columns. The returned slice is reused between calls.
+func (s *selectRowsScanner) ScanRow() ([]any, error) {
+	if s.columns == nil {
+		if _, err := s.Columns(); err != nil {
+			return nil, err
+		}
+	}
+	values, err := s.Rows.ScanRow()
+	if err != nil {
+		return nil, err
+	}
+	if len(s.row) != len(s.fields) {
+		s.row = make([]any, len(s.fields))
+	}
+	for i, j := range s.fields {
+		if j < len(values) {
+			s.row[i] = values[j]
+		} else {
+			s.row[i] = nil
+		}
+	}
+	return s.row, nil
+}
+
+// RawValue returns the raw value of the selected column if the underlying rows
+// implement RawValuer.
+func (s *selectRowsScanner) RawValue(column int) (any, bool) {
+	if column < 0 || column >= len(s.fields) {
+		return nil, false
+	}
+	return RawValue(s.Rows, s.fields[column])
+}
+
+// Close closes the underlying rows if they implement io.Closer.
+func (s *selectRowsScanner) Close() error {
+	return Close(s.Rows)
+}
+
+// EstimateRows returns the row estimate of the underlying rows if they implement Counter.
+func (s *selectRowsScanner) EstimateRows() (int64, bool) {
+	return EstimateRows(s.Rows)
+}
+
+// selectColumn is a selected column at its new position.
+type selectColumn struct {
+	Column
+	index int
+}
+
+// Index returns the position of the column among the selected columns.
+func (c *selectColumn) Index() int {
+	return c.index
+}
+
+// CanonicalType returns the canonical type of the underlying column.
+func (c *selectColumn) CanonicalType() Kind {
+	return ColumnKind(c.Column)
+}
+
+// Info returns the extended metadata of the underlying column, if any.
+func (c *selectColumn) Info() ColumnInfo {
+	info, _ := Info(c.Column)
+	return info
+}
+
+// Hints returns the codec hints of the underlying column, if any.
+func (c *selectColumn) Hints() map[string]string {
+	if h, ok := c.Column.(HintedColumn); ok {
+		return h.Hints()
+	}
+	return nil
+}
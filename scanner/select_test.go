@@ -0,0 +1,33 @@
+package scanner
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSelect(t *testing.T) {
+	rows := Select(FromData([][]any{{1, "a", true}, {2, "b", false}}), "column_2", "column_0")
+	cols, err := rows.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 2 || cols[0].Name() != "column_2" || cols[1].Index() != 1 {
+		t.Fatalf("unexpected columns %v", cols)
+	}
+	var got [][]any
+	for rows.Next() {
+		row, err := rows.ScanRow()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, CloneRow(row))
+	}
+	want := [][]any{{true, 1}, {false, 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := Select(FromData([][]any{{1}}), "missing").Columns(); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+}
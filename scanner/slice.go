@@ -63,6 +63,28 @@ func (s *sliceRowsScanner) ScanRow() ([]any, error) {
 	return s.lastRow, nil
 }
 
+// ScanBatch reads up to len(dst) rows into dst without copying them.
+// It implements the BatchScanner interface.
+func (s *sliceRowsScanner) ScanBatch(dst [][]any) (int, error) {
+	for n := range dst {
+		if !s.Next() {
+			return n, nil
+		}
+		row, err := s.ScanRow()
+		if err != nil {
+			return n, err
+		}
+		dst[n] = row
+	}
+	return len(dst), nil
+}
+
+// EstimateRows returns the number of rows of the slice.
+// It implements the Counter interface.
+func (s *sliceRowsScanner) EstimateRows() (int64, bool) {
+	return int64(len(s.rows)), true
+}
+
 // Columns returns the inferred column metadata, based on the first row.
 // If no data is available, returns an empty slice.
 func (s *sliceRowsScanner) Columns() ([]Column, error) {
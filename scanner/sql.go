@@ -17,6 +17,7 @@ type sqlRowsScanner struct {
 
 // FromSQL creates a Rows-compatible wrapper around a *sql.Rows object.
 // The driver name is required for metadata and contextual information.
+// The returned Rows implements io.Closer by closing the *sql.Rows.
 func FromSQL(rows *sql.Rows, driver string) Rows {
 	return &sqlRowsScanner{Rows: rows, driver: driver}
 }
@@ -25,7 +26,8 @@ func FromSQL(rows *sql.Rows, driver string) Rows {
 // provided by the standard database/sql package.
 type sqlColumn struct {
 	*sql.ColumnType
-	index int
+	index  int
+	driver string
 }
 
 // Index returns the column's index in the result set.
@@ -33,6 +35,12 @@ func (c *sqlColumn) Index() int {
 	return c.index
 }
 
+// CanonicalType returns the canonical type of the column, resolving the database
+// type name for the driver of the rows.
+func (c *sqlColumn) CanonicalType() Kind {
+	return normalizeColumn(c.driver, c)
+}
+
 // Columns returns column metadata for the SQL result set.
 // Uses database/sql ColumnTypes to provide column information.
 func (s *sqlRowsScanner) Columns() ([]Column, error) {
@@ -47,6 +55,7 @@ func (s *sqlRowsScanner) Columns() ([]Column, error) {
 		s.columns = append(s.columns, &sqlColumn{
 			ColumnType: c,
 			index:      i,
+			driver:     s.driver,
 		})
 	}
 	return s.columns, nil
@@ -65,16 +74,43 @@ func (s *sqlRowsScanner) ScanRow() ([]any, error) {
 	if s.currentRow == nil {
 		s.currentRow = make([]any, len(s.columns))
 	}
+	if err := s.scanInto(s.currentRow); err != nil {
+		return nil, err
+	}
+	return s.currentRow, nil
+}
+
+// ScanBatch reads up to len(dst) rows from the SQL result set into dst.
+// It implements the BatchScanner interface.
+func (s *sqlRowsScanner) ScanBatch(dst [][]any) (int, error) {
+	if s.columns == nil {
+		if _, err := s.Columns(); err != nil {
+			return 0, err
+		}
+	}
+	for n := range dst {
+		if !s.Rows.Next() {
+			return n, nil
+		}
+		if len(dst[n]) != len(s.columns) {
+			dst[n] = make([]any, len(s.columns))
+		}
+		if err := s.scanInto(dst[n]); err != nil {
+			return n, err
+		}
+	}
+	return len(dst), nil
+}
+
+// scanInto scans the current row of the result set into row.
+func (s *sqlRowsScanner) scanInto(row []any) error {
 	if s.currentRowPtrs == nil {
 		s.currentRowPtrs = make([]any, len(s.columns))
 	}
-	for i := range len(s.columns) {
-		s.currentRowPtrs[i] = &s.currentRow[i]
-	}
-	if err := s.Rows.Scan(s.currentRowPtrs...); err != nil {
-		return nil, err
+	for i := range row {
+		s.currentRowPtrs[i] = &row[i]
 	}
-	return s.currentRow, nil
+	return s.Rows.Scan(s.currentRowPtrs...)
 }
 
 // Driver returns the name of the SQL driver used.
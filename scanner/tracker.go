@@ -0,0 +1,28 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines the optional tracking of the rows written by a codec.
+package scanner
+
+// WriteTracker is an optional interface implemented by Rows that track which of
+// their rows a codec writes. Codecs read rows that they do not write, such as rows
+// read ahead in batches, rows skipped by a preprocessor or rows beyond a limit, so
+// only the codec knows how many rows ended up in the output. A codec calls
+// TrackWrites once before it writes rows and RowWritten after every written row.
+type WriteTracker interface {
+	TrackWrites()
+	RowWritten()
+}
+
+// TrackWrites announces to rows that the codec reports its written rows, if rows
+// implements WriteTracker.
+func TrackWrites(rows Rows) {
+	if tracker, ok := rows.(WriteTracker); ok {
+		tracker.TrackWrites()
+	}
+}
+
+// RowWritten reports a written row to rows if it implements WriteTracker.
+func RowWritten(rows Rows) {
+	if tracker, ok := rows.(WriteTracker); ok {
+		tracker.RowWritten()
+	}
+}
@@ -0,0 +1,296 @@
+// Package scanner provides implementations of the Rows interface for various data sources.
+// This file defines canonical column types and the normalization of driver type names.
+package scanner
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Kind is the canonical type of a column, independent of the driver that reported
+// it. Codecs that generate schemas, such as DDL statements, map the Kind instead of
+// driver-specific type names.
+type Kind int
+
+const (
+	KindUnknown     Kind = iota // The type could not be determined.
+	KindBoolean                 // BOOLEAN, BOOL, BIT (SQL Server).
+	KindInt16                   // TINYINT, SMALLINT, INT2.
+	KindInt32                   // INT, INTEGER, MEDIUMINT, INT4.
+	KindInt64                   // BIGINT, INT8, INTEGER (SQLite).
+	KindFloat32                 // REAL, FLOAT4, FLOAT (MySQL, Hive).
+	KindFloat64                 // DOUBLE PRECISION, FLOAT8, FLOAT.
+	KindDecimal                 // DECIMAL, NUMERIC, NUMBER, MONEY.
+	KindString                  // CHAR, VARCHAR, TEXT, STRING.
+	KindBinary                  // BINARY, VARBINARY, BLOB, BYTEA.
+	KindDate                    // DATE.
+	KindTime                    // TIME of day.
+	KindTimestamp               // TIMESTAMP, DATETIME, without time zone.
+	KindTimestampTZ             // TIMESTAMPTZ, DATETIMEOFFSET, with time zone.
+	KindInterval                // INTERVAL.
+	KindUUID                    // UUID, UNIQUEIDENTIFIER.
+	KindJSON                    // JSON, JSONB.
+	KindArray                   // ARRAY and Postgres array types.
+	KindMap                     // MAP.
+	KindStruct                  // STRUCT, UNION.
+)
+
+// kindNames are the names of the kinds.
+var kindNames = [...]string{
+	KindUnknown:     "unknown",
+	KindBoolean:     "boolean",
+	KindInt16:       "int16",
+	KindInt32:       "int32",
+	KindInt64:       "int64",
+	KindFloat32:     "float32",
+	KindFloat64:     "float64",
+	KindDecimal:     "decimal",
+	KindString:      "string",
+	KindBinary:      "binary",
+	KindDate:        "date",
+	KindTime:        "time",
+	KindTimestamp:   "timestamp",
+	KindTimestampTZ: "timestamptz",
+	KindInterval:    "interval",
+	KindUUID:        "uuid",
+	KindJSON:        "json",
+	KindArray:       "array",
+	KindMap:         "map",
+	KindStruct:      "struct",
+}
+
+// String returns the lower-case name of the kind, e.g. "int64".
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return kindNames[KindUnknown]
+	}
+	return kindNames[k]
+}
+
+// IsNumeric reports whether the kind holds numbers.
+func (k Kind) IsNumeric() bool {
+	switch k {
+	case KindInt16, KindInt32, KindInt64, KindFloat32, KindFloat64, KindDecimal:
+		return true
+	}
+	return false
+}
+
+// TypedColumn is an optional interface implemented by columns that know their
+// canonical type. The columns of the scanners in this package implement it.
+type TypedColumn interface {
+	CanonicalType() Kind
+}
+
+// ColumnKind returns the canonical type of col: the kind reported by col if it
+// implements TypedColumn, otherwise the kind derived from its database type name
+// with NormalizeTypeName, and finally from its scan type.
+func ColumnKind(col Column) Kind {
+	if typed, ok := col.(TypedColumn); ok {
+		return typed.CanonicalType()
+	}
+	return normalizeColumn("", col)
+}
+
+// normalizeColumn returns the canonical type of col delivered by driver.
+func normalizeColumn(driver string, col Column) Kind {
+	if typ := NormalizeTypeName(driver, col.DatabaseTypeName()); typ != KindUnknown {
+		return typ
+	}
+	return scanTypeOf(col.ScanType())
+}
+
+// NormalizeTypeName returns the canonical type of a database type name reported by
+// a driver, e.g. INT8 for "postgres", BIGINT for "mysql" or INTEGER for "sqlite3"
+// are all KindInt64. The name is case-insensitive, and length, precision and
+// Nullable wrappers are ignored, as in VARCHAR(255) or Nullable(Int32). Names that
+// differ between databases, such as FLOAT or INT8, are resolved by driver, which
+// is matched by family: names containing postgres, pgx, mysql, sqlite, sqlserver,
+// mssql, oracle, godror, hive or clickhouse. It returns KindUnknown for unknown names.
+func NormalizeTypeName(driver, databaseTypeName string) Kind {
+	name := strings.ToUpper(strings.TrimSpace(databaseTypeName))
+	for _, wrapper := range []string{"NULLABLE(", "LOWCARDINALITY("} {
+		if strings.HasPrefix(name, wrapper) && strings.HasSuffix(name, ")") {
+			name = strings.TrimSpace(name[len(wrapper) : len(name)-1])
+		}
+	}
+	if i := strings.IndexByte(name, '('); i >= 0 {
+		// Keep the words following the parameters, as in TIMESTAMP(6) WITH TIME ZONE.
+		rest := ""
+		if j := strings.LastIndexByte(name, ')'); j > i {
+			rest = name[j+1:]
+		}
+		name = strings.TrimSpace(name[:i] + rest)
+	}
+	name = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(name, "UNSIGNED "), " UNSIGNED"))
+	family := driverFamily(driver)
+	if typ, ok := driverTypes[family][name]; ok {
+		return typ
+	}
+	if (family == "postgres" && strings.HasPrefix(name, "_")) || strings.HasSuffix(name, "[]") {
+		return KindArray
+	}
+	return standardTypes[name]
+}
+
+// driverFamily returns the database family of a driver name.
+func driverFamily(driver string) string {
+	driver = strings.ToLower(driver)
+	for _, family := range []struct{ family, match string }{
+		{"postgres", "postgres"}, {"postgres", "pgx"}, {"postgres", "pq"},
+		{"mysql", "mysql"}, {"mysql", "mariadb"},
+		{"sqlite", "sqlite"},
+		{"sqlserver", "sqlserver"}, {"sqlserver", "mssql"},
+		{"oracle", "oracle"}, {"oracle", "godror"}, {"oracle", "oci8"},
+		{"hive", "hive"},
+		{"clickhouse", "clickhouse"},
+	} {
+		if strings.Contains(driver, family.match) {
+			return family.family
+		}
+	}
+	return ""
+}
+
+// standardTypes maps upper-case type names shared by most databases to canonical types.
+var standardTypes = map[string]Kind{
+	"BOOLEAN": KindBoolean, "BOOL": KindBoolean,
+	"TINYINT": KindInt16, "SMALLINT": KindInt16, "INT2": KindInt16,
+	"INT": KindInt32, "INTEGER": KindInt32, "MEDIUMINT": KindInt32, "INT4": KindInt32,
+	"BIGINT": KindInt64, "INT8": KindInt64,
+	"SERIAL": KindInt32, "SMALLSERIAL": KindInt16, "BIGSERIAL": KindInt64,
+	"REAL": KindFloat32, "FLOAT4": KindFloat32,
+	"FLOAT": KindFloat64, "DOUBLE": KindFloat64, "DOUBLE PRECISION": KindFloat64, "FLOAT8": KindFloat64,
+	"DECIMAL": KindDecimal, "NUMERIC": KindDecimal, "NUMBER": KindDecimal, "DEC": KindDecimal,
+	"MONEY": KindDecimal, "SMALLMONEY": KindDecimal,
+	"CHAR": KindString, "VARCHAR": KindString, "CHARACTER": KindString, "CHARACTER VARYING": KindString,
+	"NCHAR": KindString, "NVARCHAR": KindString, "TEXT": KindString, "STRING": KindString,
+	"TINYTEXT": KindString, "MEDIUMTEXT": KindString, "LONGTEXT": KindString, "NTEXT": KindString,
+	"CLOB": KindString, "NCLOB": KindString, "BPCHAR": KindString, "CITEXT": KindString, "ENUM": KindString,
+	"VARCHAR2": KindString, "NVARCHAR2": KindString,
+	"BINARY": KindBinary, "VARBINARY": KindBinary, "BLOB": KindBinary, "BYTEA": KindBinary,
+	"TINYBLOB": KindBinary, "MEDIUMBLOB": KindBinary, "LONGBLOB": KindBinary, "IMAGE": KindBinary, "RAW": KindBinary,
+	"DATE": KindDate, "DATE32": KindDate,
+	"TIME": KindTime, "TIMETZ": KindTime, "TIME WITH TIME ZONE": KindTime, "TIME WITHOUT TIME ZONE": KindTime,
+	"TIMESTAMP": KindTimestamp, "DATETIME": KindTimestamp, "DATETIME2": KindTimestamp, "SMALLDATETIME": KindTimestamp,
+	"TIMESTAMP WITHOUT TIME ZONE": KindTimestamp,
+	"TIMESTAMPTZ":                 KindTimestampTZ, "TIMESTAMP WITH TIME ZONE": KindTimestampTZ, "DATETIMEOFFSET": KindTimestampTZ,
+	"TIMESTAMP WITH LOCAL TIME ZONE": KindTimestampTZ,
+	"INTERVAL":                       KindInterval,
+	"UUID":                           KindUUID, "UNIQUEIDENTIFIER": KindUUID,
+	"JSON": KindJSON, "JSONB": KindJSON,
+	"ARRAY": KindArray, "MAP": KindMap, "STRUCT": KindStruct, "UNION": KindStruct,
+}
+
+// driverTypes maps upper-case type names whose meaning depends on the database,
+// by driver family, to canonical types.
+var driverTypes = map[string]map[string]Kind{
+	"mysql": {
+		"FLOAT": KindFloat32, "YEAR": KindInt16, "BIT": KindBinary, "TIMESTAMP": KindTimestampTZ,
+	},
+	"sqlite": {
+		"INTEGER": KindInt64, "INT": KindInt64, "REAL": KindFloat64,
+	},
+	"sqlserver": {
+		"BIT": KindBoolean, "TINYINT": KindInt16, "TIMESTAMP": KindBinary, "ROWVERSION": KindBinary,
+	},
+	"oracle": {
+		"DATE": KindTimestamp, "BINARY_FLOAT": KindFloat32, "BINARY_DOUBLE": KindFloat64, "LONG": KindString,
+	},
+	"hive": {
+		"FLOAT": KindFloat32, "INTERVAL_YEAR_MONTH": KindInterval, "INTERVAL_DAY_TIME": KindInterval,
+	},
+	"clickhouse": {
+		"INT8": KindInt16, "UINT8": KindInt16, "INT16": KindInt16, "UINT16": KindInt32,
+		"INT32": KindInt32, "UINT32": KindInt64, "INT64": KindInt64, "UINT64": KindInt64,
+		"FLOAT32": KindFloat32, "FLOAT64": KindFloat64, "FIXEDSTRING": KindString,
+		"DATETIME": KindTimestamp, "DATETIME64": KindTimestamp,
+	},
+}
+
+// goTypes maps the names of Go types, as reported by the columns of FromData,
+// to canonical types.
+var goTypes = map[string]Kind{
+	"bool": KindBoolean,
+	"int8": KindInt16, "int16": KindInt16, "uint8": KindInt16,
+	"int32": KindInt32, "uint16": KindInt32,
+	"int": KindInt64, "int64": KindInt64, "uint": KindInt64, "uint32": KindInt64, "uint64": KindInt64,
+	"float32": KindFloat32, "float64": KindFloat64,
+	"string": KindString, "[]uint8": KindBinary, "json.Number": KindDecimal,
+	"time.Time": KindTimestampTZ, "time.Duration": KindInterval,
+	"json.RawMessage": KindJSON,
+}
+
+// Types recognized by scanTypeOf.
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	durationType   = reflect.TypeOf(time.Duration(0))
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+	jsonNumberType = reflect.TypeOf(json.Number(""))
+)
+
+// scanTypeOf returns the canonical type of values of the Go type typ.
+func scanTypeOf(typ reflect.Type) Kind {
+	if typ == nil {
+		return KindUnknown
+	}
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	switch typ {
+	case timeType:
+		return KindTimestampTZ
+	case durationType:
+		return KindInterval
+	case rawMessageType:
+		return KindJSON
+	case jsonNumberType:
+		return KindDecimal
+	}
+	if typ.PkgPath() == "database/sql" {
+		switch typ.Name() {
+		case "NullBool":
+			return KindBoolean
+		case "NullByte", "NullInt16":
+			return KindInt16
+		case "NullInt32":
+			return KindInt32
+		case "NullInt64":
+			return KindInt64
+		case "NullFloat64":
+			return KindFloat64
+		case "NullString":
+			return KindString
+		case "NullTime":
+			return KindTimestampTZ
+		}
+	}
+	switch typ.Kind() {
+	case reflect.Bool:
+		return KindBoolean
+	case reflect.Int8, reflect.Int16, reflect.Uint8:
+		return KindInt16
+	case reflect.Int32, reflect.Uint16:
+		return KindInt32
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return KindInt64
+	case reflect.Float32:
+		return KindFloat32
+	case reflect.Float64:
+		return KindFloat64
+	case reflect.String:
+		return KindString
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return KindBinary
+		}
+		return KindArray
+	case reflect.Map:
+		return KindMap
+	case reflect.Struct:
+		return KindStruct
+	}
+	return KindUnknown
+}
@@ -0,0 +1,46 @@
+package scanner
+
+import "testing"
+
+func TestNormalizeTypeName(t *testing.T) {
+	tests := []struct {
+		driver, name string
+		want         Kind
+	}{
+		{"postgres", "INT8", KindInt64},
+		{"mysql", "BIGINT", KindInt64},
+		{"sqlite3", "INTEGER", KindInt64},
+		{"pgx", "int4", KindInt32},
+		{"clickhouse", "Int8", KindInt16},
+		{"clickhouse", "Nullable(DateTime64(3))", KindTimestamp},
+		{"mysql", "FLOAT", KindFloat32},
+		{"sqlserver", "FLOAT", KindFloat64},
+		{"sqlserver", "BIT", KindBoolean},
+		{"mysql", "UNSIGNED INT", KindInt32},
+		{"postgres", "VARCHAR(255)", KindString},
+		{"postgres", "_INT4", KindArray},
+		{"oracle", "TIMESTAMP(6) WITH TIME ZONE", KindTimestampTZ},
+		{"oracle", "DATE", KindTimestamp},
+		{"", "DECIMAL(10, 2)", KindDecimal},
+		{"", "geometry", KindUnknown},
+	}
+	for _, tt := range tests {
+		if got := NormalizeTypeName(tt.driver, tt.name); got != tt.want {
+			t.Errorf("NormalizeTypeName(%q, %q) = %v, want %v", tt.driver, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestColumnKind(t *testing.T) {
+	rows := FromData([][]any{{int32(1), "a", 1.5, []byte("x"), nil}})
+	cols, err := rows.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Kind{KindInt32, KindString, KindFloat64, KindBinary, KindUnknown}
+	for i, col := range cols {
+		if got := ColumnKind(col); got != want[i] {
+			t.Errorf("column %d: got %v, want %v", i, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// This file implements splitting an export into shards by the hash of a key column.
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// shardBufferSize is the number of rows buffered per shard when shards are encoded in parallel.
+const shardBufferSize = 64
+
+// WithShards splits the rows written by WriteShards across n shards. Rows are assigned
+// by the hash of the value of keyColumn, so that all rows with the same key end up in
+// the same shard; if keyColumn is empty, and for NULL keys, rows are distributed
+// round-robin. Every shard is a complete document written with the codec.
+func WithShards(n int, keyColumn string) Option {
+	return func(e *Exporter) {
+		e.shards = n
+		e.shardKey = keyColumn
+	}
+}
+
+// WithParallelShards encodes the shards written by WriteShards concurrently instead
+// of one row at a time. Rows are copied and buffered per shard, which uses more memory
+// but lets the codecs of different shards run in parallel.
+func WithParallelShards(parallel bool) Option {
+	return func(e *Exporter) {
+		e.parallelShards = parallel
+	}
+}
+
+// ShardFiles returns an open function for WriteShards that creates one file per
+// shard, named by formatting pattern with the shard number, e.g. "part-%03d.csv".
+func ShardFiles(pattern string) func(shard int) (Destination, error) {
+	return func(shard int) (Destination, error) {
+		return FileDestination(fmt.Sprintf(pattern, shard))
+	}
+}
+
+// WriteShards splits the rows across the shards configured with WithShards and writes
+// every shard to the destination returned by open for it. Without WithShards all rows
+// are written to a single shard. Destinations are closed once all shards have been
+// written successfully; if any shard fails, all destinations are aborted if they
+// implement Aborter and closed otherwise. Rejects, checks and column statistics
+// cover all rows; a check failing with WithFailOnCheck fails the export and aborts
+// the destinations. WithMaxBytes, WithIntegrityFooter and WithAsyncWrite are not
+// supported. If the source rows implement io.Closer, they are closed once the
+// export has finished or failed.
+func (cs *Exporter) WriteShards(open func(shard int) (Destination, error)) (err error) {
+	defer func() {
+		if closeErr := scanner.Close(cs.rows); err == nil {
+			err = closeErr
+		}
+	}()
+	if err := cs.errSplitOutput(); err != nil {
+		return err
+	}
+	src, observers := cs.observedSource()
+	cols, err := src.Columns()
+	if err != nil {
+		return err
+	}
+	keyIndex := -1
+	if cs.shardKey != "" {
+		for i, col := range cols {
+			if col.Name() == cs.shardKey {
+				keyIndex = i
+			}
+		}
+		if keyIndex < 0 {
+			return fmt.Errorf("exporter: unknown column %q", cs.shardKey)
+		}
+	}
+
+	n := max(cs.shards, 1)
+	dests := make([]Destination, 0, n)
+	for i := range n {
+		dest, err := open(i)
+		if err != nil {
+			finishDestinations(dests, err)
+			return fmt.Errorf("exporter: failed to open shard %d: %w", i, err)
+		}
+		dests = append(dests, dest)
+	}
+
+	shards := make([]*shardRows, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range shards {
+		shards[i] = newShardRows(cols, src.Driver(), cs.parallelShards)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(shards[i].done)
+			errs[i] = cs.writeShard(dests[i], shards[i])
+		}()
+	}
+
+	dispatchErr := dispatchShards(src, shards, keyIndex, cs.parallelShards)
+	for _, shard := range shards {
+		close(shard.rows)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			errs[i] = &DestinationError{Index: i, Err: err}
+		}
+	}
+	err = errors.Join(append([]error{dispatchErr}, errs...)...)
+	err = observers.finish(cs, &Stats{}, err)
+	return errors.Join(err, finishDestinations(dests, err))
+}
+
+// writeShard writes a single shard with the codec.
+func (cs *Exporter) writeShard(dest Destination, rows scanner.Rows) error {
+	out, closeOut, err := cs.compressor(dest)
+	if err != nil {
+		return err
+	}
+	err = cs.export(out, rows)
+	if closeErr := closeOut(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// dispatchShards reads the source and hands every row over to its shard.
+func dispatchShards(src scanner.Rows, shards []*shardRows, keyIndex int, parallel bool) error {
+	next := 0
+	for src.Next() {
+		values, err := src.ScanRow()
+		if err != nil {
+			return err
+		}
+		shard := -1
+		if keyIndex >= 0 && keyIndex < len(values) {
+			if key := tostring.ToString(values[keyIndex]); !key.IsNULL {
+				h := fnv.New64a()
+				h.Write([]byte(key.String))
+				shard = int(h.Sum64() % uint64(len(shards)))
+			}
+		}
+		if shard < 0 {
+			shard = next
+			next = (next + 1) % len(shards)
+		}
+		if parallel {
+			// The source may reuse the row once Next is called again.
+			values = scanner.CloneRow(values)
+		}
+		shards[shard].send(values)
+	}
+	return src.Err()
+}
+
+// finishDestinations closes all destinations, or aborts them if err is not nil.
+func finishDestinations(dests []Destination, err error) error {
+	var errs []error
+	for i, dest := range dests {
+		var finishErr error
+		if aborter, ok := dest.(Aborter); ok && err != nil {
+			finishErr = aborter.Abort(err)
+		} else {
+			finishErr = dest.Close()
+		}
+		if finishErr != nil {
+			errs = append(errs, &DestinationError{Index: i, Err: finishErr})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// shardRows is the Rows of a single shard, fed by dispatchShards.
+type shardRows struct {
+	columns []scanner.Column
+	driver  string
+
+	rows    chan []any
+	current []any
+	done    chan struct{}
+
+	// processed is signalled when the codec has finished with the current row,
+	// so that rows are encoded one at a time when shards are not parallel.
+	processed chan struct{}
+	pending   bool
+}
+
+// newShardRows creates the Rows of a shard.
+func newShardRows(columns []scanner.Column, driver string, parallel bool) *shardRows {
+	s := &shardRows{
+		columns: columns,
+		driver:  driver,
+		done:    make(chan struct{}),
+	}
+	if parallel {
+		s.rows = make(chan []any, shardBufferSize)
+	} else {
+		s.rows = make(chan []any)
+		s.processed = make(chan struct{}, 1)
+	}
+	return s
+}
+
+// send hands a row over to the shard. Rows for a shard that has stopped reading are dropped.
+func (s *shardRows) send(row []any) {
+	select {
+	case s.rows <- row:
+	case <-s.done:
+		return
+	}
+	if s.processed != nil {
+		select {
+		case <-s.processed:
+		case <-s.done:
+		}
+	}
+}
+
+// Next waits for the next row of the shard.
+func (s *shardRows) Next() bool {
+	if s.pending {
+		s.pending = false
+		s.processed <- struct{}{}
+	}
+	row, ok := <-s.rows
+	s.current = row
+	s.pending = ok && s.processed != nil
+	return ok
+}
+
+// ScanRow returns the current row.
+func (s *shardRows) ScanRow() ([]any, error) {
+	return s.current, nil
+}
+
+// Columns returns the columns of the source.
+func (s *shardRows) Columns() ([]scanner.Column, error) {
+	return s.columns, nil
+}
+
+// Driver returns the driver of the source.
+func (s *shardRows) Driver() string {
+	return s.driver
+}
+
+// Err returns nil; errors of the source are reported by WriteShards.
+func (s *shardRows) Err() error {
+	return nil
+}
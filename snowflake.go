@@ -0,0 +1,118 @@
+// This file implements a destination that uploads an export to a Snowflake stage.
+
+package exporter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SnowflakeOption defines a functional option for configuring SnowflakeStageDestination.
+type SnowflakeOption func(*snowflakeDestination)
+
+// WithSnowflakeCopyInto runs COPY INTO table from the uploaded file once it has been
+// staged. fileFormat is the content of the FILE_FORMAT clause matching the codec,
+// e.g. "TYPE = CSV SKIP_HEADER = 1" or "FORMAT_NAME = my_csv_format".
+func WithSnowflakeCopyInto(table, fileFormat string) SnowflakeOption {
+	return func(d *snowflakeDestination) {
+		d.copyTable = table
+		d.fileFormat = fileFormat
+	}
+}
+
+// WithSnowflakeOverwrite replaces a staged file of the same name instead of skipping the upload.
+func WithSnowflakeOverwrite(overwrite bool) SnowflakeOption {
+	return func(d *snowflakeDestination) {
+		d.overwrite = overwrite
+	}
+}
+
+// SnowflakeStageDestination returns a Destination that uploads the export to the
+// Snowflake internal stage, e.g. "@my_stage/exports", as a file named fileName.
+// db must use the Snowflake driver, which implements the PUT command. The export
+// is written to a temporary local file, which PUT compresses with gzip unless it is
+// already compressed (see WithCompression; name the file with a .gz suffix then).
+//
+// Close uploads the file and runs the COPY INTO configured with WithSnowflakeCopyInto.
+// Abort discards the file without uploading it. The temporary file is removed in both cases.
+func SnowflakeStageDestination(ctx context.Context, db *sql.DB, stage, fileName string, opts ...SnowflakeOption) (Destination, error) {
+	dir, err := os.MkdirTemp("", "exporter-snowflake-")
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Create(filepath.Join(dir, fileName))
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	d := &snowflakeDestination{
+		File:  f,
+		ctx:   ctx,
+		db:    db,
+		stage: stage,
+		dir:   dir,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d, nil
+}
+
+// snowflakeDestination writes the export to a temporary file and stages it on Close.
+type snowflakeDestination struct {
+	*os.File
+
+	ctx        context.Context
+	db         *sql.DB
+	stage      string
+	dir        string
+	overwrite  bool
+	copyTable  string
+	fileFormat string
+}
+
+// Close uploads the file to the stage and optionally copies it into the table.
+func (d *snowflakeDestination) Close() error {
+	defer os.RemoveAll(d.dir)
+	if err := d.File.Close(); err != nil {
+		return err
+	}
+	put := fmt.Sprintf("PUT '%s' %s", fileURL(d.File.Name()), d.stage)
+	if d.overwrite {
+		put += " OVERWRITE = TRUE"
+	}
+	if _, err := d.db.ExecContext(d.ctx, put); err != nil {
+		return fmt.Errorf("snowflake: failed to upload to stage: %w", err)
+	}
+	if d.copyTable == "" {
+		return nil
+	}
+	staged := strings.TrimSuffix(d.stage, "/") + "/" + filepath.Base(d.File.Name())
+	copyInto := fmt.Sprintf("COPY INTO %s FROM %s", d.copyTable, staged)
+	if d.fileFormat != "" {
+		copyInto += fmt.Sprintf(" FILE_FORMAT = (%s)", d.fileFormat)
+	}
+	if _, err := d.db.ExecContext(d.ctx, copyInto); err != nil {
+		return fmt.Errorf("snowflake: failed to copy into %s: %w", d.copyTable, err)
+	}
+	return nil
+}
+
+// Abort removes the temporary file without uploading it.
+func (d *snowflakeDestination) Abort(error) error {
+	return errors.Join(d.File.Close(), os.RemoveAll(d.dir))
+}
+
+// fileURL returns the file:// URL of a local path as expected by PUT.
+func fileURL(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path // Windows drive letters, e.g. file:///C:/...
+	}
+	return "file://" + strings.ReplaceAll(path, "'", `\'`)
+}
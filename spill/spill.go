@@ -0,0 +1,376 @@
+// Package spill provides a row buffer that moves rows to a temporary file once
+// a memory limit is reached, for wrappers that must hold a whole source, such as
+// the old source of a diff, so that they handle sources larger than RAM with
+// predictable memory use.
+package spill
+
+import (
+	"bufio"
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// ErrDiskLimit is returned by Append when the temporary file would exceed the
+// limit set with WithDiskLimit.
+var ErrDiskLimit = errors.New("spill: disk limit exceeded")
+
+// Option defines a functional option for configuring a Buffer.
+type Option func(*Buffer)
+
+// WithDir sets the directory of the temporary file (default os.TempDir).
+func WithDir(dir string) Option {
+	return func(b *Buffer) {
+		b.dir = dir
+	}
+}
+
+// WithMemoryLimit sets the estimated number of bytes of rows held in memory. Once
+// the limit is reached, further rows are written to the temporary file. A
+// non-positive value means no limit (default), so that nothing is spilled.
+func WithMemoryLimit(bytes int64) Option {
+	return func(b *Buffer) {
+		b.memoryLimit = bytes
+	}
+}
+
+// WithDiskLimit limits the size of the temporary file. A non-positive value means
+// no limit (default).
+func WithDiskLimit(bytes int64) Option {
+	return func(b *Buffer) {
+		b.diskLimit = bytes
+	}
+}
+
+// Buffer is an append-only list of rows with random access. The first rows are
+// kept in memory up to the memory limit, the others in a temporary file, which
+// is removed by Close. Values of types other than nil, booleans, numbers, strings,
+// byte slices, times and durations are spilled in their string form as converted
+// by tostring; driver.Valuer values are spilled as their driver value. A Buffer
+// is not safe for concurrent use.
+type Buffer struct {
+	dir         string
+	memoryLimit int64
+	diskLimit   int64
+
+	rows   [][]any // The rows held in memory.
+	memory int64   // The estimated size of rows.
+
+	file    *os.File
+	w       *bufio.Writer
+	offsets []int64 // The offsets of the spilled rows in the file.
+	size    int64   // The number of bytes written to the file.
+	buf     []byte
+}
+
+// New returns an empty Buffer.
+func New(opts ...Option) *Buffer {
+	b := &Buffer{}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Len returns the number of rows.
+func (b *Buffer) Len() int {
+	return len(b.rows) + len(b.offsets)
+}
+
+// Spilled reports whether rows have been written to the temporary file.
+func (b *Buffer) Spilled() bool {
+	return len(b.offsets) != 0
+}
+
+// Append adds a row. Rows held in memory are kept as they are, so the buffer
+// takes ownership of row.
+func (b *Buffer) Append(row []any) error {
+	if b.file == nil && (b.memoryLimit <= 0 || b.memory < b.memoryLimit) {
+		b.rows = append(b.rows, row)
+		b.memory += rowSize(row)
+		return nil
+	}
+	if b.file == nil {
+		f, err := os.CreateTemp(b.dir, "exporter-spill-*")
+		if err != nil {
+			return fmt.Errorf("spill: %w", err)
+		}
+		b.file, b.w = f, bufio.NewWriter(f)
+	}
+	b.buf = appendRow(b.buf[:0], row)
+	if b.diskLimit > 0 && b.size+int64(len(b.buf)) > b.diskLimit {
+		return ErrDiskLimit
+	}
+	if _, err := b.w.Write(b.buf); err != nil {
+		return fmt.Errorf("spill: %w", err)
+	}
+	b.offsets = append(b.offsets, b.size)
+	b.size += int64(len(b.buf))
+	return nil
+}
+
+// Row returns the i-th row. Spilled rows are read into a new slice.
+func (b *Buffer) Row(i int) ([]any, error) {
+	if i < len(b.rows) {
+		return b.rows[i], nil
+	}
+	i -= len(b.rows)
+	if err := b.w.Flush(); err != nil {
+		return nil, fmt.Errorf("spill: %w", err)
+	}
+	end := b.size
+	if i+1 < len(b.offsets) {
+		end = b.offsets[i+1]
+	}
+	data := make([]byte, end-b.offsets[i])
+	if _, err := b.file.ReadAt(data, b.offsets[i]); err != nil {
+		return nil, fmt.Errorf("spill: %w", err)
+	}
+	return decodeRow(data)
+}
+
+// Close releases the rows and removes the temporary file.
+func (b *Buffer) Close() error {
+	b.rows, b.offsets = nil, nil
+	if b.file == nil {
+		return nil
+	}
+	f := b.file
+	b.file, b.w = nil, nil
+	return errors.Join(f.Close(), os.Remove(f.Name()))
+}
+
+// Value tags of the file format.
+const (
+	tagNil byte = iota
+	tagFalse
+	tagTrue
+	tagInt
+	tagInt8
+	tagInt16
+	tagInt32
+	tagInt64
+	tagUint
+	tagUint8
+	tagUint16
+	tagUint32
+	tagUint64
+	tagFloat32
+	tagFloat64
+	tagString
+	tagBytes
+	tagTime
+	tagDuration
+)
+
+// rowSize returns the estimated memory size of a row.
+func rowSize(row []any) int64 {
+	size := int64(24 + 16*len(row))
+	for _, v := range row {
+		switch v := v.(type) {
+		case string:
+			size += int64(len(v))
+		case []byte:
+			size += int64(24 + len(v))
+		case time.Time:
+			size += 24
+		default:
+			size += 8
+		}
+	}
+	return size
+}
+
+// appendRow appends the encoding of row to dst: the number of values followed by
+// a tag and a payload per value.
+func appendRow(dst []byte, row []any) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(row)))
+	for _, v := range row {
+		dst = appendValue(dst, v)
+	}
+	return dst
+}
+
+// appendValue appends the encoding of v to dst.
+func appendValue(dst []byte, v any) []byte {
+	switch v := v.(type) {
+	case nil:
+		return append(dst, tagNil)
+	case bool:
+		if v {
+			return append(dst, tagTrue)
+		}
+		return append(dst, tagFalse)
+	case int:
+		return binary.AppendVarint(append(dst, tagInt), int64(v))
+	case int8:
+		return binary.AppendVarint(append(dst, tagInt8), int64(v))
+	case int16:
+		return binary.AppendVarint(append(dst, tagInt16), int64(v))
+	case int32:
+		return binary.AppendVarint(append(dst, tagInt32), int64(v))
+	case int64:
+		return binary.AppendVarint(append(dst, tagInt64), v)
+	case uint:
+		return binary.AppendUvarint(append(dst, tagUint), uint64(v))
+	case uint8:
+		return binary.AppendUvarint(append(dst, tagUint8), uint64(v))
+	case uint16:
+		return binary.AppendUvarint(append(dst, tagUint16), uint64(v))
+	case uint32:
+		return binary.AppendUvarint(append(dst, tagUint32), uint64(v))
+	case uint64:
+		return binary.AppendUvarint(append(dst, tagUint64), v)
+	case float32:
+		return binary.LittleEndian.AppendUint32(append(dst, tagFloat32), math.Float32bits(v))
+	case float64:
+		return binary.LittleEndian.AppendUint64(append(dst, tagFloat64), math.Float64bits(v))
+	case string:
+		return append(binary.AppendUvarint(append(dst, tagString), uint64(len(v))), v...)
+	case []byte:
+		if v == nil {
+			return append(dst, tagNil)
+		}
+		return append(binary.AppendUvarint(append(dst, tagBytes), uint64(len(v))), v...)
+	case time.Time:
+		data, err := v.MarshalBinary()
+		if err != nil {
+			// Times with offsets that cannot be encoded keep their instant in UTC.
+			data, _ = v.UTC().MarshalBinary()
+		}
+		return append(binary.AppendUvarint(append(dst, tagTime), uint64(len(data))), data...)
+	case time.Duration:
+		return binary.AppendVarint(append(dst, tagDuration), int64(v))
+	case driver.Valuer:
+		if value, err := v.Value(); err == nil {
+			return appendValue(dst, value)
+		}
+	}
+	s := tostring.ToString(v)
+	if s.IsNULL {
+		return append(dst, tagNil)
+	}
+	return appendValue(dst, s.String)
+}
+
+// decodeRow decodes a row encoded by appendRow.
+func decodeRow(data []byte) ([]any, error) {
+	d := decoder{data: data}
+	n := d.uvarint()
+	if d.err != nil || n > uint64(len(data)) {
+		return nil, errCorrupt
+	}
+	row := make([]any, n)
+	for i := range row {
+		row[i] = d.value()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return row, nil
+}
+
+// errCorrupt is returned when the temporary file cannot be decoded.
+var errCorrupt = errors.New("spill: corrupt temporary file")
+
+// decoder reads values from an encoded row.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+// value reads a tagged value.
+func (d *decoder) value() any {
+	if d.err != nil || len(d.data) == 0 {
+		d.err = errCorrupt
+		return nil
+	}
+	tag := d.data[0]
+	d.data = d.data[1:]
+	switch tag {
+	case tagNil:
+		return nil
+	case tagFalse:
+		return false
+	case tagTrue:
+		return true
+	case tagInt:
+		return int(d.varint())
+	case tagInt8:
+		return int8(d.varint())
+	case tagInt16:
+		return int16(d.varint())
+	case tagInt32:
+		return int32(d.varint())
+	case tagInt64:
+		return d.varint()
+	case tagUint:
+		return uint(d.uvarint())
+	case tagUint8:
+		return uint8(d.uvarint())
+	case tagUint16:
+		return uint16(d.uvarint())
+	case tagUint32:
+		return uint32(d.uvarint())
+	case tagUint64:
+		return d.uvarint()
+	case tagFloat32:
+		return math.Float32frombits(binary.LittleEndian.Uint32(d.bytes(4)))
+	case tagFloat64:
+		return math.Float64frombits(binary.LittleEndian.Uint64(d.bytes(8)))
+	case tagString:
+		return string(d.bytes(int(d.uvarint())))
+	case tagBytes:
+		return append([]byte{}, d.bytes(int(d.uvarint()))...)
+	case tagTime:
+		var t time.Time
+		if err := t.UnmarshalBinary(d.bytes(int(d.uvarint()))); err != nil && d.err == nil {
+			d.err = errCorrupt
+		}
+		return t
+	case tagDuration:
+		return time.Duration(d.varint())
+	}
+	d.err = errCorrupt
+	return nil
+}
+
+// varint reads a signed varint.
+func (d *decoder) varint() int64 {
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.err = errCorrupt
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// uvarint reads an unsigned varint.
+func (d *decoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errCorrupt
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// bytes reads n bytes, or records an error and returns zeroed bytes enough for
+// any fixed-size value if the data is too short.
+func (d *decoder) bytes(n int) []byte {
+	if n < 0 || n > len(d.data) {
+		d.err = errCorrupt
+		return make([]byte, 8)
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
@@ -0,0 +1,86 @@
+package spill
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuffer(t *testing.T) {
+	dir := t.TempDir()
+	b := New(WithDir(dir), WithMemoryLimit(1))
+	ts := time.Date(2024, 5, 1, 12, 30, 0, 0, time.FixedZone("", 2*3600))
+	rows := [][]any{
+		{1, "in memory"},
+		{nil, true, false, 1, int8(-2), int16(3), int32(-4), int64(5), uint(6), uint8(7), uint16(8), uint32(9), uint64(10)},
+		{float32(1.5), 2.25, "text", []byte("bytes"), []byte(nil), ts, 3 * time.Second, struct{ A int }{1}},
+	}
+	for _, row := range rows {
+		if err := b.Append(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if b.Len() != 3 || !b.Spilled() {
+		t.Fatalf("Len() = %d, Spilled() = %v", b.Len(), b.Spilled())
+	}
+	want := rows
+	want[2] = []any{float32(1.5), 2.25, "text", []byte("bytes"), nil, ts, 3 * time.Second, `{"A":1}`}
+	for i := range rows {
+		got, err := b.Row(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 2 {
+			if !got[5].(time.Time).Equal(ts) {
+				t.Errorf("time %v, want %v", got[5], ts)
+			}
+			got[5] = ts
+		}
+		if !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("row %d = %#v, want %#v", i, got, want[i])
+		}
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("temporary file was not removed: %v", entries)
+	}
+}
+
+func TestBufferDiskLimit(t *testing.T) {
+	b := New(WithDir(t.TempDir()), WithMemoryLimit(1), WithDiskLimit(10))
+	defer b.Close()
+	if err := b.Append([]any{"kept in memory"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Append([]any{"short"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Append([]any{"too long for the limit"}); !errors.Is(err, ErrDiskLimit) {
+		t.Errorf("got %v, want ErrDiskLimit", err)
+	}
+	if b.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", b.Len())
+	}
+}
+
+func TestBufferInMemory(t *testing.T) {
+	dir := t.TempDir()
+	b := New(WithDir(dir))
+	row := []any{1, "a"}
+	for range 100 {
+		if err := b.Append(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if b.Spilled() {
+		t.Error("rows were spilled without a memory limit")
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("unexpected temporary file: %v", entries)
+	}
+	b.Close()
+}
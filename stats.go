@@ -0,0 +1,131 @@
+// This file implements export statistics and the byte budget.
+
+package exporter
+
+import (
+	"io"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// Stats describes a completed export.
+type Stats struct {
+	Rows      int64 // Number of rows written by the codec (or read, see scanner.WriteTracker).
+	Bytes     int64 // Number of bytes written to the destination.
+	Truncated bool  // Whether the export was stopped early by WithMaxBytes.
+	Rejected  int64 // Number of rows written to the rejects output of WithRejects.
+
+	// Columns holds per-column statistics if enabled with WithColumnStats.
+	Columns []ColumnStats
+
+	// Checks holds the results of the checks configured with WithChecks.
+	Checks []CheckResult
+}
+
+// WithMaxBytes stops the export cleanly once n bytes have been written: no further
+// rows are read, the codec finishes the document (closing tags and brackets) and
+// Stats.Truncated is set. The budget is checked between rows, so the output may
+// exceed n by the last row, data still buffered by the codec and the format trailer;
+// choose n with that margin in mind. A non-positive n means no limit (default).
+func WithMaxBytes(n int64) Option {
+	return func(e *Exporter) {
+		e.maxBytes = n
+	}
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	io.Writer
+	n int64
+}
+
+// Write writes p to the underlying writer and counts the written bytes.
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+// statsRows wraps a Rows to count rows and to stop reading once the byte budget is spent.
+type statsRows struct {
+	scanner.Rows
+
+	stats    *Stats
+	written  *countingWriter
+	maxBytes int64
+	metadata map[string]string
+
+	read        int64 // Number of rows read by the codec.
+	tracked     bool  // Whether the codec reports its written rows.
+	writtenRows int64 // Number of rows reported as written by the codec.
+}
+
+// Next reports whether another row is available and within the byte budget.
+func (s *statsRows) Next() bool {
+	if s.maxBytes > 0 && s.written.n >= s.maxBytes {
+		if s.Rows.Next() {
+			s.stats.Truncated = true
+		}
+		return false
+	}
+	return s.Rows.Next()
+}
+
+// ScanRow returns the current row and counts it as read.
+func (s *statsRows) ScanRow() ([]any, error) {
+	row, err := s.Rows.ScanRow()
+	if err == nil {
+		s.read++
+	}
+	return row, err
+}
+
+// TrackWrites records that the codec reports its written rows.
+func (s *statsRows) TrackWrites() {
+	s.tracked = true
+}
+
+// RowWritten counts a row written by the codec.
+func (s *statsRows) RowWritten() {
+	s.writtenRows++
+}
+
+// rowsWritten returns the number of rows written by the codec, or the number of
+// rows read by it if the codec does not report its written rows.
+func (s *statsRows) rowsWritten() int64 {
+	if s.tracked {
+		return s.writtenRows
+	}
+	return s.read
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (s *statsRows) RawValue(column int) (any, bool) {
+	return scanner.RawValue(s.Rows, column)
+}
+
+// EstimateRows returns the row estimate of the underlying rows.
+func (s *statsRows) EstimateRows() (int64, bool) {
+	return scanner.EstimateRows(s.Rows)
+}
+
+// Describe returns the metadata of the export.
+func (s *statsRows) Describe() map[string]string {
+	return s.metadata
+}
+
+// statsBatchRows is a statsRows over a source implementing scanner.BatchScanner.
+// It forwards batch reads, so that codecs keep reading such sources in batches.
+// It is only used without a byte budget, which is checked between single rows.
+type statsBatchRows struct {
+	*statsRows
+	batch scanner.BatchScanner
+}
+
+// ScanBatch reads a batch of rows from the source and counts them as read. Rows
+// read ahead in a batch are only counted as written once the codec writes them.
+func (s *statsBatchRows) ScanBatch(dst [][]any) (int, error) {
+	n, err := s.batch.ScanBatch(dst)
+	s.read += int64(n)
+	return n, err
+}
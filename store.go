@@ -0,0 +1,125 @@
+// This file implements a local store that skips delivering unchanged exports.
+
+package exporter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ExportStore records the checksum of every export delivered under a name in a
+// local directory, so that an export identical to the last one delivered under the
+// same name is not written or uploaded again. This saves bandwidth for recurring
+// jobs whose data rarely changes. Since the codecs write the column names into the
+// output, a changed schema changes the checksum as well.
+type ExportStore struct {
+	dir string
+}
+
+// NewExportStore returns an ExportStore keeping its records in dir, which is created if needed.
+func NewExportStore(dir string) (*ExportStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &ExportStore{dir: dir}, nil
+}
+
+// Checksum returns the SHA-256 checksum, hex-encoded, of the export last delivered under name.
+func (s *ExportStore) Checksum(name string) (string, bool) {
+	data, err := os.ReadFile(s.recordPath(name))
+	if err != nil {
+		return "", false
+	}
+	return strings.TrimSpace(string(data)), true
+}
+
+// Destination returns a Destination that writes the export to a temporary file in
+// the store directory while computing its checksum. On Close, if the checksum equals
+// the one of the export last delivered under name, the file is discarded and open is
+// not called. Otherwise open is called, the file is copied to the returned destination,
+// which is then closed, and the checksum is recorded. Abort discards the file.
+func (s *ExportStore) Destination(name string, open func() (Destination, error)) (Destination, error) {
+	f, err := os.CreateTemp(s.dir, "export-*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	return &storeDestination{
+		Writer: io.MultiWriter(f, h),
+		store:  s,
+		name:   name,
+		open:   open,
+		file:   f,
+		hash:   h,
+	}, nil
+}
+
+// recordPath returns the path of the checksum record of name.
+func (s *ExportStore) recordPath(name string) string {
+	key := sha256.Sum256([]byte(name))
+	return filepath.Join(s.dir, hex.EncodeToString(key[:])+".sha256")
+}
+
+// record stores the checksum of the export delivered under name.
+func (s *ExportStore) record(name, checksum string) error {
+	path := s.recordPath(name)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(checksum+"\n"), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// storeDestination buffers an export in a temporary file and delivers it on Close if it changed.
+type storeDestination struct {
+	io.Writer
+
+	store *ExportStore
+	name  string
+	open  func() (Destination, error)
+	file  *os.File
+	hash  hash.Hash
+}
+
+// Close delivers the export unless it is identical to the last one delivered under the name.
+func (d *storeDestination) Close() error {
+	defer os.Remove(d.file.Name())
+	defer d.file.Close()
+	checksum := hex.EncodeToString(d.hash.Sum(nil))
+	if last, ok := d.store.Checksum(d.name); ok && last == checksum {
+		return nil
+	}
+	if _, err := d.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	dest, err := d.open()
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dest, d.file); err != nil {
+		if aborter, ok := dest.(Aborter); ok {
+			return errors.Join(err, aborter.Abort(err))
+		}
+		return errors.Join(err, dest.Close())
+	}
+	if err := dest.Close(); err != nil {
+		return err
+	}
+	if err := d.store.record(d.name, checksum); err != nil {
+		return fmt.Errorf("exporter: failed to record checksum: %w", err)
+	}
+	return nil
+}
+
+// Abort discards the buffered export.
+func (d *storeDestination) Abort(error) error {
+	d.file.Close()
+	return os.Remove(d.file.Name())
+}
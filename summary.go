@@ -0,0 +1,145 @@
+// This file implements the summary documents written next to file exports.
+
+package exporter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// SummarySuffix is appended to the name of an export file to name its summary.
+const SummarySuffix = ".summary.json"
+
+// Summary describes a file export. It is written as JSON next to the file by
+// WriteFile and WriteFilePattern with WithSummaryFile, so that downstream
+// automation can verify a delivery without parsing the data file.
+type Summary struct {
+	File      string               `json:"file"`     // Base name of the export file.
+	Finished  time.Time            `json:"finished"` // Zero with WithDeterministicOutput.
+	Rows      int64                `json:"rows"`
+	Bytes     int64                `json:"bytes"`
+	Truncated bool                 `json:"truncated,omitempty"`
+	Rejected  int64                `json:"rejected,omitempty"`
+	Checksum  string               `json:"checksum"` // SHA-256 of the file, hex-encoded.
+	Schema    *scanner.TableSchema `json:"schema"`
+	Metadata  map[string]string    `json:"metadata,omitempty"`
+	Options   SummaryOptions       `json:"options"`
+	Columns   []ColumnStats        `json:"columns,omitempty"` // Collected with WithColumnStats.
+	Checks    []CheckResult        `json:"checks,omitempty"`  // Results of WithChecks.
+}
+
+// SummaryOptions records the export options that affect the content of the file.
+type SummaryOptions struct {
+	Codec         string `json:"codec"` // Go type of the codec.
+	Compression   string `json:"compression,omitempty"`
+	MaxBytes      int64  `json:"max_bytes,omitempty"`
+	MaxCellLength int    `json:"max_cell_length,omitempty"`
+	Deterministic bool   `json:"deterministic,omitempty"`
+	NullPolicy    int    `json:"null_policy,omitempty"`
+	TimeZone      string `json:"time_zone,omitempty"`
+	Transforms    int    `json:"transforms,omitempty"` // Number of value transformations.
+
+	IntegrityFooter bool `json:"integrity_footer,omitempty"`
+}
+
+// WithSummaryFile writes a summary of every file export written with WriteFile or
+// WriteFilePattern to a file named after the export with SummarySuffix, e.g.
+// users.csv.summary.json, holding the statistics, schema, checksum, metadata and
+// options of the export. The summary is only written if the export succeeds.
+func WithSummaryFile(summary bool) Option {
+	return func(e *Exporter) {
+		e.summaryFile = summary
+	}
+}
+
+// checksumWriter hashes the bytes written to a named file.
+type checksumWriter struct {
+	io.Writer
+	name string
+	hash hash.Hash
+}
+
+// Write writes p to the underlying writer and hashes the bytes written.
+func (w *checksumWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.hash.Write(p[:n])
+	return n, err
+}
+
+// Name returns the name of the file, which identifies the destination in audit records.
+func (w *checksumWriter) Name() string {
+	return w.name
+}
+
+// exportFile exports to the file f and returns the statistics of the export and
+// its summary if WithSummaryFile is set, or nil otherwise.
+func (cs *Exporter) exportFile(f *os.File) (Stats, *Summary, error) {
+	if !cs.summaryFile {
+		stats, err := cs.Export(f)
+		return stats, nil, err
+	}
+	schema, schemaErr := scanner.Schema(cs.rows)
+	metadata := cs.describe(cs.rows, time.Now())
+	w := &checksumWriter{Writer: f, name: f.Name(), hash: sha256.New()}
+	stats, err := cs.Export(w)
+	if err == nil {
+		err = schemaErr
+	}
+	if err != nil {
+		return stats, nil, err
+	}
+	s := &Summary{
+		File:      filepath.Base(f.Name()),
+		Rows:      stats.Rows,
+		Bytes:     stats.Bytes,
+		Truncated: stats.Truncated,
+		Rejected:  stats.Rejected,
+		Checksum:  hex.EncodeToString(w.hash.Sum(nil)),
+		Schema:    schema,
+		Metadata:  metadata,
+		Options: SummaryOptions{
+			Codec:         fmt.Sprintf("%T", cs.codec),
+			MaxBytes:      cs.maxBytes,
+			MaxCellLength: cs.maxCellLength,
+			Deterministic: cs.deterministic,
+			NullPolicy:    int(cs.nullPolicy),
+			Transforms:    len(cs.transforms),
+
+			IntegrityFooter: cs.integrityFooter,
+		},
+		Columns: stats.Columns,
+		Checks:  stats.Checks,
+	}
+	if cs.timeZone != nil {
+		s.Options.TimeZone = cs.timeZone.String()
+	}
+	if cs.compression == CompressionGzip {
+		s.Options.Compression = "gzip"
+	}
+	if !cs.deterministic {
+		s.Finished = time.Now().UTC()
+	}
+	return stats, s, nil
+}
+
+// writeSummary writes the summary of the export file filename, if any.
+func writeSummary(filename string, s *Summary) error {
+	if s == nil {
+		return nil
+	}
+	s.File = filepath.Base(filename)
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename+SummarySuffix, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,42 @@
+// This file implements the normalization of time values to a single time zone.
+
+package exporter
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// WithTimeZone converts every time.Time and valid sql.NullTime value to loc
+// before it is formatted, so that sources whose sessions use different time
+// zones produce consistent timestamps in a single file. It applies to all codecs
+// and runs after the user-defined transformations. A nil loc disables the
+// conversion (default). With WithDeterministicOutput, loc replaces UTC.
+func WithTimeZone(loc *time.Location) Option {
+	return func(e *Exporter) {
+		e.timeZone = loc
+	}
+}
+
+// WithUTC converts all time values to UTC; see WithTimeZone.
+func WithUTC() Option {
+	return WithTimeZone(time.UTC)
+}
+
+// inTimeZone returns a transformation converting time values to loc.
+func inTimeZone(loc *time.Location) func(v any, _ scanner.Metadata) (any, error) {
+	return func(v any, _ scanner.Metadata) (any, error) {
+		switch t := v.(type) {
+		case time.Time:
+			return t.In(loc), nil
+		case sql.NullTime:
+			if t.Valid {
+				t.Time = t.Time.In(loc)
+			}
+			return t, nil
+		}
+		return v, nil
+	}
+}
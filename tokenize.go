@@ -0,0 +1,164 @@
+// This file implements the tokenization of sensitive column values.
+
+package exporter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/go-data-exporter/exporter/scanner"
+	"github.com/go-data-exporter/exporter/tostring"
+)
+
+// tokenizeRounds is the number of Feistel rounds used to tokenize digits.
+const tokenizeRounds = 10
+
+// maxTokenizedDigits is the longest digit string tokenized with its format preserved.
+// Both halves of longer strings would overflow the uint64 arithmetic of the rounds.
+const maxTokenizedDigits = 36
+
+// WithTokenizedColumns replaces the values of the named columns with tokens derived
+// from key, so that PII is removed from the export while the tokenized columns stay
+// joinable across tables and exports that use the same key.
+//
+// Integers and strings of decimal digits, such as numeric IDs, are tokenized with
+// format-preserving encryption: the token is an integer, or a string of digits, with
+// the same number of digits and no two values share a token. Other values are replaced
+// with a keyed hash (HMAC-SHA256) of their string form, encoded as 32 hex characters.
+// NULL values are left untouched. The key must be kept secret: anyone holding it can
+// recompute tokens, and reverse those of numeric values.
+func WithTokenizedColumns(key []byte, columnNames ...string) Option {
+	t := &tokenizer{key: append([]byte(nil), key...)}
+	return func(e *Exporter) {
+		for _, name := range columnNames {
+			e.transforms = append(e.transforms, transform{column: name, fn: t.tokenize})
+		}
+	}
+}
+
+// tokenizer derives tokens from values with a secret key.
+type tokenizer struct {
+	key []byte
+}
+
+// tokenize returns the token of v.
+func (t *tokenizer) tokenize(v any, _ scanner.Metadata) (any, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case int:
+		return int(t.signed(int64(v), math.MinInt, math.MaxInt)), nil
+	case int8:
+		return int8(t.signed(int64(v), math.MinInt8, math.MaxInt8)), nil
+	case int16:
+		return int16(t.signed(int64(v), math.MinInt16, math.MaxInt16)), nil
+	case int32:
+		return int32(t.signed(int64(v), math.MinInt32, math.MaxInt32)), nil
+	case int64:
+		return t.signed(v, math.MinInt64, math.MaxInt64), nil
+	case uint:
+		return uint(t.unsigned(uint64(v), 0, math.MaxUint)), nil
+	case uint8:
+		return uint8(t.unsigned(uint64(v), 0, math.MaxUint8)), nil
+	case uint16:
+		return uint16(t.unsigned(uint64(v), 0, math.MaxUint16)), nil
+	case uint32:
+		return uint32(t.unsigned(uint64(v), 0, math.MaxUint32)), nil
+	case uint64:
+		return t.unsigned(v, 0, math.MaxUint64), nil
+	}
+	s := tostring.ToString(v)
+	if s.IsNULL {
+		return nil, nil
+	}
+	if isDigits(s.String) && len(s.String) <= maxTokenizedDigits {
+		return t.digits(s.String), nil
+	}
+	mac := hmac.New(sha256.New, t.key)
+	mac.Write([]byte("hash:"))
+	mac.Write([]byte(s.String))
+	return hex.EncodeToString(mac.Sum(nil)[:16]), nil
+}
+
+// signed tokenizes an integer within [lo, hi], keeping its sign and number of digits.
+func (t *tokenizer) signed(v, lo, hi int64) int64 {
+	if v < 0 {
+		// The absolute value of lo does not fit into an int64, but into a uint64.
+		return -int64(t.unsigned(uint64(-(v+1))+1, 1, uint64(-(lo+1))+1))
+	}
+	return int64(t.unsigned(uint64(v), 0, uint64(hi)))
+}
+
+// unsigned tokenizes an integer within [lo, hi], keeping its number of digits.
+// The digits are encrypted until the result is a number with the same number of
+// digits within [lo, hi] (cycle walking). As v itself lies in that domain, this
+// terminates and maps the domain one-to-one onto itself.
+func (t *tokenizer) unsigned(v, lo, hi uint64) uint64 {
+	s := strconv.FormatUint(v, 10)
+	for {
+		s = t.digits(s)
+		token, err := strconv.ParseUint(s, 10, 64)
+		if err == nil && (s[0] != '0' || len(s) == 1) && token >= lo && token <= hi {
+			return token
+		}
+	}
+}
+
+// digits encrypts a string of decimal digits with a Feistel network over the digits,
+// which maps every string of the same length to a distinct string.
+func (t *tokenizer) digits(s string) string {
+	u := len(s) / 2
+	a, b := s[:u], s[u:]
+	for i := range tokenizeRounds {
+		m := len(a)
+		num, _ := strconv.ParseUint("0"+a, 10, 64)
+		mod := pow10(m)
+		c := (num + t.round(i, len(s), b)%mod) % mod
+		a, b = b, formatDigits(c, m)
+	}
+	return a + b
+}
+
+// round returns the keyed round function of round i applied to the half b.
+func (t *tokenizer) round(i, n int, b string) uint64 {
+	mac := hmac.New(sha256.New, t.key)
+	mac.Write([]byte{'f', 'p', 'e', byte(i), byte(n)})
+	mac.Write([]byte(b))
+	return binary.BigEndian.Uint64(mac.Sum(nil))
+}
+
+// pow10 returns 10 to the power of n.
+func pow10(n int) uint64 {
+	p := uint64(1)
+	for range n {
+		p *= 10
+	}
+	return p
+}
+
+// formatDigits formats c as exactly n digits, padded with leading zeros.
+func formatDigits(c uint64, n int) string {
+	if n == 0 {
+		return ""
+	}
+	s := strconv.FormatUint(c, 10)
+	return strings.Repeat("0", n-len(s)) + s
+}
+
+// isDigits reports whether s is a non-empty string of ASCII decimal digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
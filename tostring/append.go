@@ -0,0 +1,41 @@
+// Package tostring provides functionality to convert arbitrary Go values into strings.
+// This file implements allocation-free conversion into caller-provided byte buffers.
+package tostring
+
+import "strings"
+
+// AppendFor is like ToStringFor but appends the string representation of v to dst
+// and returns the extended buffer along with a flag indicating if v was NULL.
+// Nothing is appended for NULL values.
+func (c *Converter) AppendFor(dst []byte, v any, driver, typeName string) ([]byte, bool) {
+	if v == nil {
+		return dst, true
+	}
+	if len(c.databaseTypes) != 0 && typeName != "" {
+		if _, ok := c.databaseTypes[databaseType{driver, strings.ToUpper(typeName)}]; ok {
+			return appendString(dst, c.ToStringFor(v, driver, typeName))
+		}
+		if _, ok := c.databaseTypes[databaseType{"", strings.ToUpper(typeName)}]; ok {
+			return appendString(dst, c.ToStringFor(v, driver, typeName))
+		}
+	}
+	return c.Append(dst, v)
+}
+
+// Append is like ToString but appends the string representation of v to dst
+// and returns the extended buffer along with a flag indicating if v was NULL.
+// Values are formatted directly into dst; nothing is appended for NULL values.
+func (c *Converter) Append(dst []byte, v any) ([]byte, bool) {
+	if v == nil {
+		return dst, true
+	}
+	return c.appendValue(dst, v)
+}
+
+// appendString appends a converted String to dst.
+func appendString(dst []byte, s String) ([]byte, bool) {
+	if s.IsNULL {
+		return dst, true
+	}
+	return append(dst, s.String...), false
+}
@@ -0,0 +1,145 @@
+// Package tostring provides functionality to convert arbitrary Go values into strings.
+// This file implements conversion of arbitrary-precision numbers (math/big and decimal types).
+package tostring
+
+import (
+	"math/big"
+	"strings"
+)
+
+// RoundingMode defines how arbitrary-precision numbers are rounded to the configured scale.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest value, with ties away from zero (default).
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest value, with ties to the even digit (banker's rounding).
+	RoundHalfEven
+	// RoundDown truncates toward zero.
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+)
+
+// maxRepeatingScale is the number of fractional digits used for values that have
+// no finite decimal representation (such as 1/3) when no scale is configured.
+const maxRepeatingScale = 20
+
+// ratConverter is implemented by decimal types that can expose their exact value,
+// such as github.com/shopspring/decimal.Decimal.
+type ratConverter interface {
+	Rat() *big.Rat
+}
+
+// WithDecimalScale sets the number of fractional digits used for arbitrary-precision
+// numbers (big.Int, big.Float, big.Rat and decimal types). A negative scale renders
+// the exact value (default).
+func WithDecimalScale(scale int) Option {
+	return func(c *Converter) {
+		c.decimalScale = scale
+	}
+}
+
+// WithDecimalRounding sets the rounding mode applied when a decimal scale is configured.
+func WithDecimalRounding(mode RoundingMode) Option {
+	return func(c *Converter) {
+		c.decimalRounding = mode
+	}
+}
+
+// formatBigInt formats an integer, padding it with fractional zeros if a scale is set.
+func (c *Converter) formatBigInt(v *big.Int) string {
+	if c.decimalScale <= 0 {
+		return v.String()
+	}
+	return v.String() + "." + strings.Repeat("0", c.decimalScale)
+}
+
+// formatBigFloat formats a big.Float using the configured scale and rounding.
+func (c *Converter) formatBigFloat(v *big.Float) String {
+	if c.decimalScale < 0 || v.IsInf() {
+		return String{c.number(v.Text('f', -1)), false}
+	}
+	r, _ := v.Rat(nil)
+	return String{c.number(formatRat(r, c.decimalScale, c.decimalRounding)), false}
+}
+
+// formatRat formats a rational number using the configured scale and rounding.
+// Without a scale, terminating decimals are rendered exactly and repeating
+// decimals are rounded to maxRepeatingScale digits.
+func (c *Converter) formatRat(v *big.Rat) string {
+	scale := c.decimalScale
+	if scale < 0 {
+		var ok bool
+		if scale, ok = terminatingScale(v.Denom()); !ok {
+			scale = maxRepeatingScale
+		}
+	}
+	return formatRat(v, scale, c.decimalRounding)
+}
+
+// terminatingScale returns the number of fractional digits needed to represent
+// a fraction with the given denominator exactly, if it is finite.
+func terminatingScale(denom *big.Int) (int, bool) {
+	d := new(big.Int).Set(denom)
+	two, five := big.NewInt(2), big.NewInt(5)
+	rem := new(big.Int)
+	var twos, fives int
+	for {
+		if q, r := new(big.Int).QuoRem(d, two, rem); r.Sign() == 0 {
+			d, twos = q, twos+1
+			continue
+		}
+		break
+	}
+	for {
+		if q, r := new(big.Int).QuoRem(d, five, rem); r.Sign() == 0 {
+			d, fives = q, fives+1
+			continue
+		}
+		break
+	}
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return 0, false
+	}
+	return max(twos, fives), true
+}
+
+// formatRat renders r as a decimal string with exactly scale fractional digits.
+func formatRat(r *big.Rat, scale int, mode RoundingMode) string {
+	num := new(big.Int).Mul(r.Num(), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil))
+	den := r.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 {
+		twice := new(big.Int).Abs(rem)
+		twice.Lsh(twice, 1)
+		cmp := twice.Cmp(den)
+		increment := false
+		switch mode {
+		case RoundHalfUp:
+			increment = cmp >= 0
+		case RoundHalfEven:
+			increment = cmp > 0 || (cmp == 0 && q.Bit(0) == 1)
+		case RoundUp:
+			increment = true
+		}
+		if increment {
+			q.Add(q, big.NewInt(int64(num.Sign())))
+		}
+	}
+
+	digits := new(big.Int).Abs(q).String()
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	var b strings.Builder
+	if q.Sign() < 0 {
+		b.WriteByte('-')
+	}
+	b.WriteString(digits[:len(digits)-scale])
+	if scale > 0 {
+		b.WriteByte('.')
+		b.WriteString(digits[len(digits)-scale:])
+	}
+	return b.String()
+}
@@ -0,0 +1,137 @@
+// Package tostring provides functionality to convert arbitrary Go values into strings.
+// This file implements conversion of time.Duration and driver interval types.
+package tostring
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationFormat defines how time.Duration and interval values are rendered.
+type DurationFormat int
+
+const (
+	// DurationGo renders durations using time.Duration.String, e.g. "1h2m3.5s" (default).
+	DurationGo DurationFormat = iota
+	// DurationClock renders durations as HH:MM:SS with optional fractional seconds, e.g. "01:02:03.5".
+	DurationClock
+	// DurationSeconds renders durations as the total number of seconds, e.g. "3723.5".
+	DurationSeconds
+)
+
+// Interval is a calendar interval as reported by SQL drivers (for example pgtype.Interval).
+// Months and days are kept separate from the time part because their length varies.
+type Interval struct {
+	Months       int32
+	Days         int32
+	Microseconds int64
+}
+
+// WithDurationFormat sets the format used for time.Duration and interval values.
+func WithDurationFormat(format DurationFormat) Option {
+	return func(c *Converter) {
+		c.durationFormat = format
+	}
+}
+
+// formatDuration renders a duration in the configured format.
+func (c *Converter) formatDuration(d time.Duration) string {
+	switch c.durationFormat {
+	case DurationClock:
+		return formatClock(d)
+	case DurationSeconds:
+		return strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+	}
+	return d.String()
+}
+
+// formatInterval renders an interval. With DurationSeconds the total number of
+// seconds is computed assuming 30-day months and 24-hour days; otherwise months
+// and days are written as words followed by the time part, e.g. "1 year 2 mons 3 days 04:05:06".
+func (c *Converter) formatInterval(iv Interval) string {
+	timePart := time.Duration(iv.Microseconds) * time.Microsecond
+	if c.durationFormat == DurationSeconds {
+		days := int64(iv.Months)*30 + int64(iv.Days)
+		seconds := float64(days*86400) + timePart.Seconds()
+		return strconv.FormatFloat(seconds, 'f', -1, 64)
+	}
+	var parts []string
+	if years := iv.Months / 12; years != 0 {
+		parts = append(parts, plural(int64(years), "year", "years"))
+	}
+	if months := iv.Months % 12; months != 0 {
+		parts = append(parts, plural(int64(months), "mon", "mons"))
+	}
+	if iv.Days != 0 {
+		parts = append(parts, plural(int64(iv.Days), "day", "days"))
+	}
+	if timePart != 0 || len(parts) == 0 {
+		parts = append(parts, c.formatDuration(timePart))
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatClock renders a duration as [-]HH:MM:SS[.fraction].
+func formatClock(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	h := d / time.Hour
+	m := (d % time.Hour) / time.Minute
+	s := (d % time.Minute) / time.Second
+	ns := d % time.Second
+	out := fmt.Sprintf("%s%02d:%02d:%02d", sign, h, m, s)
+	if ns != 0 {
+		out += strings.TrimRight(fmt.Sprintf(".%09d", ns), "0")
+	}
+	return out
+}
+
+// plural formats a count with the singular or plural unit.
+func plural(n int64, singular, pluralForm string) string {
+	if n == 1 || n == -1 {
+		return strconv.FormatInt(n, 10) + " " + singular
+	}
+	return strconv.FormatInt(n, 10) + " " + pluralForm
+}
+
+// intervalOf detects driver interval types structurally: a struct with integer
+// Months, Days and Microseconds fields and an optional Valid flag, which matches
+// pgtype.Interval and similar types without importing their drivers.
+func intervalOf(rv reflect.Value) (iv Interval, valid, ok bool) {
+	if rv.Kind() != reflect.Struct {
+		return Interval{}, false, false
+	}
+	months := rv.FieldByName("Months")
+	days := rv.FieldByName("Days")
+	micros := rv.FieldByName("Microseconds")
+	if !isInt(months) || !isInt(days) || !isInt(micros) {
+		return Interval{}, false, false
+	}
+	valid = true
+	if v := rv.FieldByName("Valid"); v.IsValid() && v.Kind() == reflect.Bool {
+		valid = v.Bool()
+	}
+	return Interval{
+		Months:       int32(months.Int()),
+		Days:         int32(days.Int()),
+		Microseconds: micros.Int(),
+	}, valid, true
+}
+
+// isInt reports whether v is a valid field of a signed integer kind.
+func isInt(v reflect.Value) bool {
+	if !v.IsValid() {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
@@ -4,8 +4,14 @@
 package tostring
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +22,9 @@ import (
 // jsonStd is a high-performance JSON encoder/decoder compatible with the standard library.
 var jsonStd = jsoniter.ConfigCompatibleWithStandardLibrary
 
+// defaultConverter is the converter used by the package-level ToString function.
+var defaultConverter = New()
+
 // String represents a string value along with a flag indicating whether it was NULL.
 // If IsNULL is true, then the value should be considered as NULL or absent.
 type String struct {
@@ -23,76 +32,422 @@ type String struct {
 	IsNULL bool
 }
 
+// BytesEncoding defines how []byte values are rendered.
+type BytesEncoding int
+
+const (
+	// BytesRaw renders []byte values as-is (default).
+	BytesRaw BytesEncoding = iota
+	// BytesHex renders []byte values as lowercase hexadecimal.
+	BytesHex
+	// BytesBase64 renders []byte values using standard base64 encoding.
+	BytesBase64
+)
+
+// Converter converts arbitrary values to String using configurable formatting rules.
+// A Converter is safe for concurrent use once created.
+type Converter struct {
+	timeLayout    string
+	location      *time.Location
+	floatFormat   byte
+	floatPrec     int
+	trueLiteral   string
+	falseLiteral  string
+	bytesEncoding BytesEncoding
+
+	decimalSeparator string
+	groupSeparator   string
+
+	decimalScale    int
+	decimalRounding RoundingMode
+	durationFormat  DurationFormat
+
+	databaseTypes map[databaseType]func(v any) String
+
+	zeroTimeIsNULL  bool
+	emptyJSONIsNULL bool
+	nullPredicate   func(v any) bool
+}
+
+// Option defines a functional option for configuring a Converter.
+type Option func(*Converter)
+
+// New creates a new Converter with the provided options.
+// Without options it behaves exactly like the package-level ToString function.
+func New(opts ...Option) *Converter {
+	c := &Converter{
+		timeLayout:      time.RFC3339Nano,
+		floatFormat:     'f',
+		floatPrec:       -1,
+		trueLiteral:     "true",
+		falseLiteral:    "false",
+		decimalScale:    -1,
+		zeroTimeIsNULL:  true,
+		emptyJSONIsNULL: true,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithTimeLayout sets the layout used to format time.Time values (default is time.RFC3339Nano).
+func WithTimeLayout(layout string) Option {
+	return func(c *Converter) {
+		c.timeLayout = layout
+	}
+}
+
+// WithTimeZone converts time.Time values to the given location before formatting.
+// A nil location keeps the location of each value (default).
+func WithTimeZone(loc *time.Location) Option {
+	return func(c *Converter) {
+		c.location = loc
+	}
+}
+
+// WithFloatFormat sets the format and precision used for float values,
+// as accepted by strconv.FormatFloat (default is 'f' with precision -1).
+func WithFloatFormat(format byte, prec int) Option {
+	return func(c *Converter) {
+		c.floatFormat = format
+		c.floatPrec = prec
+	}
+}
+
+// WithBoolLiterals sets the strings used for true and false (default is "true"/"false").
+func WithBoolLiterals(trueLiteral, falseLiteral string) Option {
+	return func(c *Converter) {
+		c.trueLiteral = trueLiteral
+		c.falseLiteral = falseLiteral
+	}
+}
+
+// WithNumberSeparators sets the decimal separator and the digit group separator
+// of numbers, e.g. "," and "." for German, for reports read by people. Groups
+// have three digits. An empty decimal separator keeps "." and an empty group
+// separator disables grouping (default). Numbers in exponent notation keep their
+// exponent; NaN and infinities are not changed.
+func WithNumberSeparators(decimal, group string) Option {
+	return func(c *Converter) {
+		c.decimalSeparator = decimal
+		c.groupSeparator = group
+	}
+}
+
+// WithBytesEncoding sets how []byte values are rendered (default is BytesRaw).
+func WithBytesEncoding(encoding BytesEncoding) Option {
+	return func(c *Converter) {
+		c.bytesEncoding = encoding
+	}
+}
+
+// TreatZeroTimeAsNull controls whether the zero time.Time is reported as NULL (default is true).
+// When disabled, the zero time is formatted like any other time value.
+func TreatZeroTimeAsNull(isNULL bool) Option {
+	return func(c *Converter) {
+		c.zeroTimeIsNULL = isNULL
+	}
+}
+
+// TreatEmptyJSONAsNull controls whether values whose JSON representation is
+// "null", "[]" or "{}" are reported as NULL (default is true). When disabled,
+// empty arrays and objects are rendered as "[]" and "{}"; a JSON null is still NULL.
+func TreatEmptyJSONAsNull(isNULL bool) Option {
+	return func(c *Converter) {
+		c.emptyJSONIsNULL = isNULL
+	}
+}
+
+// WithNullPredicate sets a function that is consulted before any conversion.
+// If it returns true for a value, the value is reported as NULL.
+func WithNullPredicate(fn func(v any) bool) Option {
+	return func(c *Converter) {
+		c.nullPredicate = fn
+	}
+}
+
+// databaseType identifies a column type as reported by a specific driver.
+type databaseType struct {
+	driver   string
+	typeName string
+}
+
+// WithDatabaseType registers a conversion function for values of columns whose
+// DatabaseTypeName is typeName (case-insensitive) and whose driver is driver.
+// An empty driver matches columns from any driver. Registered functions take
+// precedence over the Go type based conversion, so values such as a MySQL DECIMAL
+// delivered as []byte or a Hive TIMESTAMP delivered as string can be normalized
+// in one place. They are only consulted by ToStringFor.
+func WithDatabaseType(driver, typeName string, fn func(v any) String) Option {
+	return func(c *Converter) {
+		if c.databaseTypes == nil {
+			c.databaseTypes = make(map[databaseType]func(v any) String)
+		}
+		c.databaseTypes[databaseType{driver, strings.ToUpper(typeName)}] = fn
+	}
+}
+
+// ToString converts an arbitrary value to a String using the default converter.
+// See Converter.ToString for details.
+func ToString(v any) String {
+	return defaultConverter.ToString(v)
+}
+
+// ToStringFor converts a value from a column with the given driver and database
+// type name. Functions registered with WithDatabaseType for the exact driver are
+// preferred over those registered for any driver; if none matches, the value is
+// converted with ToString. NULL values are never passed to registered functions.
+func (c *Converter) ToStringFor(v any, driver, typeName string) String {
+	if v == nil {
+		return String{"", true}
+	}
+	if len(c.databaseTypes) != 0 && typeName != "" {
+		typeName = strings.ToUpper(typeName)
+		if fn, ok := c.databaseTypes[databaseType{driver, typeName}]; ok {
+			return fn(v)
+		}
+		if fn, ok := c.databaseTypes[databaseType{"", typeName}]; ok {
+			return fn(v)
+		}
+	}
+	return c.ToString(v)
+}
+
 // ToString converts an arbitrary value to a String type, which contains
 // a string representation of the value and a flag indicating if the value was NULL.
 //
 // The conversion logic supports common Go primitive types, slices, time.Time,
-// and types implementing json.Marshaler or fmt.Stringer interfaces.
+// time.Duration and interval types, the sql.Null* types, math/big numbers, decimal types exposing Rat() *big.Rat
+// (such as shopspring/decimal), pointers, and types implementing json.Marshaler,
+// fmt.Stringer or driver.Valuer interfaces.
 //
 // If the input is nil or represents an empty/null value (like zero time,
 // "null", "[]", or "{}" in JSON), the result will have IsNULL set to true.
-func ToString(v any) String {
-	if v == nil {
+// Which values are considered NULL is controlled by TreatZeroTimeAsNull,
+// TreatEmptyJSONAsNull and WithNullPredicate.
+func (c *Converter) ToString(v any) String {
+	if s, ok := v.(string); ok && c.nullPredicate == nil {
+		// Strings are returned as-is, without copying them through a buffer.
+		return String{s, false}
+	}
+	buf, isNULL := c.Append(nil, v)
+	if isNULL {
 		return String{"", true}
 	}
+	return String{string(buf), false}
+}
+
+// appendValue appends the string representation of a non-nil value to dst. It is
+// the single conversion switch shared by ToString and Append.
+func (c *Converter) appendValue(dst []byte, v any) ([]byte, bool) {
+	if c.nullPredicate != nil && c.nullPredicate(v) {
+		return dst, true
+	}
 	switch v := v.(type) {
 	case string:
-		return String{v, false}
+		return append(dst, v...), false
 	case []byte:
-		return String{string(v), false}
+		switch c.bytesEncoding {
+		case BytesHex:
+			return hex.AppendEncode(dst, v), false
+		case BytesBase64:
+			return base64.StdEncoding.AppendEncode(dst, v), false
+		}
+		return append(dst, v...), false
 	case bool:
-		return String{strconv.FormatBool(v), false}
+		if v {
+			return append(dst, c.trueLiteral...), false
+		}
+		return append(dst, c.falseLiteral...), false
 	case int:
-		return String{strconv.Itoa(v), false}
+		return c.appendNumber(dst, strconv.AppendInt(dst, int64(v), 10)), false
 	case int8:
-		return String{strconv.FormatInt(int64(v), 10), false}
+		return c.appendNumber(dst, strconv.AppendInt(dst, int64(v), 10)), false
 	case int16:
-		return String{strconv.FormatInt(int64(v), 10), false}
+		return c.appendNumber(dst, strconv.AppendInt(dst, int64(v), 10)), false
 	case int32:
-		return String{strconv.FormatInt(int64(v), 10), false}
+		return c.appendNumber(dst, strconv.AppendInt(dst, int64(v), 10)), false
 	case int64:
-		return String{strconv.FormatInt(v, 10), false}
+		return c.appendNumber(dst, strconv.AppendInt(dst, v, 10)), false
 	case uint:
-		return String{strconv.FormatUint(uint64(v), 10), false}
+		return c.appendNumber(dst, strconv.AppendUint(dst, uint64(v), 10)), false
 	case uint8:
-		return String{strconv.FormatUint(uint64(v), 10), false}
+		return c.appendNumber(dst, strconv.AppendUint(dst, uint64(v), 10)), false
 	case uint16:
-		return String{strconv.FormatUint(uint64(v), 10), false}
+		return c.appendNumber(dst, strconv.AppendUint(dst, uint64(v), 10)), false
 	case uint32:
-		return String{strconv.FormatUint(uint64(v), 10), false}
+		return c.appendNumber(dst, strconv.AppendUint(dst, uint64(v), 10)), false
 	case uint64:
-		return String{strconv.FormatUint(v, 10), false}
+		return c.appendNumber(dst, strconv.AppendUint(dst, v, 10)), false
 	case time.Time:
-		// TODO (research): does zero time mean NULL?
-		if v.IsZero() {
-			return String{"", true}
+		if c.zeroTimeIsNULL && v.IsZero() {
+			return dst, true
+		}
+		if c.location != nil {
+			v = v.In(c.location)
 		}
-		return String{v.Format(time.RFC3339Nano), false}
+		return v.AppendFormat(dst, c.timeLayout), false
+	case time.Duration:
+		return append(dst, c.formatDuration(v)...), false
+	case Interval:
+		return append(dst, c.formatInterval(v)...), false
 	case float32:
-		return String{strconv.FormatFloat(float64(v), 'f', -1, 32), false}
+		return c.appendNumber(dst, strconv.AppendFloat(dst, float64(v), c.floatFormat, c.floatPrec, 32)), false
 	case float64:
-		return String{strconv.FormatFloat(v, 'f', -1, 64), false}
+		return c.appendNumber(dst, strconv.AppendFloat(dst, v, c.floatFormat, c.floatPrec, 64)), false
+	case sql.NullString:
+		if !v.Valid {
+			return dst, true
+		}
+		return append(dst, v.String...), false
+	case sql.NullInt64:
+		if !v.Valid {
+			return dst, true
+		}
+		return c.appendValue(dst, v.Int64)
+	case sql.NullInt32:
+		if !v.Valid {
+			return dst, true
+		}
+		return c.appendValue(dst, v.Int32)
+	case sql.NullInt16:
+		if !v.Valid {
+			return dst, true
+		}
+		return c.appendValue(dst, v.Int16)
+	case sql.NullByte:
+		if !v.Valid {
+			return dst, true
+		}
+		return c.appendValue(dst, v.Byte)
+	case sql.NullFloat64:
+		if !v.Valid {
+			return dst, true
+		}
+		return c.appendValue(dst, v.Float64)
+	case sql.NullBool:
+		if !v.Valid {
+			return dst, true
+		}
+		return c.appendValue(dst, v.Bool)
+	case sql.NullTime:
+		if !v.Valid {
+			return dst, true
+		}
+		return c.appendValue(dst, v.Time)
+	case *big.Int:
+		if v == nil {
+			return dst, true
+		}
+		return append(dst, c.number(c.formatBigInt(v))...), false
+	case big.Int:
+		return append(dst, c.number(c.formatBigInt(&v))...), false
+	case *big.Float:
+		if v == nil {
+			return dst, true
+		}
+		return appendString(dst, c.formatBigFloat(v))
+	case big.Float:
+		return appendString(dst, c.formatBigFloat(&v))
+	case *big.Rat:
+		if v == nil {
+			return dst, true
+		}
+		return append(dst, c.number(c.formatRat(v))...), false
+	case big.Rat:
+		return append(dst, c.number(c.formatRat(&v))...), false
+	case ratConverter:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return dst, true
+		}
+		return append(dst, c.number(c.formatRat(v.Rat()))...), false
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return dst, true
+		}
+		// *T is converted like T, e.g. *time.Time with the configured layout.
+		return c.Append(dst, rv.Elem().Interface())
+	}
+	if iv, valid, ok := intervalOf(rv); ok {
+		if !valid {
+			return dst, true
+		}
+		return append(dst, c.formatInterval(iv)...), false
 	}
 	if jsonMarshaler, ok := v.(json.Marshaler); ok {
 		if jsonData, err := jsonMarshaler.MarshalJSON(); err == nil {
-			s := strings.Trim(string(jsonData), `"`)
-			// TODO (research): does [], {} mean NULL?
-			if s == "[]" || s == "{}" || s == "null" {
-				return String{"", true}
-			}
-			return String{s, false}
+			return appendString(dst, c.fromJSON(jsonData))
 		}
 	}
 	if fmtStringer, ok := v.(fmt.Stringer); ok {
-		return String{fmtStringer.String(), false}
+		return append(dst, fmtStringer.String()...), false
+	}
+	if valuer, ok := v.(driver.Valuer); ok {
+		// Generic nullable wrappers such as sql.Null[T].
+		if value, err := valuer.Value(); err == nil {
+			return c.Append(dst, value)
+		}
 	}
 	if jsonData, err := jsonStd.Marshal(v); err == nil {
-		s := strings.Trim(string(jsonData), `"`)
-		// TODO (research): does [], {} mean NULL?
-		if s == "[]" || s == "{}" || s == "null" {
-			return String{"", true}
+		return appendString(dst, c.fromJSON(jsonData))
+	}
+	return fmt.Appendf(dst, "%v", v), false
+}
+
+// appendNumber applies the configured separators to the number formatted at the
+// end of buf, which extends dst.
+func (c *Converter) appendNumber(dst, buf []byte) []byte {
+	if c.decimalSeparator == "" && c.groupSeparator == "" {
+		return buf
+	}
+	return append(buf[:len(dst)], c.number(string(buf[len(dst):]))...)
+}
+
+// number applies the configured separators to a formatted number.
+func (c *Converter) number(s string) string {
+	if c.decimalSeparator == "" && c.groupSeparator == "" {
+		return s
+	}
+	sign, digits := "", s
+	if digits != "" && (digits[0] == '-' || digits[0] == '+') {
+		sign, digits = digits[:1], digits[1:]
+	}
+	exponent := ""
+	if i := strings.IndexAny(digits, "eE"); i >= 0 {
+		digits, exponent = digits[:i], digits[i:]
+	}
+	intPart, fraction, hasFraction := strings.Cut(digits, ".")
+	if intPart == "" || strings.Trim(intPart, "0123456789") != "" {
+		return s
+	}
+	var b strings.Builder
+	b.WriteString(sign)
+	for i := range len(intPart) {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(c.groupSeparator)
 		}
-		return String{s, false}
+		b.WriteByte(intPart[i])
+	}
+	if hasFraction {
+		if c.decimalSeparator == "" {
+			b.WriteByte('.')
+		} else {
+			b.WriteString(c.decimalSeparator)
+		}
+		b.WriteString(fraction)
+	}
+	b.WriteString(exponent)
+	return b.String()
+}
+
+// fromJSON converts JSON-encoded data to a String, applying the empty JSON NULL rules.
+func (c *Converter) fromJSON(jsonData []byte) String {
+	s := strings.Trim(string(jsonData), `"`)
+	if s == "null" || (c.emptyJSONIsNULL && (s == "[]" || s == "{}")) {
+		return String{"", true}
 	}
-	return String{fmt.Sprintf("%v", v), false}
+	return String{s, false}
 }
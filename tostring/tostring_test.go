@@ -0,0 +1,270 @@
+package tostring
+
+import (
+	"database/sql"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestToString(t *testing.T) {
+	ts := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	tests := []struct {
+		v    any
+		want String
+	}{
+		{nil, String{"", true}},
+		{"text", String{"text", false}},
+		{[]byte("bytes"), String{"bytes", false}},
+		{true, String{"true", false}},
+		{int64(-42), String{"-42", false}},
+		{uint8(7), String{"7", false}},
+		{3.14, String{"3.14", false}},
+		{ts, String{"2024-05-06T07:08:09Z", false}},
+		{time.Time{}, String{"", true}},
+		{[]int{1, 2}, String{"[1,2]", false}},
+		{[]int{}, String{"", true}},
+	}
+	for _, tt := range tests {
+		if got := ToString(tt.v); got != tt.want {
+			t.Errorf("ToString(%#v) = %#v, want %#v", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestConverterOptions(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	c := New(
+		WithTimeLayout(time.DateTime),
+		WithTimeZone(moscow),
+		WithFloatFormat('f', 2),
+		WithBoolLiterals("t", "f"),
+		WithBytesEncoding(BytesHex),
+	)
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC), "2024-05-06 10:08:09"},
+		{3.14159, "3.14"},
+		{float32(2.5), "2.50"},
+		{true, "t"},
+		{false, "f"},
+		{[]byte{0xde, 0xad}, "dead"},
+	}
+	for _, tt := range tests {
+		if got := c.ToString(tt.v); got.String != tt.want || got.IsNULL {
+			t.Errorf("ToString(%#v) = %#v, want %q", tt.v, got, tt.want)
+		}
+	}
+
+	if got := New(WithBytesEncoding(BytesBase64)).ToString([]byte("hi")).String; got != "aGk=" {
+		t.Errorf("base64 encoding: got %q", got)
+	}
+}
+
+func TestConverterNULLSemantics(t *testing.T) {
+	c := New(TreatZeroTimeAsNull(false), TreatEmptyJSONAsNull(false))
+	if got := c.ToString(time.Time{}); got.IsNULL || got.String != "0001-01-01T00:00:00Z" {
+		t.Errorf("zero time: got %#v", got)
+	}
+	if got := c.ToString([]int{}); got.IsNULL || got.String != "[]" {
+		t.Errorf("empty slice: got %#v", got)
+	}
+	if got := c.ToString(map[string]int{}); got.IsNULL || got.String != "{}" {
+		t.Errorf("empty map: got %#v", got)
+	}
+
+	c = New(WithNullPredicate(func(v any) bool { return v == "N/A" }))
+	if got := c.ToString("N/A"); !got.IsNULL {
+		t.Errorf("predicate: got %#v", got)
+	}
+	if got := c.ToString("value"); got.IsNULL {
+		t.Errorf("predicate: got %#v", got)
+	}
+}
+
+func TestSQLNullAndPointers(t *testing.T) {
+	str := "value"
+	var nilStr *string
+	tests := []struct {
+		v    any
+		want String
+	}{
+		{sql.NullString{String: "x", Valid: true}, String{"x", false}},
+		{sql.NullString{}, String{"", true}},
+		{sql.NullInt64{Int64: 5, Valid: true}, String{"5", false}},
+		{sql.NullInt64{}, String{"", true}},
+		{sql.NullFloat64{Float64: 1.5, Valid: true}, String{"1.5", false}},
+		{sql.NullTime{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}, String{"2024-01-01T00:00:00Z", false}},
+		{sql.NullTime{}, String{"", true}},
+		{sql.Null[int]{V: 3, Valid: true}, String{"3", false}},
+		{sql.Null[int]{}, String{"", true}},
+		{&str, String{"value", false}},
+		{nilStr, String{"", true}},
+	}
+	for _, tt := range tests {
+		if got := ToString(tt.v); got != tt.want {
+			t.Errorf("ToString(%#v) = %#v, want %#v", tt.v, got, tt.want)
+		}
+	}
+
+	// Pointers are converted like their values, not through MarshalJSON.
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	c := New(WithTimeLayout(time.DateTime))
+	if got := c.ToString(&ts); got != (String{"2024-01-02 03:04:05", false}) {
+		t.Errorf("ToString(*time.Time) = %#v", got)
+	}
+	if got := c.ToString(&time.Time{}); !got.IsNULL {
+		t.Errorf("ToString(zero *time.Time) = %#v, want NULL", got)
+	}
+}
+
+// decimalStub mimics decimal types such as shopspring/decimal.Decimal.
+type decimalStub struct{ r *big.Rat }
+
+func (d decimalStub) Rat() *big.Rat { return d.r }
+
+func TestDecimals(t *testing.T) {
+	bigInt, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	bigFloat, _ := new(big.Float).SetPrec(200).SetString("12345678901234567890.125")
+	tests := []struct {
+		c    *Converter
+		v    any
+		want string
+	}{
+		{New(), bigInt, "123456789012345678901234567890"},
+		{New(WithDecimalScale(2)), big.NewInt(5), "5.00"},
+		{New(), bigFloat, "12345678901234567890.125"},
+		{New(WithDecimalScale(2)), bigFloat, "12345678901234567890.13"},
+		{New(WithDecimalScale(2), WithDecimalRounding(RoundHalfEven)), bigFloat, "12345678901234567890.12"},
+		{New(WithDecimalScale(2), WithDecimalRounding(RoundDown)), big.NewRat(-2, 3), "-0.66"},
+		{New(WithDecimalScale(2)), big.NewRat(-2, 3), "-0.67"},
+		{New(WithDecimalScale(0), WithDecimalRounding(RoundUp)), big.NewRat(1, 10), "1"},
+		{New(WithDecimalScale(2)), big.NewRat(-1, 1000), "0.00"},
+		{New(), big.NewRat(3, 8), "0.375"},
+		{New(), big.NewRat(1, 3), "0.33333333333333333333"},
+		{New(), decimalStub{big.NewRat(-1505, 100)}, "-15.05"},
+		{New(WithDecimalScale(1)), decimalStub{big.NewRat(-1505, 100)}, "-15.1"},
+	}
+	for _, tt := range tests {
+		if got := tt.c.ToString(tt.v); got.String != tt.want || got.IsNULL {
+			t.Errorf("ToString(%v) = %#v, want %q", tt.v, got, tt.want)
+		}
+	}
+	var nilInt *big.Int
+	if got := ToString(nilInt); !got.IsNULL {
+		t.Errorf("nil *big.Int: got %#v", got)
+	}
+}
+
+// pgInterval mimics pgtype.Interval.
+type pgInterval struct {
+	Microseconds int64
+	Days         int32
+	Months       int32
+	Valid        bool
+}
+
+func TestDurations(t *testing.T) {
+	d := time.Hour + 2*time.Minute + 3*time.Second + 500*time.Millisecond
+	iv := pgInterval{Months: 14, Days: 3, Microseconds: int64(4*time.Hour/time.Microsecond) + 5, Valid: true}
+	tests := []struct {
+		c    *Converter
+		v    any
+		want string
+	}{
+		{New(), d, "1h2m3.5s"},
+		{New(WithDurationFormat(DurationClock)), d, "01:02:03.5"},
+		{New(WithDurationFormat(DurationClock)), -26 * time.Hour, "-26:00:00"},
+		{New(WithDurationFormat(DurationSeconds)), d, "3723.5"},
+		{New(WithDurationFormat(DurationClock)), iv, "1 year 2 mons 3 days 04:00:00.000005"},
+		{New(WithDurationFormat(DurationSeconds)), Interval{Days: 1, Microseconds: 1500000}, "86401.5"},
+		{New(), Interval{}, "0s"},
+	}
+	for _, tt := range tests {
+		if got := tt.c.ToString(tt.v); got.String != tt.want || got.IsNULL {
+			t.Errorf("ToString(%v) = %#v, want %q", tt.v, got, tt.want)
+		}
+	}
+	if got := ToString(pgInterval{}); !got.IsNULL {
+		t.Errorf("invalid interval: got %#v", got)
+	}
+}
+
+func TestToStringFor(t *testing.T) {
+	c := New(
+		WithDatabaseType("mysql", "decimal", func(v any) String {
+			return String{"mysql:" + ToString(v).String, false}
+		}),
+		WithDatabaseType("", "TIMESTAMP", func(v any) String {
+			return String{"any:" + ToString(v).String, false}
+		}),
+	)
+	tests := []struct {
+		driver, typeName string
+		v                any
+		want             String
+	}{
+		{"mysql", "DECIMAL", []byte("1.50"), String{"mysql:1.50", false}},
+		{"postgres", "DECIMAL", []byte("1.50"), String{"1.50", false}},
+		{"gohive", "timestamp", "2024-01-01", String{"any:2024-01-01", false}},
+		{"mysql", "DECIMAL", nil, String{"", true}},
+	}
+	for _, tt := range tests {
+		if got := c.ToStringFor(tt.v, tt.driver, tt.typeName); got != tt.want {
+			t.Errorf("ToStringFor(%v, %q, %q) = %#v, want %#v", tt.v, tt.driver, tt.typeName, got, tt.want)
+		}
+	}
+}
+
+func TestAppendMatchesToString(t *testing.T) {
+	converters := []*Converter{
+		New(),
+		New(WithTimeLayout(time.DateTime), WithFloatFormat('e', 3), WithBoolLiterals("Y", "N"), WithBytesEncoding(BytesBase64)),
+		New(WithBytesEncoding(BytesHex), TreatZeroTimeAsNull(false)),
+	}
+	values := []any{
+		nil, "text", []byte{0xca, 0xfe}, true, false, -7, int8(-8), int16(16), int32(32), int64(64),
+		uint(1), uint8(8), uint16(16), uint32(32), uint64(64), float32(1.5), 2.25,
+		time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC), time.Time{}, time.Second, sql.NullInt64{},
+		[]int{1, 2}, big.NewInt(10),
+	}
+	for _, c := range converters {
+		for _, v := range values {
+			want := c.ToString(v)
+			got, isNULL := c.Append([]byte("prefix:"), v)
+			if string(got) != "prefix:"+want.String || isNULL != want.IsNULL {
+				t.Errorf("Append(%#v) = %q, %v, want %q, %v", v, got, isNULL, "prefix:"+want.String, want.IsNULL)
+			}
+		}
+	}
+}
+
+func TestWithNumberSeparators(t *testing.T) {
+	c := New(WithNumberSeparators(",", "."), WithFloatFormat('f', -1))
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{1234567, "1.234.567"},
+		{-1234.5, "-1.234,5"},
+		{int8(12), "12"},
+		{sql.NullInt64{Int64: 1000, Valid: true}, "1.000"},
+		{big.NewRat(-12345, 10), "-1.234,5"},
+		{math.Inf(1), "+Inf"},
+		{"1234", "1234"},
+	}
+	for _, tt := range tests {
+		if got := c.ToString(tt.v); got.String != tt.want {
+			t.Errorf("ToString(%#v) = %q, want %q", tt.v, got.String, tt.want)
+		}
+		if got, _ := c.Append(nil, tt.v); string(got) != tt.want {
+			t.Errorf("Append(%#v) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+	if got := New(WithNumberSeparators("", " "), WithFloatFormat('e', 2)).ToString(12345.0).String; got != "1.23e+04" {
+		t.Errorf("got %q, want the exponent notation unchanged", got)
+	}
+}
@@ -0,0 +1,107 @@
+// This file implements the Rows wrapper that applies exporter-level value transformations.
+
+package exporter
+
+import (
+	"fmt"
+
+	"github.com/go-data-exporter/exporter/scanner"
+)
+
+// transform is a value transformation applied to a single column,
+// or to every column if column is empty.
+type transform struct {
+	column string
+	fn     func(v any, metadata scanner.Metadata) (any, error)
+}
+
+// transformRows wraps a Rows and applies exporter-level transformations
+// to every scanned value before it reaches the codec.
+type transformRows struct {
+	scanner.Rows
+
+	transforms []transform
+	columns    []scanner.Column
+	byColumn   [][]func(any, scanner.Metadata) (any, error)
+	row        []any
+	rowID      int
+}
+
+// newTransformRows wraps rows with the given transformations.
+func newTransformRows(rows scanner.Rows, transforms []transform) *transformRows {
+	return &transformRows{Rows: rows, transforms: transforms}
+}
+
+// Columns returns the column metadata of the underlying rows and resolves
+// the columns referenced by the transformations.
+func (t *transformRows) Columns() ([]scanner.Column, error) {
+	if t.columns != nil {
+		return t.columns, nil
+	}
+	cols, err := t.Rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	byColumn := make([][]func(any, scanner.Metadata) (any, error), len(cols))
+	for _, tr := range t.transforms {
+		found := false
+		for i, col := range cols {
+			if tr.column == "" || tr.column == col.Name() {
+				byColumn[i] = append(byColumn[i], tr.fn)
+				found = true
+			}
+		}
+		if !found && tr.column != "" {
+			return nil, fmt.Errorf("exporter: unknown column %q", tr.column)
+		}
+	}
+	t.columns, t.byColumn = cols, byColumn
+	return t.columns, nil
+}
+
+// ScanRow returns the current row with all transformations applied.
+// The source row is not modified; the returned slice is reused between calls.
+func (t *transformRows) ScanRow() ([]any, error) {
+	if t.columns == nil {
+		if _, err := t.Columns(); err != nil {
+			return nil, err
+		}
+	}
+	values, err := t.Rows.ScanRow()
+	if err != nil {
+		return nil, err
+	}
+	t.rowID++
+	if len(t.row) != len(values) {
+		t.row = make([]any, len(values))
+	}
+	copy(t.row, values)
+	for i := range t.row {
+		if i >= len(t.byColumn) {
+			break
+		}
+		for _, fn := range t.byColumn[i] {
+			meta := scanner.Metadata{
+				RowID:  t.rowID,
+				Driver: t.Driver(),
+				Column: t.columns[i],
+			}
+			meta.Raw, _ = scanner.RawValue(t.Rows, i)
+			if t.row[i], err = fn(t.row[i], meta); err != nil {
+				err = fmt.Errorf("column %q row %d: %w", t.columns[i].Name(), t.rowID, err)
+				return nil, &scanner.RowError{Row: values, Err: err}
+			}
+		}
+	}
+	return t.row, nil
+}
+
+// RawValue returns the raw value of the underlying rows if they implement RawValuer.
+func (t *transformRows) RawValue(column int) (any, bool) {
+	return scanner.RawValue(t.Rows, column)
+}
+
+// EstimateRows returns the row estimate of the underlying rows.
+func (t *transformRows) EstimateRows() (int64, bool) {
+	return scanner.EstimateRows(t.Rows)
+}
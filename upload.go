@@ -0,0 +1,53 @@
+// This file implements streaming an export to an upload function through a pipe.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// errUploadReturned fails the export when the upload returns without an error
+// before it has read the complete export.
+var errUploadReturned = errors.New("exporter: upload returned before reading the export")
+
+// Upload streams the export to upload, e.g. a call of an SDK that reads the object
+// to store from an io.Reader, without buffering it: the export is written into a
+// pipe while upload reads it in a separate goroutine. Upload must read r until
+// io.EOF, which marks the complete export, and must return once its context is
+// done or reading r fails.
+//
+// If either side fails, the other is stopped: a failing export closes the pipe
+// with its error and cancels the context of upload, and a failing upload cancels
+// reading the rows. The first error is returned, and Upload returns only after
+// upload has returned, so no goroutine outlives the call. Reading the rows also
+// stops with the error of ctx once it is done.
+func (cs *Exporter) Upload(ctx context.Context, upload func(ctx context.Context, r io.Reader) error) (Stats, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	pr, pw := io.Pipe()
+	uploaded := make(chan struct{})
+	go func() {
+		err := upload(ctx, pr)
+		if err != nil {
+			err = fmt.Errorf("exporter: upload failed: %w", err)
+			cancel(err)
+		} else {
+			err = errUploadReturned
+		}
+		pr.CloseWithError(err)
+		close(uploaded)
+	}()
+	e := *cs
+	e.rows = &contextRows{Rows: cs.rows, ctx: ctx}
+	stats, err := e.Export(pw)
+	if err != nil {
+		cancel(err)
+	}
+	pw.CloseWithError(err)
+	<-uploaded
+	// The side failing first has canceled ctx with its error.
+	return stats, context.Cause(ctx)
+}